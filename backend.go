@@ -0,0 +1,174 @@
+// 01   Oct. 16, 2026   Initial version, solver backend interfaces
+// 02   Oct. 16, 2026   Added BestObjVal and MipRelGap
+// 03   Oct. 16, 2026   Added Status and SolnInfo
+// 04   Oct. 16, 2026   Added parameter functions
+// 05   Oct. 16, 2026   Added ReadParamFile and WriteParamFile
+// 06   Oct. 16, 2026   LpOpt and MipOpt take a context
+// 07   Oct. 16, 2026   Added SetLogFunc
+// 08   Oct. 16, 2026   Added SetProgressFunc
+// 09   Oct. 16, 2026   Added ObjSA, RhsSA and BoundSA
+// 10   Oct. 16, 2026   Added Base, CopyBase, ReadBasis and WriteBasis
+// 11   Oct. 16, 2026   Added PrimOpt, DualOpt, BarOpt and HybNetOpt
+// 12   Oct. 16, 2026   Added ChgBds, ChgRhs, ChgSense, ChgRngVal, ChgObj, ChgCType and renaming
+// 13   Oct. 16, 2026   Added DelRows, DelCols, DelSetRows and DelSetCols
+// 14   Oct. 16, 2026   Added ObjSen, Rows, Cols, Obj and Coefs
+// 15   Oct. 16, 2026   Added AddRows and AddCols
+// 16   Oct. 16, 2026   Added CopyQuad, ChgQPCoef, Quad and QPOpt
+// 17   Oct. 16, 2026   Added AddQConstrs, NumQConstrs, QConstrNames, QConstrSlack and QConstrDSlack
+// 18   Oct. 16, 2026   Added AddIndConstrs, NumIndConstrs, IndConstrs and IndConstrNames
+// 19   Oct. 16, 2026   Added AddSOS, NumSOS, SOS, SOSNames and DelSOS
+// 20   Oct. 16, 2026   Added AddPwl, NumPwl, Pwl and PwlNames
+// 21   Oct. 16, 2026   Added AddMIPStarts, ChgMIPStarts, MIPStarts, MIPStartNames and MST files
+// 22   Oct. 16, 2026   Added Populate, PoolNumSolns, PoolSolution and solution pool filters
+// 23   Oct. 16, 2026   Added DelIndConstrs and DelPwl
+// 24   Oct. 16, 2026   gpx alone copies the constraints passed to a Solver
+
+package gpx
+
+import (
+	"context"
+)
+
+// DefaultBackend is the Backend used by OpenCplex and by the package-level
+// functions. When gpx is built with the "cplex" build tag it is set to
+// CplexBackend; otherwise it is nil until the program assigns one, for example
+// a FakeBackend in unit tests.
+var DefaultBackend Backend
+
+// Backend is implemented by each solver engine which gpx can drive. The Env and
+// Problem handles perform all argument checking and the translation between the
+// gpx data structures, so a Backend only needs to implement the primitive
+// operations below.
+type Backend interface {
+	// OpenEnv opens a new, independent solver environment.
+	OpenEnv() (BackendEnv, error)
+}
+
+// BackendEnv is a solver environment opened by a Backend. Parameters are
+// identified by their Cplex id, and apply to all problems in the environment.
+// Progress events need only have the MIP, Iterations, Objective, Nodes,
+// HasIncumbent, BestInteger and BestBound fields set; gpx fills in the others
+// and throttles the events.
+type BackendEnv interface {
+	CreateProb(name string) (Solver, error)                  // Create a new, empty problem
+	OutputToScreen(echoOn bool) error                        // Turn solver output to screen on or off
+	SetLogFunc(fn func(LogChannel, string)) error            // Send messages to fn, or stop if nil
+	SetProgressFunc(fn func(Progress)) error                 // Send progress events to fn, or stop if nil
+	SetIntParam(id int, value int) error                     // Set an integer parameter
+	SetDblParam(id int, value float64) error                 // Set a double parameter
+	SetLongParam(id int, value int64) error                  // Set a long integer parameter
+	SetStrParam(id int, value string) error                  // Set a string parameter
+	GetIntParam(id int) (int, error)                         // Value of an integer parameter
+	GetDblParam(id int) (float64, error)                     // Value of a double parameter
+	GetLongParam(id int) (int64, error)                      // Value of a long integer parameter
+	GetStrParam(id int) (string, error)                      // Value of a string parameter
+	SetDefaults() error                                      // Reset all parameters to their defaults
+	ReadParamFile(fileName string) error                     // Set the parameters listed in a file
+	WriteParamFile(fileName string) error                    // Write the non-default parameters to a file
+	CloseEnv() error                                         // Close the environment
+}
+
+// Solver is a single problem created in a BackendEnv. Row and column indices
+// are zero-based, and slices passed to Solver methods are sized by the caller
+// to match the number of rows or columns in the problem. The Chg functions
+// receive indices already checked by gpx, with names resolved. LpOpt and MipOpt stop
+// as soon as possible once ctx is done, leaving the problem with an aborted
+// status (see SolutionStatus.IsAborted) and, for a MIP, the incumbent found so
+// far. The constraints, MIP starts and filters passed to the Add and Chg
+// functions are copies made by gpx, sharing no memory with the caller, so a
+// Solver may keep them as they are; those it returns are copies it does not
+// keep.
+type Solver interface {
+	FreeProb() error                                         // Release the problem
+	ChgProbName(name string) error                           // Change the problem name
+	ChgObjSen(sense int) error                               // Set objective sense (1 min, -1 max)
+	NewRows(rList []InputRow) error                          // Append rows
+	NewCols(objList []InputObjCoef, cList []InputCol) error  // Append columns
+	AddRows(rList []InputRow, rMat InputMatrix) error        // Append rows with their coefficients
+	AddCols(objList []InputObjCoef, cList []InputCol, cMat InputMatrix) error // Append columns with their coefficients
+	ChgCoefList(eList []InputElem) error                     // Set non-zero coefficients
+	CopyQuad(qList []InputQuadCoef) error                    // Set Q, both halves, replacing any previous one
+	ChgQPCoef(qList []InputQuadCoef) error                   // Change coefficients of Q at both symmetric positions
+	Quad() ([]InputQuadCoef, error)                          // Non-zero coefficients of Q, both halves
+	AddQConstrs(qList []InputQConstr) error                  // Append quadratic constraints
+	AddIndConstrs(iList []InputIndicator) error              // Append indicator constraints
+	AddSOS(sList []InputSOS) error                           // Append special ordered sets
+	AddPwl(pList []InputPwl) error                           // Append piecewise linear constraints
+	AddMIPStarts(mList []InputMIPStart) error                // Append MIP starts, columns by index
+	ChgMIPStarts(indices []int, mList []InputMIPStart) error // Change the efforts and values of MIP starts
+	AddPoolFilters(fList []InputPoolFilter) error            // Append solution pool filters
+	ChgBds(bList []InputBound) error                         // Change column bounds, by index
+	ChgRhs(rList []InputRowValue) error                      // Change right hand sides, by index
+	ChgSense(sList []InputSense) error                       // Change row senses, by index
+	ChgRngVal(rList []InputRowValue) error                   // Change range values, by index
+	ChgObj(oList []InputColValue) error                      // Change objective coefficients, by index
+	ChgCType(tList []InputCType) error                       // Change column types, by index
+	ChgColName(nList []InputName) error                      // Rename columns, by index
+	ChgRowName(nList []InputName) error                      // Rename rows, by index
+	DelRows(begin, end int) error                            // Delete a range of rows
+	DelCols(begin, end int) error                            // Delete a range of columns
+	DelSetRows(delStat []int) error                          // Delete rows set to 1, return new indices in delStat
+	DelSetCols(delStat []int) error                          // Delete columns set to 1, return new indices in delStat
+	DelIndConstrs(begin, end int) error                      // Delete a range of indicator constraints
+	DelSOS(begin, end int) error                             // Delete a range of special ordered sets
+	DelPwl(begin, end int) error                             // Delete a range of piecewise linear constraints
+	DelPoolFilters(begin, end int) error                     // Delete a range of solution pool filters
+	LpOpt(ctx context.Context) error                         // Solve as an LP, stopping when ctx is done
+	MipOpt(ctx context.Context) error                        // Solve as a MIP, stopping when ctx is done
+	QPOpt(ctx context.Context) error                         // Solve as a QP, stopping when ctx is done
+	Populate(ctx context.Context) error                      // Fill the solution pool, stopping when ctx is done
+	PrimOpt(ctx context.Context) error                       // Solve as an LP with the primal simplex
+	DualOpt(ctx context.Context) error                       // Solve as an LP with the dual simplex
+	BarOpt(ctx context.Context, crossover Algorithm) error   // Solve with the barrier, AlgAuto for the default crossover
+	HybNetOpt(ctx context.Context, method Algorithm) error   // Solve with the network, then the simplex method
+	NumRows() int                                            // Number of rows
+	NumCols() int                                            // Number of columns
+	NumQConstrs() int                                        // Number of quadratic constraints
+	NumIndConstrs() int                                      // Number of indicator constraints
+	IndConstrs(iList []InputIndicator) error                 // Indicator constraints, without their names
+	NumSOS() int                                             // Number of special ordered sets
+	SOS(sList []InputSOS) error                              // Special ordered sets, without their names
+	NumPwl() int                                             // Number of piecewise linear constraints
+	Pwl(pList []InputPwl) error                              // Piecewise linear constraints, without their names
+	NumMIPStarts() int                                       // Number of MIP starts
+	MIPStarts(mList []InputMIPStart) error                   // MIP starts, without their names
+	NumPoolFilters() int                                     // Number of solution pool filters
+	ObjSen() (int, error)                                    // Objective sense (1 min, -1 max)
+	Rows(rList []InputRow) error                             // Sense, Rhs and RngVal of all rows
+	Cols(cList []InputCol) error                             // Type and bounds of all columns
+	Obj(obj []float64) error                                 // Objective coefficients of all columns
+	Coefs() ([]InputElem, error)                             // Non-zero coefficients, in any order
+	ObjVal() (float64, error)                                // Objective value of the solution
+	Solution(x, dj, pi, slack []float64) (float64, error)    // Full LP solution and objective value
+	X(x []float64) error                                     // Column values of the solution
+	Slack(slack []float64) error                             // Row slacks of the solution
+	QConstrSlack(slack []float64) error                      // Quadratic constraint slacks of the solution
+	QConstrDSlack(index int) ([]InputLinCoef, error)         // Non-zero dual slacks of a quadratic constraint
+	Status() (SolutionStatus, error)                         // Status of the solution
+	SolnInfo() (SolnInfo, error)                             // Method and type of the solution
+	BestObjVal() (float64, error)                            // Best bound found by MipOpt
+	MipRelGap() (float64, error)                             // Relative gap of the MipOpt solution
+	PoolNumSolns() int                                       // Number of solutions in the pool
+	PoolSolution(i int, x, slack []float64) (float64, error) // Objective, column values and slacks of pool solution i
+	ObjSA(lower, upper []float64) error                      // Objective ranges of the LP basis
+	RhsSA(lower, upper []float64) error                      // Right hand side ranges of the LP basis
+	BoundSA(lbLo, lbUp, ubLo, ubUp []float64) error          // Bound ranges of the LP basis
+	Base(cStat, rStat []BasisStatus) error                   // Status of each column and row in the basis
+	CopyBase(cStat, rStat []BasisStatus) error               // Set the starting basis of the next LpOpt
+	ReadBasis(fileName string) error                         // Read the starting basis from a file
+	WriteBasis(fileName string) error                        // Write the basis to a file
+	ReadMIPStarts(fileName string) error                     // Read MIP starts from an MST file
+	WriteMIPStarts(fileName string) error                    // Write all MIP starts to an MST file
+	ColNames() ([]string, error)                             // Names of all columns
+	RowNames() ([]string, error)                             // Names of all rows
+	QConstrNames() ([]string, error)                         // Names of all quadratic constraints
+	IndConstrNames() ([]string, error)                       // Names of all indicator constraints
+	SOSNames() ([]string, error)                             // Names of all special ordered sets
+	PwlNames() ([]string, error)                             // Names of all piecewise linear constraints
+	MIPStartNames() ([]string, error)                        // Names of all MIP starts
+	ReadCopyProb(fileName string, fileType string) error     // Read the problem from a file
+	WriteProb(fileName string, fileType string) error        // Write the problem to a file
+	SolWrite(fileName string) error                          // Write the solution to a file
+}
+
+//============================ END OF FILE =====================================
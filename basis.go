@@ -0,0 +1,375 @@
+// 01   Oct. 16, 2026   Initial version, access to the simplex basis and basis files
+
+package gpx
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/pkg/errors"
+	"os"
+	"strings"
+)
+
+// BasisStatus is the status of a column, or of the slack of a row, in a simplex
+// basis. The values are those used by CPXgetbase and CPXcopybase. For rows
+// other than ranged rows, a nonbasic slack is always BasisAtLower; for a ranged
+// row, BasisAtLower means that the row activity is at the lower end of the
+// range (Rhs), and BasisAtUpper that it is at the upper end.
+type BasisStatus int
+
+// Basis status values.
+const (
+	BasisAtLower             BasisStatus = 0   // Nonbasic at the lower bound (CPX_AT_LOWER)
+	BasisBasic               BasisStatus = 1   // Basic (CPX_BASIC)
+	BasisAtUpper             BasisStatus = 2   // Nonbasic at the upper bound (CPX_AT_UPPER)
+	BasisFreeSuper           BasisStatus = 3   // Nonbasic and free, or superbasic (CPX_FREE_SUPER)
+)
+
+//==============================================================================
+
+// String returns the name of the status.
+func (b BasisStatus) String() string {
+
+	switch b {
+	case BasisAtLower:
+		return "at lower"
+	case BasisBasic:
+		return "basic"
+	case BasisAtUpper:
+		return "at upper"
+	case BasisFreeSuper:
+		return "free superbasic"
+	}
+
+	return fmt.Sprintf("unknown basis status %d", int(b))
+}
+
+//==============================================================================
+// PROBLEM FUNCTIONS
+//==============================================================================
+
+// GetBase obtains the status of each column and row in the current basis,
+// usually the optimal basis found by LpOpt, and populates the slices passed to
+// the function with one entry per column and row.
+// If the problem has no basis, the error matches ErrNoBasis.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXgetbase.
+func (p *Problem) GetBase(cStat *[]BasisStatus, rStat *[]BasisStatus) error {
+
+	*cStat = nil
+	*rStat = nil
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	cols := make([]BasisStatus, p.slv.NumCols())
+	rows := make([]BasisStatus, p.slv.NumRows())
+
+	if err := p.slv.Base(cols, rows); err != nil {
+		return errors.Wrap(err, "GetBase failed")
+	}
+
+	*cStat = cols
+	*rStat = rows
+
+	return nil
+}
+
+//==============================================================================
+
+// CopyBase copies a starting basis into the problem, to be used by the next
+// LpOpt if ParamAdvance is 1 (the default). The slices may be shorter than the
+// number of columns and rows, so that a basis obtained by GetBase can be copied
+// back after columns or rows have been appended: missing columns are placed at
+// their lower bound, and the slacks of missing rows are made basic.
+// Re-solving a modified problem does not require CopyBase, since the basis of
+// the previous solve is kept, but CopyBase allows a basis to be reused in
+// another problem, or after the problem has been rebuilt.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXcopybase.
+func (p *Problem) CopyBase(cStat []BasisStatus, rStat []BasisStatus) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	numCols := p.slv.NumCols()
+	numRows := p.slv.NumRows()
+
+	if len(cStat) > numCols || len(rStat) > numRows {
+		return errors.Errorf("Basis has %d columns and %d rows, problem has %d and %d",
+			len(cStat), len(rStat), numCols, numRows)
+	}
+
+	cols := make([]BasisStatus, numCols)
+	copy(cols, cStat)
+
+	rows := make([]BasisStatus, numRows)
+	for i := range rows {
+		rows[i] = BasisBasic
+	}
+	copy(rows, rStat)
+
+	for _, list := range [][]BasisStatus{cols, rows} {
+		for _, status := range list {
+			if status < BasisAtLower || status > BasisFreeSuper {
+				return errors.Errorf("Unexpected basis status %d", int(status))
+			}
+		}
+	}
+
+	return p.slv.CopyBase(cols, rows)
+}
+
+//==============================================================================
+
+// ReadBasis reads a basis from a file in MPS basis format (.bas) and copies it
+// into the problem, as CopyBase does. Names in the file refer to the names of
+// the columns and rows of the problem.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXreadcopybase.
+func (p *Problem) ReadBasis(fileName string) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	defer p.logScope()()
+
+	return p.slv.ReadBasis(fileName)
+}
+
+//==============================================================================
+
+// WriteBasis writes the current basis of the problem to a file in MPS basis
+// format (.bas), replacing the file if it exists.
+// If the problem has no basis, the error matches ErrNoBasis.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXmbasewrite.
+func (p *Problem) WriteBasis(fileName string) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	defer p.logScope()()
+
+	return p.slv.WriteBasis(fileName)
+}
+
+//==============================================================================
+// MPS BASIS FILES FOR THE BACKENDS WRITTEN IN GO
+//==============================================================================
+
+// basisName returns the name used for a column or row in a basis file. Items
+// without a name are given the default names used by Cplex, e.g. "x3" for the
+// third column and "c2" for the second row.
+func basisName(names []string, index int, prefix string) string {
+
+	if names[index] != "" {
+		return names[index]
+	}
+
+	return fmt.Sprintf("%s%d", prefix, index + 1)
+}
+
+//==============================================================================
+
+// writeBasisFile writes the basis to a file in MPS basis format. Each basic
+// column is paired with a nonbasic row, written as XU if the row is at its
+// upper bound and XL otherwise; the remaining nonbasic columns at their upper
+// bound are written as UL. Columns at their lower bound and basic rows are
+// the defaults, and are not written.
+func writeBasisFile(fileName string, probName string, colNames, rowNames []string, b *lpBasis) error {
+	var basicCols    []int   // Basic columns
+	var nonbasicRows []int   // Nonbasic rows
+
+	for j, status := range b.cols {
+		if status == BasisBasic {
+			basicCols = append(basicCols, j)
+		}
+	}
+
+	for i, status := range b.rows {
+		if status != BasisBasic {
+			nonbasicRows = append(nonbasicRows, i)
+		}
+	}
+
+	if len(basicCols) != len(nonbasicRows) {
+		return errors.Errorf("Basis has %d basic columns and %d nonbasic rows",
+			len(basicCols), len(nonbasicRows))
+	}
+
+	f, err := os.Create(fileName)
+	if err != nil {
+		return errors.Wrap(ErrFileOpen, err.Error())
+	}
+
+	w := bufio.NewWriter(f)
+	fmt.Fprintf(w, "NAME          %s\n", probName)
+
+	for k, j := range basicCols {
+		i := nonbasicRows[k]
+		code := "XL"
+		if b.rows[i] == BasisAtUpper {
+			code = "XU"
+		}
+		fmt.Fprintf(w, " %s %-8s  %s\n", code, basisName(colNames, j, "x"), basisName(rowNames, i, "c"))
+	}
+
+	for j, status := range b.cols {
+		if status == BasisAtUpper {
+			fmt.Fprintf(w, " UL %s\n", basisName(colNames, j, "x"))
+		}
+	}
+
+	fmt.Fprintf(w, "ENDATA\n")
+
+	if err = w.Flush(); err != nil {
+		f.Close()
+		return errors.Wrapf(err, "Failed to write %s", fileName)
+	}
+
+	return f.Close()
+}
+
+//==============================================================================
+
+// readBasisFile reads a basis in MPS basis format, as written by
+// writeBasisFile or by Cplex. Columns not listed are at their lower bound, and
+// rows not listed are basic. Lines starting with '*' are comments.
+// In case the file cannot be read or refers to unknown names, it returns an
+// error.
+func readBasisFile(fileName string, colNames, rowNames []string) (*lpBasis, error) {
+
+	f, err := os.Open(fileName)
+	if err != nil {
+		return nil, errors.Wrap(ErrFileOpen, err.Error())
+	}
+	defer f.Close()
+
+	colIndex := make(map[string]int, len(colNames))
+	for j := range colNames {
+		colIndex[basisName(colNames, j, "x")] = j
+	}
+
+	rowIndex := make(map[string]int, len(rowNames))
+	for i := range rowNames {
+		rowIndex[basisName(rowNames, i, "c")] = i
+	}
+
+	b := &lpBasis{cols: make([]BasisStatus, len(colNames)), rows: make([]BasisStatus, len(rowNames))}
+	for i := range b.rows {
+		b.rows[i] = BasisBasic
+	}
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if text == "" || text[0] == '*' || strings.HasPrefix(text, "NAME") {
+			continue
+		}
+		if strings.HasPrefix(text, "ENDATA") {
+			break
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) < 2 {
+			return nil, errors.Errorf("Line %d of %s is not a basis entry", line, fileName)
+		}
+
+		j, ok := colIndex[fields[1]]
+		if !ok {
+			return nil, errors.Errorf("Line %d of %s refers to unknown column %s", line, fileName, fields[1])
+		}
+
+		switch fields[0] {
+		case "XU", "XL":
+			if len(fields) < 3 {
+				return nil, errors.Errorf("Line %d of %s has no row name", line, fileName)
+			}
+			i, ok := rowIndex[fields[2]]
+			if !ok {
+				return nil, errors.Errorf("Line %d of %s refers to unknown row %s", line, fileName, fields[2])
+			}
+			b.cols[j] = BasisBasic
+			b.rows[i] = BasisAtLower
+			if fields[0] == "XU" {
+				b.rows[i] = BasisAtUpper
+			}
+		case "UL":
+			b.cols[j] = BasisAtUpper
+		case "LL":
+			b.cols[j] = BasisAtLower
+		default:
+			return nil, errors.Errorf("Line %d of %s has unknown code %s", line, fileName, fields[0])
+		}
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "Failed to read %s", fileName)
+	}
+
+	return b, nil
+}
+
+//==============================================================================
+// PACKAGE-LEVEL FUNCTIONS
+//==============================================================================
+
+// GetBase obtains the basis of the default problem. See Problem.GetBase.
+func GetBase(cStat *[]BasisStatus, rStat *[]BasisStatus) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetBase(cStat, rStat)
+}
+
+//==============================================================================
+
+// CopyBase copies a starting basis into the default problem. See
+// Problem.CopyBase.
+func CopyBase(cStat []BasisStatus, rStat []BasisStatus) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.CopyBase(cStat, rStat)
+}
+
+//==============================================================================
+
+// ReadBasis reads a basis file into the default problem. See
+// Problem.ReadBasis.
+func ReadBasis(fileName string) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.ReadBasis(fileName)
+}
+
+//==============================================================================
+
+// WriteBasis writes the basis of the default problem to a file. See
+// Problem.WriteBasis.
+func WriteBasis(fileName string) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.WriteBasis(fileName)
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,130 @@
+package gpx
+
+import (
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// TestBasisFileRoundTrip writes the optimal basis of the problem of goModel,
+// reads it into a new problem, and checks that LpOpt then starts from it: the
+// new problem is solved within one iteration, which is not enough from the
+// slack basis.
+func TestBasisFileRoundTrip(t *testing.T) {
+
+	_, prob := newTestProb(t, NewGoBackend(), goModel("C"))
+
+	fileName := filepath.Join(t.TempDir(), "go.bas")
+	if err := prob.WriteBasis(fileName); !errors.Is(err, ErrNoBasis) {
+		t.Errorf("WriteBasis before LpOpt = %v, want %v", err, ErrNoBasis)
+	}
+
+	if err := prob.LpOpt(); err != nil {
+		t.Fatalf("LpOpt failed: %v", err)
+	}
+
+	var cStat, rStat []BasisStatus
+	if err := prob.GetBase(&cStat, &rStat); err != nil {
+		t.Fatalf("GetBase failed: %v", err)
+	}
+
+	// y and the slack of c2 are basic, x is at its upper bound.
+	wantCols := []BasisStatus{BasisAtUpper, BasisBasic}
+	wantRows := []BasisStatus{BasisAtLower, BasisBasic}
+	if !reflect.DeepEqual(cStat, wantCols) || !reflect.DeepEqual(rStat, wantRows) {
+		t.Errorf("GetBase = %v, %v, want %v, %v", cStat, rStat, wantCols, wantRows)
+	}
+
+	if err := prob.WriteBasis(fileName); err != nil {
+		t.Fatalf("WriteBasis failed: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	want := "NAME          go\n XL y         c1\n UL x\nENDATA\n"
+	if string(data) != want {
+		t.Errorf("%s =\n%s\nwant\n%s", fileName, data, want)
+	}
+
+	for _, warm := range []bool{false, true} {
+		env, read := newTestProb(t, NewGoBackend(), goModel("C"))
+		if err = env.SetLongParam(ParamItLimit, 1); err != nil {
+			t.Fatalf("SetLongParam failed: %v", err)
+		}
+		if warm {
+			if err = read.ReadBasis(fileName); err != nil {
+				t.Fatalf("ReadBasis failed: %v", err)
+			}
+		}
+		if err = read.LpOpt(); err != nil {
+			t.Fatalf("LpOpt failed: %v", err)
+		}
+
+		var status SolutionStatus
+		wantStatus := StatAbortItLim
+		if warm {
+			wantStatus = StatOptimal
+		}
+		if read.GetStatus(&status); status != wantStatus {
+			t.Errorf("warm start %t: GetStatus = %s, want %s", warm, status, wantStatus)
+		}
+	}
+}
+
+//==============================================================================
+
+func TestReadBasisFile(t *testing.T) {
+
+	named   := []string{"x", "y"}
+	unnamed := []string{"", ""}   // Columns with the default names x1 and x2
+
+	tests := []struct {
+		name      string
+		colNames  []string
+		text      string
+		cols      []BasisStatus   // nil for an error
+		rows      []BasisStatus
+	}{
+		{"cplex file", named, "* ENCODING=ISO-8859-1\nNAME          go Bases\n XU y         c2\n UL x\nENDATA\n",
+			[]BasisStatus{BasisAtUpper, BasisBasic}, []BasisStatus{BasisBasic, BasisAtUpper}},
+		{"default names", unnamed, "NAME\n XL x2        c1\n LL x1\nENDATA\n",
+			[]BasisStatus{BasisAtLower, BasisBasic}, []BasisStatus{BasisAtLower, BasisBasic}},
+		{"empty", named, "NAME\nENDATA\n",
+			[]BasisStatus{BasisAtLower, BasisAtLower}, []BasisStatus{BasisBasic, BasisBasic}},
+		{"text after ENDATA", unnamed, "NAME\nENDATA\n XX x1\n",
+			[]BasisStatus{BasisAtLower, BasisAtLower}, []BasisStatus{BasisBasic, BasisBasic}},
+		{"unknown column", named, " UL z\n", nil, nil},
+		{"unknown row", unnamed, " XL x1 c3\n", nil, nil},
+		{"no row", unnamed, " XL x1\n", nil, nil},
+		{"unknown code", unnamed, " BS x1\n", nil, nil},
+		{"no name", named, " UL\n", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			fileName := filepath.Join(t.TempDir(), "test.bas")
+			if err := ioutil.WriteFile(fileName, []byte(tt.text), 0644); err != nil {
+				t.Fatalf("WriteFile failed: %v", err)
+			}
+
+			b, err := readBasisFile(fileName, tt.colNames, []string{"c1", "c2"})
+			if (err != nil) != (tt.cols == nil) {
+				t.Fatalf("readBasisFile error = %v, want error %t", err, tt.cols == nil)
+			}
+			if err == nil && (!reflect.DeepEqual(b.cols, tt.cols) || !reflect.DeepEqual(b.rows, tt.rows)) {
+				t.Errorf("readBasisFile = %v, %v, want %v, %v", b.cols, b.rows, tt.cols, tt.rows)
+			}
+		})
+	}
+
+	if _, err := readBasisFile(filepath.Join(t.TempDir(), "missing.bas"), nil, nil); !errors.Is(err, ErrFileOpen) {
+		t.Errorf("readBasisFile of a missing file returned %v, want %v", err, ErrFileOpen)
+	}
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,4038 @@
+// 01   Oct. 16, 2026   Moved Cplex calls from gpx.go behind the Backend interface
+// 02   Oct. 16, 2026   Added BestObjVal and MipRelGap
+// 03   Oct. 16, 2026   Added Status and SolnInfo
+// 04   Oct. 16, 2026   Errors returned as CplexError, removed output to stderr
+// 05   Oct. 16, 2026   Added parameter functions
+// 06   Oct. 16, 2026   Added ReadParamFile and WriteParamFile
+// 07   Oct. 16, 2026   LpOpt and MipOpt can be aborted through a context
+// 08   Oct. 16, 2026   Function destinations removed before closing, see cplexlog.go
+// 09   Oct. 16, 2026   Progress callbacks removed before closing, see cplexprogress.go
+// 10   Oct. 16, 2026   Added ObjSA, RhsSA and BoundSA
+// 11   Oct. 16, 2026   Added Base, CopyBase, ReadBasis and WriteBasis
+// 12   Oct. 16, 2026   Added PrimOpt, DualOpt, BarOpt and HybNetOpt
+// 13   Oct. 16, 2026   Added ChgBds, ChgRhs, ChgSense, ChgRngVal, ChgObj, ChgCType and renaming
+// 14   Oct. 16, 2026   Added DelRows, DelCols, DelSetRows and DelSetCols
+// 15   Oct. 16, 2026   Added ObjSen, Rows, Cols, Obj and Coefs
+// 16   Oct. 16, 2026   Added AddRows and AddCols
+// 17   Oct. 16, 2026   Added CopyQuad, ChgQPCoef, Quad and QPOpt, problem types kept in step
+// 18   Oct. 16, 2026   Added AddQConstrs, NumQConstrs, QConstrNames, QConstrSlack and QConstrDSlack
+// 19   Oct. 16, 2026   Added AddIndConstrs, NumIndConstrs, IndConstrs and IndConstrNames
+// 20   Oct. 16, 2026   Added AddSOS, NumSOS, SOS, SOSNames and DelSOS
+// 21   Oct. 16, 2026   Added AddPwl, NumPwl, Pwl and PwlNames
+// 22   Oct. 16, 2026   Added AddMIPStarts, ChgMIPStarts, MIPStarts, MIPStartNames and MST files
+// 23   Oct. 16, 2026   Added Populate, PoolNumSolns, PoolSolution and solution pool filters
+// 24   Oct. 16, 2026   Added DelIndConstrs and DelPwl
+// 25   Oct. 16, 2026   Problem names passed to Cplex without a fixed-size buffer
+
+//go:build cplex
+
+package gpx
+
+/*
+// Everything in comments above the import "C" is C code and will be compiled with the GCC. 
+// Make sure you have a GCC installed.
+
+#cgo CFLAGS: -ID:/pk_cplex/include
+#cgo LDFLAGS: -LD:/pk_cplex/include -lcplex1271
+
+#include <string.h>
+#include <stdio.h>
+#include <stdint.h>
+#include <ilcplex/cplex.h>
+
+#define BUFSIZE 80;
+
+//==============================================================================
+// C HELPER FUNCTIONS
+//==============================================================================
+
+//------------------------------------------------------------------------------
+char**makeCharArray(int size) {
+        return calloc(sizeof(char*), size);
+}
+
+char *makeNameStore(int size) {
+	return malloc (size);	
+}
+
+static void setArrayString(char **a, char *s, int n) {
+	a[n] = s;
+}
+
+static void freeCharArray(char **a, int size) {
+	int i;
+	for (i = 0; i < size; i++) {
+		free(a[i]);			
+	}
+	free(a);
+}
+
+char *getArrayString(char **a, int n) {
+	char *aItem;
+				
+	aItem = strdup(a[n]);
+	return aItem;	
+}
+
+char *cGetArrayItem(char **a, int n) {
+	int i;
+	char *arrayItem;
+	
+	arrayItem = strdup(a[n]);
+	return arrayItem;
+}
+
+//==============================================================================
+// CPX FUNCTIONS
+//==============================================================================
+
+//------------------------------------------------------------------------------
+// Get the message associated with an error code. The buffer must hold at least
+// CPXMESSAGEBUFSIZE characters, and is set to an empty string if the code is
+// not known to Cplex.
+void cGetErrorString(CPXENVptr env, int code, char *buffer) {
+
+	if (CPXgeterrorstring(env, code, buffer) == NULL) {
+		buffer[0] = '\0';
+	}
+}
+
+//------------------------------------------------------------------------------
+int cOpenCplex(CPXENVptr *env) {
+	
+	int status = 0;	
+	*env = NULL;
+	
+    *env = CPXopenCPLEX(&status);
+
+	return status;	
+}
+
+//------------------------------------------------------------------------------
+// Turn on output to the screen.
+int cOutputToScreen(CPXENVptr env, int state) {
+	int status = 0;
+	int echoState = 0;
+	
+	if (state) {
+		echoState = CPX_ON;	
+	} else {
+		echoState = CPX_OFF;	
+	}
+	
+	status = CPXsetintparam(env, CPXPARAM_ScreenOutput, echoState);
+	return status;
+}
+
+//------------------------------------------------------------------------------
+// Turn on data checking.
+int cCheckData(CPXENVptr env) {
+
+	int status = 0;
+	status = CPXsetintparam(env, CPXPARAM_Read_DataCheck, CPX_DATACHECK_WARN);
+	return status;	
+}
+
+//------------------------------------------------------------------------------
+// Set and get parameters.
+int cSetIntParam(CPXENVptr env, int id, int value) {
+
+	return CPXsetintparam(env, id, value);
+}
+
+int cSetDblParam(CPXENVptr env, int id, double value) {
+
+	return CPXsetdblparam(env, id, value);
+}
+
+int cSetLongParam(CPXENVptr env, int id, long long value) {
+
+	return CPXsetlongparam(env, id, (CPXLONG) value);
+}
+
+int cSetStrParam(CPXENVptr env, int id, char *value) {
+
+	return CPXsetstrparam(env, id, value);
+}
+
+int cGetIntParam(CPXENVptr env, int id, int *value) {
+
+	return CPXgetintparam(env, id, value);
+}
+
+int cGetDblParam(CPXENVptr env, int id, double *value) {
+
+	return CPXgetdblparam(env, id, value);
+}
+
+int cGetLongParam(CPXENVptr env, int id, long long *value) {
+
+	int status;
+	CPXLONG cValue = 0;
+
+	status = CPXgetlongparam(env, id, &cValue);
+	*value = (long long) cValue;
+
+	return status;
+}
+
+// The buffer must hold at least CPX_STR_PARAM_MAX characters.
+int cGetStrParam(CPXENVptr env, int id, char *value) {
+
+	return CPXgetstrparam(env, id, value);
+}
+
+//------------------------------------------------------------------------------
+// Reset all parameters to their defaults, then turn data checking back on.
+int cSetDefaults(CPXENVptr env) {
+
+	int status;
+
+	status = CPXsetdefaults(env);
+	if (status == 0) {
+		status = CPXsetintparam(env, CPXPARAM_Read_DataCheck, CPX_DATACHECK_WARN);
+	}
+
+	return status;
+}
+
+//------------------------------------------------------------------------------
+// Read parameters from a file.
+int cReadParamFile(CPXENVptr env, char *cFileName) {
+
+	return CPXreadcopyparam(env, cFileName);
+}
+
+//------------------------------------------------------------------------------
+// Write the non-default parameters to a file.
+int cWriteParamFile(CPXENVptr env, char *cFileName) {
+
+	return CPXwriteparam(env, cFileName);
+}
+
+//------------------------------------------------------------------------------
+// Install or remove (terminate = NULL) the termination flag of the environment.
+int cSetTerminate(CPXENVptr env, int *terminate) {
+
+	return CPXsetterminate(env, terminate);
+}
+
+//------------------------------------------------------------------------------
+// Create the LP.
+int cCreateProb(CPXENVptr env, CPXLPptr *lp, char *probName) {
+
+	int status = 0;
+
+	*lp = CPXcreateprob(env, &status, probName);
+
+	return status;
+}
+
+//------------------------------------------------------------------------------
+// Change the name of the LP.
+int cChgProbName(CPXENVptr env, CPXLPptr lp, char *probName) {
+
+	return CPXchgprobname(env, lp, probName);
+}
+
+//------------------------------------------------------------------------------
+// Change objective function sense (default is minimize).
+int cChgObjSen(CPXENVptr env, CPXLPptr lp, int state) {
+	int status = 0;
+	int sense;
+	
+	if (state == 1) {
+		sense = CPX_MIN;	
+	} else {
+		sense = CPX_MAX;	
+	}
+	
+	status = CPXchgobjsen(env, lp, sense);
+	return status;
+}
+
+//------------------------------------------------------------------------------
+// Create new rows
+int cCreateRows(CPXENVptr env, CPXLPptr lp, int numRows, char *senseArray, char **rowName, double *rhs, double *rngVal) {
+
+	int status = 0;
+			
+	status = CPXnewrows(env, lp, numRows, rhs, senseArray, rngVal, rowName);
+
+	return status;	
+}
+
+
+//------------------------------------------------------------------------------
+// Create new columns
+int cCreateCols(CPXENVptr env, CPXLPptr lp, int isMip, int numCols, double *obj, char **colName,  char *type, double *lb, double *ub) {
+
+	int status = 0;
+
+	// If this is a MIP, we must pass tye variable type array to Cplex.
+	// Otherwise, we must pass a NULL even if all variables are of the C type so CPXlpopt
+	// does not complain when we solve the problem.
+	
+	if (isMip) {
+		status = CPXnewcols(env, lp, numCols, obj, lb, ub, type, colName);	
+	} else {	
+		status = CPXnewcols(env, lp, numCols, obj, lb, ub, NULL, colName);	
+    }
+	
+	
+	return status;	
+}
+
+//------------------------------------------------------------------------------
+// Create new columns
+int cChgCoefList(CPXENVptr env, CPXLPptr lp, int numNZ, int *rowlist, int *collist, double *vallist) {
+
+	int status = 0;
+	
+	status = CPXchgcoeflist(env, lp, numNZ, rowlist, collist, vallist); 
+	
+	return status;	
+
+}
+
+//------------------------------------------------------------------------------
+// Change lower ('L'), upper ('U') or both ('B') bounds of columns
+int cChgBds(CPXENVptr env, CPXLPptr lp, int cnt, int *indices, char *lu, double *bd) {
+
+	return CPXchgbds(env, lp, cnt, indices, lu, bd);
+}
+
+//------------------------------------------------------------------------------
+// Change the right hand side of rows
+int cChgRhs(CPXENVptr env, CPXLPptr lp, int cnt, int *indices, double *values) {
+
+	return CPXchgrhs(env, lp, cnt, indices, values);
+}
+
+//------------------------------------------------------------------------------
+// Change the sense of rows
+int cChgSense(CPXENVptr env, CPXLPptr lp, int cnt, int *indices, char *sense) {
+
+	return CPXchgsense(env, lp, cnt, indices, sense);
+}
+
+//------------------------------------------------------------------------------
+// Change the range value of rows
+int cChgRngVal(CPXENVptr env, CPXLPptr lp, int cnt, int *indices, double *values) {
+
+	return CPXchgrngval(env, lp, cnt, indices, values);
+}
+
+//------------------------------------------------------------------------------
+// Change objective function coefficients
+int cChgObj(CPXENVptr env, CPXLPptr lp, int cnt, int *indices, double *values) {
+
+	return CPXchgobj(env, lp, cnt, indices, values);
+}
+
+//------------------------------------------------------------------------------
+// Problem types. mipProbType returns the type of a problem once it has integer
+// columns, contProbType the type once all its columns are continuous, and
+// quadProbType the type once it has a quadratic objective. A problem with
+// quadratic constraints, which may also have a quadratic objective, stays a
+// QCP or MIQCP.
+static int mipProbType(int type) {
+
+	switch (type) {
+	case CPXPROB_LP:
+		return CPXPROB_MILP;
+	case CPXPROB_QP:
+		return CPXPROB_MIQP;
+	case CPXPROB_QCP:
+		return CPXPROB_MIQCP;
+	}
+
+	return type;
+}
+
+static int contProbType(int type) {
+
+	switch (type) {
+	case CPXPROB_MILP:
+		return CPXPROB_LP;
+	case CPXPROB_MIQP:
+		return CPXPROB_QP;
+	case CPXPROB_MIQCP:
+		return CPXPROB_QCP;
+	}
+
+	return type;
+}
+
+static int quadProbType(int type) {
+
+	switch (type) {
+	case CPXPROB_LP:
+		return CPXPROB_QP;
+	case CPXPROB_MILP:
+		return CPXPROB_MIQP;
+	}
+
+	return type;
+}
+
+//------------------------------------------------------------------------------
+// Change the type of columns. As in cCreateCols, a continuous problem only
+// becomes a MIP once a column is not continuous, and it is turned back into a
+// continuous problem once all columns are continuous, so that CPXlpopt or
+// CPXqpopt can be used again, unless it has special ordered sets, indicator
+// or piecewise linear constraints.
+int cChgCType(CPXENVptr env, CPXLPptr lp, int cnt, int *indices, char *ctype) {
+
+	int  status   = 0;
+	int  isMip    = 0;
+	int  numCols  = 0;
+	int  type     = CPXgetprobtype(env, lp);
+	int  i;
+	char *allTypes;
+
+	for (i = 0; i < cnt; i++) {
+		if (ctype[i] != 'C') {
+			isMip = 1;
+		}
+	}
+
+	if (mipProbType(type) != type) {
+		if (!isMip) {
+			return 0;
+		}
+		status = CPXchgprobtype(env, lp, mipProbType(type));
+		if (status) {
+			return status;
+		}
+	}
+
+	status = CPXchgctype(env, lp, cnt, indices, ctype);
+	type   = CPXgetprobtype(env, lp);
+	if (status || isMip || contProbType(type) == type) {
+		return status;
+	}
+
+	if (CPXgetnumsos(env, lp) > 0 || CPXgetnumindconstrs(env, lp) > 0 || CPXgetnumpwl(env, lp) > 0) {
+		return 0;
+	}
+
+	numCols  = CPXgetnumcols(env, lp);
+	allTypes = malloc(numCols);
+	if (allTypes == NULL) {
+		return CPXERR_NO_MEMORY;
+	}
+
+	status = CPXgetctype(env, lp, allTypes, 0, numCols - 1);
+	for (i = 0; status == 0 && i < numCols; i++) {
+		if (allTypes[i] != 'C') {
+			free(allTypes);
+			return 0;
+		}
+	}
+	free(allTypes);
+
+	if (status) {
+		return status;
+	}
+
+	return CPXchgprobtype(env, lp, contProbType(type));
+}
+
+//------------------------------------------------------------------------------
+// Make the problem a QP or MIQP if it is an LP or MIP.
+static int makeQuad(CPXENVptr env, CPXLPptr lp) {
+
+	int type = CPXgetprobtype(env, lp);
+
+	if (quadProbType(type) != type) {
+		return CPXchgprobtype(env, lp, quadProbType(type));
+	}
+
+	return 0;
+}
+
+//------------------------------------------------------------------------------
+// Copy the quadratic objective matrix, column by column
+int cCopyQuad(CPXENVptr env, CPXLPptr lp, int *qmatbeg, int *qmatcnt, int *qmatind, double *qmatval) {
+
+	int status = makeQuad(env, lp);
+
+	if (status) {
+		return status;
+	}
+
+	return CPXcopyquad(env, lp, qmatbeg, qmatcnt, qmatind, qmatval);
+}
+
+//------------------------------------------------------------------------------
+// Change coefficients of the quadratic objective matrix
+int cChgQPCoef(CPXENVptr env, CPXLPptr lp, int cnt, int *rows, int *cols, double *values) {
+
+	int status = makeQuad(env, lp);
+	int i;
+
+	for (i = 0; status == 0 && i < cnt; i++) {
+		status = CPXchgqpcoef(env, lp, rows[i], cols[i], values[i]);
+	}
+
+	return status;
+}
+
+//------------------------------------------------------------------------------
+// Get the number of non-zero coefficients of the quadratic objective matrix
+int cGetNumQPNz(CPXENVptr env, CPXLPptr lp, int *numNz) {
+
+	*numNz = CPXgetnumqpnz(env, lp);
+
+	return 0;
+}
+
+//------------------------------------------------------------------------------
+// Get the quadratic objective matrix, column by column
+int cGetQuad(CPXENVptr env, CPXLPptr lp, int numCols, int numNz, int *qmatbeg, int *qmatind, double *qmatval) {
+
+	int nzcnt   = 0;
+	int surplus = 0;
+
+	return CPXgetquad(env, lp, &nzcnt, qmatbeg, qmatind, qmatval, numNz, &surplus, 0, numCols - 1);
+}
+
+//------------------------------------------------------------------------------
+// Add a quadratic constraint. Cplex turns the problem into a QCP or MIQCP.
+int cAddQConstr(CPXENVptr env, CPXLPptr lp, int linCnt, int quadCnt, double rhs, char sense,
+	int *linInd, double *linVal, int *quadRow, int *quadCol, double *quadVal, char *name) {
+
+	return CPXaddqconstr(env, lp, linCnt, quadCnt, rhs, sense, linInd, linVal, quadRow, quadCol, quadVal, name);
+}
+
+//------------------------------------------------------------------------------
+// Get the number of quadratic constraints
+int cGetNumQConstrs(CPXENVptr env, CPXLPptr lp, int *numQConstrs) {
+
+	*numQConstrs = CPXgetnumqconstrs(env, lp);
+
+	return 0;
+}
+
+//------------------------------------------------------------------------------
+// Get the name of a quadratic constraint, into a buffer of space characters.
+// With space set to 0, only the surplus is returned, from which the size of the
+// buffer is calculated.
+int cGetQConstrName(CPXENVptr env, CPXLPptr lp, int index, char *buf, int space, int *surplus) {
+
+	int status = CPXgetqconstrname(env, lp, buf, space, surplus, index);
+
+	if (status == CPXERR_NEGATIVE_SURPLUS && space == 0) {
+		return 0;
+	}
+
+	return status;
+}
+
+//------------------------------------------------------------------------------
+// Get the slacks of the quadratic constraints
+int cGetQConstrSlack(CPXENVptr env, CPXLPptr lp, int numQConstrs, double *slack) {
+
+	return CPXgetqconstrslack(env, lp, slack, 0, numQConstrs - 1);
+}
+
+//------------------------------------------------------------------------------
+// Get the dual slack vector of a quadratic constraint. With space set to 0,
+// only the surplus is returned, from which the number of non-zeros is
+// calculated.
+int cGetQConstrDSlack(CPXENVptr env, CPXLPptr lp, int index, int *ind, double *val, int space, int *surplus) {
+
+	int nz     = 0;
+	int status = CPXgetqconstrdslack(env, lp, index, &nz, ind, val, space, surplus);
+
+	if (status == CPXERR_NEGATIVE_SURPLUS && space == 0) {
+		return 0;
+	}
+
+	return status;
+}
+
+//------------------------------------------------------------------------------
+// Add an indicator constraint
+int cAddIndConstr(CPXENVptr env, CPXLPptr lp, int indVar, int complemented, int linCnt, double rhs, char sense,
+	int *linInd, double *linVal, char *name) {
+
+	return CPXaddindconstr(env, lp, indVar, complemented, linCnt, rhs, sense, linInd, linVal, name);
+}
+
+//------------------------------------------------------------------------------
+// Get the number of indicator constraints
+int cGetNumIndConstrs(CPXENVptr env, CPXLPptr lp, int *numIndConstrs) {
+
+	*numIndConstrs = CPXgetnumindconstrs(env, lp);
+
+	return 0;
+}
+
+//------------------------------------------------------------------------------
+// Get an indicator constraint, with space for the coefficients of its linear
+// constraint. With space set to 0, the surplus gives the number of
+// coefficients.
+int cGetIndConstr(CPXENVptr env, CPXLPptr lp, int index, int *indVar, int *complemented, double *rhs,
+	char *sense, int *linInd, double *linVal, int space, int *surplus) {
+
+	int nz     = 0;
+	int status = CPXgetindconstr(env, lp, indVar, complemented, &nz, rhs, sense, linInd, linVal, space,
+		surplus, index);
+
+	if (status == CPXERR_NEGATIVE_SURPLUS && space == 0) {
+		return 0;
+	}
+
+	return status;
+}
+
+//------------------------------------------------------------------------------
+// Get the name of an indicator constraint, as cGetQConstrName does
+int cGetIndConstrName(CPXENVptr env, CPXLPptr lp, int index, char *buf, int space, int *surplus) {
+
+	int status = CPXgetindconstrname(env, lp, buf, space, surplus, index);
+
+	if (status == CPXERR_NEGATIVE_SURPLUS && space == 0) {
+		return 0;
+	}
+
+	return status;
+}
+
+//------------------------------------------------------------------------------
+// Delete a range of indicator constraints
+int cDelIndConstrs(CPXENVptr env, CPXLPptr lp, int begin, int end) {
+
+	return CPXdelindconstrs(env, lp, begin, end);
+}
+
+//------------------------------------------------------------------------------
+// Add special ordered sets, making the problem a MIP first if it is continuous
+int cAddSOS(CPXENVptr env, CPXLPptr lp, int numSOS, int numNz, char *types, int *beg, int *ind, double *wt,
+	char **names) {
+
+	int type = CPXgetprobtype(env, lp);
+
+	if (mipProbType(type) != type) {
+		int status = CPXchgprobtype(env, lp, mipProbType(type));
+		if (status) {
+			return status;
+		}
+	}
+
+	return CPXaddsos(env, lp, numSOS, numNz, types, beg, ind, wt, names);
+}
+
+//------------------------------------------------------------------------------
+// Get the number of special ordered sets
+int cGetNumSOS(CPXENVptr env, CPXLPptr lp, int *numSOS) {
+
+	*numSOS = CPXgetnumsos(env, lp);
+
+	return 0;
+}
+
+//------------------------------------------------------------------------------
+// Get the number of members of all special ordered sets
+int cGetNumSOSNz(CPXENVptr env, CPXLPptr lp, int numSOS, int *numNz) {
+
+	int nz      = 0;
+	int surplus = 0;
+	int status  = CPXgetsos(env, lp, &nz, NULL, NULL, NULL, NULL, 0, &surplus, 0, numSOS - 1);
+
+	if (status != CPXERR_NEGATIVE_SURPLUS && status != 0) {
+		return status;
+	}
+
+	*numNz = -surplus;
+
+	return 0;
+}
+
+//------------------------------------------------------------------------------
+// Get all special ordered sets, set by set
+int cGetSOS(CPXENVptr env, CPXLPptr lp, int numSOS, int numNz, char *types, int *beg, int *ind, double *wt) {
+
+	int nz      = 0;
+	int surplus = 0;
+
+	return CPXgetsos(env, lp, &nz, types, beg, ind, wt, numNz, &surplus, 0, numSOS - 1);
+}
+
+//------------------------------------------------------------------------------
+// Get the size of the names of all special ordered sets, 0 if they have none
+int cGetSOSNameSurplus(CPXENVptr env, CPXLPptr lp, int numSOS, int *surplus) {
+
+	int status = CPXgetsosname(env, lp, NULL, NULL, 0, surplus, 0, numSOS - 1);
+
+	if (status == CPXERR_NO_NAMES) {
+		*surplus = 0;
+		return 0;
+	}
+	if (status != CPXERR_NEGATIVE_SURPLUS && status != 0) {
+		return status;
+	}
+
+	return 0;
+}
+
+//------------------------------------------------------------------------------
+// Get the names of all special ordered sets
+int cGetSOSNames(CPXENVptr env, CPXLPptr lp, int numSOS, char **names, char *store, int storeSize) {
+
+	int surplus = 0;
+
+	if (storeSize <= 0) {
+		return CPXERR_NO_NAMES;
+	}
+
+	return CPXgetsosname(env, lp, names, store, storeSize, &surplus, 0, numSOS - 1);
+}
+
+//------------------------------------------------------------------------------
+// Delete a range of special ordered sets
+int cDelSOS(CPXENVptr env, CPXLPptr lp, int begin, int end) {
+
+	return CPXdelsos(env, lp, begin, end);
+}
+
+//------------------------------------------------------------------------------
+// Add a piecewise linear constraint, making the problem a MIP first if it is
+// continuous
+int cAddPwl(CPXENVptr env, CPXLPptr lp, int yCol, int xCol, double preSlope, double postSlope, int numBreaks,
+	double *breakX, double *breakY, char *name) {
+
+	int type = CPXgetprobtype(env, lp);
+
+	if (mipProbType(type) != type) {
+		int status = CPXchgprobtype(env, lp, mipProbType(type));
+		if (status) {
+			return status;
+		}
+	}
+
+	return CPXaddpwl(env, lp, yCol, xCol, preSlope, postSlope, numBreaks, breakX, breakY, name);
+}
+
+//------------------------------------------------------------------------------
+// Get the number of piecewise linear constraints
+int cGetNumPwl(CPXENVptr env, CPXLPptr lp, int *numPwl) {
+
+	*numPwl = CPXgetnumpwl(env, lp);
+
+	return 0;
+}
+
+//------------------------------------------------------------------------------
+// Get a piecewise linear constraint, with space for its breakpoints. With space
+// set to 0, the surplus gives the number of breakpoints.
+int cGetPwl(CPXENVptr env, CPXLPptr lp, int index, int *yCol, int *xCol, double *preSlope, double *postSlope,
+	double *breakX, double *breakY, int space, int *surplus) {
+
+	int numBreaks = 0;
+	int status    = CPXgetpwl(env, lp, index, yCol, xCol, preSlope, postSlope, &numBreaks, breakX, breakY,
+		space, surplus);
+
+	if (status == CPXERR_NEGATIVE_SURPLUS && space == 0) {
+		return 0;
+	}
+
+	return status;
+}
+
+//------------------------------------------------------------------------------
+// Get the name of a piecewise linear constraint, as cGetQConstrName does
+int cGetPwlName(CPXENVptr env, CPXLPptr lp, int index, char *buf, int space, int *surplus) {
+
+	int status = CPXgetpwlname(env, lp, buf, space, surplus, index);
+
+	if (status == CPXERR_NEGATIVE_SURPLUS && space == 0) {
+		return 0;
+	}
+
+	return status;
+}
+
+//------------------------------------------------------------------------------
+// Delete a range of piecewise linear constraints
+int cDelPwl(CPXENVptr env, CPXLPptr lp, int begin, int end) {
+
+	return CPXdelpwl(env, lp, begin, end);
+}
+
+//------------------------------------------------------------------------------
+// Add MIP starts
+int cAddMIPStarts(CPXENVptr env, CPXLPptr lp, int numStarts, int numNz, int *beg, int *ind, double *val,
+	int *effort, char **names) {
+
+	return CPXaddmipstarts(env, lp, numStarts, numNz, beg, ind, val, effort, names);
+}
+
+//------------------------------------------------------------------------------
+// Change MIP starts
+int cChgMIPStarts(CPXENVptr env, CPXLPptr lp, int numStarts, int *indices, int numNz, int *beg, int *ind,
+	double *val, int *effort) {
+
+	return CPXchgmipstarts(env, lp, numStarts, indices, numNz, beg, ind, val, effort);
+}
+
+//------------------------------------------------------------------------------
+// Get the number of MIP starts
+int cGetNumMIPStarts(CPXENVptr env, CPXLPptr lp, int *numStarts) {
+
+	*numStarts = CPXgetnummipstarts(env, lp);
+
+	return 0;
+}
+
+//------------------------------------------------------------------------------
+// Get the number of values of all MIP starts
+int cGetNumMIPStartNz(CPXENVptr env, CPXLPptr lp, int numStarts, int *numNz) {
+
+	int nz      = 0;
+	int surplus = 0;
+	int status  = CPXgetmipstarts(env, lp, &nz, NULL, NULL, NULL, NULL, 0, &surplus, 0, numStarts - 1);
+
+	if (status != CPXERR_NEGATIVE_SURPLUS && status != 0) {
+		return status;
+	}
+
+	*numNz = -surplus;
+
+	return 0;
+}
+
+//------------------------------------------------------------------------------
+// Get all MIP starts, start by start
+int cGetMIPStarts(CPXENVptr env, CPXLPptr lp, int numStarts, int numNz, int *beg, int *ind, double *val,
+	int *effort) {
+
+	int nz      = 0;
+	int surplus = 0;
+
+	return CPXgetmipstarts(env, lp, &nz, beg, ind, val, effort, numNz, &surplus, 0, numStarts - 1);
+}
+
+//------------------------------------------------------------------------------
+// Get the name of a MIP start into buf, or only its size in surplus if space
+// is 0
+int cGetMIPStartName(CPXENVptr env, CPXLPptr lp, int index, char *buf, int space, int *surplus) {
+
+	char *name   = NULL;
+	int   status = CPXgetmipstartname(env, lp, space > 0 ? &name : NULL, buf, space, surplus, index, index);
+
+	if (status == CPXERR_NO_NAMES) {
+		*surplus = 0;
+		return 0;
+	}
+	if (status == CPXERR_NEGATIVE_SURPLUS && space == 0) {
+		return 0;
+	}
+
+	return status;
+}
+
+//------------------------------------------------------------------------------
+// Read MIP starts from an MST file
+int cReadMIPStarts(CPXENVptr env, CPXLPptr lp, char *cFileName) {
+
+	return CPXreadcopymipstarts(env, lp, cFileName);
+}
+
+//------------------------------------------------------------------------------
+// Write all MIP starts to an MST file
+int cWriteMIPStarts(CPXENVptr env, CPXLPptr lp, char *cFileName, int numStarts) {
+
+	return CPXwritemipstarts(env, lp, cFileName, 0, numStarts - 1);
+}
+
+//------------------------------------------------------------------------------
+// Populate the solution pool
+int cPopulate(CPXENVptr env, CPXLPptr lp) {
+
+	return CPXpopulate(env, lp);
+}
+
+//------------------------------------------------------------------------------
+// Get the number of solutions in the solution pool
+int cGetPoolNumSolns(CPXENVptr env, CPXLPptr lp, int *numSolns) {
+
+	*numSolns = CPXgetsolnpoolnumsolns(env, lp);
+
+	return 0;
+}
+
+//------------------------------------------------------------------------------
+// Get the objective value, column values and slacks of a pool solution
+int cGetPoolSolution(CPXENVptr env, CPXLPptr lp, int soln, double *objVal, int numCols, double *x,
+	int numRows, double *slack) {
+
+	int status = CPXgetsolnpoolobjval(env, lp, soln, objVal);
+
+	if (status == 0 && numCols > 0) {
+		status = CPXgetsolnpoolx(env, lp, soln, x, 0, numCols - 1);
+	}
+	if (status == 0 && numRows > 0) {
+		status = CPXgetsolnpoolslack(env, lp, soln, slack, 0, numRows - 1);
+	}
+
+	return status;
+}
+
+//------------------------------------------------------------------------------
+// Add a diversity filter to the solution pool
+int cAddPoolDivFilter(CPXENVptr env, CPXLPptr lp, double lowerBound, double upperBound, int numNz, int *ind,
+	double *weight, double *refVal, char *name) {
+
+	return CPXaddsolnpooldivfilter(env, lp, lowerBound, upperBound, numNz, ind, weight, refVal, name);
+}
+
+//------------------------------------------------------------------------------
+// Add a range filter to the solution pool
+int cAddPoolRngFilter(CPXENVptr env, CPXLPptr lp, double lowerBound, double upperBound, int numNz, int *ind,
+	double *val, char *name) {
+
+	return CPXaddsolnpoolrngfilter(env, lp, lowerBound, upperBound, numNz, ind, val, name);
+}
+
+//------------------------------------------------------------------------------
+// Get the number of solution pool filters
+int cGetPoolNumFilters(CPXENVptr env, CPXLPptr lp, int *numFilters) {
+
+	*numFilters = CPXgetsolnpoolnumfilters(env, lp);
+
+	return 0;
+}
+
+//------------------------------------------------------------------------------
+// Delete a range of solution pool filters
+int cDelPoolFilters(CPXENVptr env, CPXLPptr lp, int begin, int end) {
+
+	return CPXdelsolnpoolfilters(env, lp, begin, end);
+}
+
+//------------------------------------------------------------------------------
+// Change the names of columns
+int cChgColName(CPXENVptr env, CPXLPptr lp, int cnt, int *indices, char **names) {
+
+	return CPXchgcolname(env, lp, cnt, indices, names);
+}
+
+//------------------------------------------------------------------------------
+// Change the names of rows
+int cChgRowName(CPXENVptr env, CPXLPptr lp, int cnt, int *indices, char **names) {
+
+	return CPXchgrowname(env, lp, cnt, indices, names);
+}
+
+//------------------------------------------------------------------------------
+// Add rows with their coefficients. CPXaddrows takes no range values, which
+// are set afterwards for the ranged rows.
+int cAddRows(CPXENVptr env, CPXLPptr lp, int numRows, int numNZ, double *rhs, char *sense, double *rngVal,
+	int *matbeg, int *matind, double *matval, char **rowName) {
+
+	int status = 0;
+	int first  = CPXgetnumrows(env, lp);
+	int i, row;
+
+	status = CPXaddrows(env, lp, 0, numRows, numNZ, rhs, sense, matbeg, matind, matval, NULL, rowName);
+
+	for (i = 0; status == 0 && i < numRows; i++) {
+		if (sense[i] == 'R') {
+			row    = first + i;
+			status = CPXchgrngval(env, lp, 1, &row, &rngVal[i]);
+		}
+	}
+
+	return status;
+}
+
+//------------------------------------------------------------------------------
+// Add columns with their coefficients. CPXaddcols takes no types, which are set
+// afterwards with cChgCType if any column is not continuous.
+int cAddCols(CPXENVptr env, CPXLPptr lp, int isMip, int numCols, int numNZ, double *obj, int *matbeg, int *matind,
+	double *matval, double *lb, double *ub, char **colName, char *type) {
+
+	int status = 0;
+	int first  = CPXgetnumcols(env, lp);
+	int i;
+	int *indices;
+
+	status = CPXaddcols(env, lp, numCols, numNZ, obj, matbeg, matind, matval, lb, ub, colName);
+	if (status || !isMip) {
+		return status;
+	}
+
+	indices = malloc(numCols * sizeof(int));
+	if (indices == NULL) {
+		return CPXERR_NO_MEMORY;
+	}
+	for (i = 0; i < numCols; i++) {
+		indices[i] = first + i;
+	}
+
+	status = cChgCType(env, lp, numCols, indices, type);
+	free(indices);
+
+	return status;
+}
+
+//------------------------------------------------------------------------------
+// Delete a range of rows
+int cDelRows(CPXENVptr env, CPXLPptr lp, int begin, int end) {
+
+	return CPXdelrows(env, lp, begin, end);
+}
+
+//------------------------------------------------------------------------------
+// Delete a range of columns
+int cDelCols(CPXENVptr env, CPXLPptr lp, int begin, int end) {
+
+	return CPXdelcols(env, lp, begin, end);
+}
+
+//------------------------------------------------------------------------------
+// Delete the rows set to 1 in delstat, which returns the new row indices
+int cDelSetRows(CPXENVptr env, CPXLPptr lp, int *delstat) {
+
+	return CPXdelsetrows(env, lp, delstat);
+}
+
+//------------------------------------------------------------------------------
+// Delete the columns set to 1 in delstat, which returns the new column indices
+int cDelSetCols(CPXENVptr env, CPXLPptr lp, int *delstat) {
+
+	return CPXdelsetcols(env, lp, delstat);
+}
+
+//------------------------------------------------------------------------------
+// Optimize a linear problem (continuous variables only).
+int cLpOpt(CPXENVptr env, CPXLPptr lp) {
+
+	int status = 0;
+	
+	status = CPXlpopt(env, lp);	
+
+	return status;	
+}
+
+//------------------------------------------------------------------------------
+// Optimize with the primal simplex.
+int cPrimOpt(CPXENVptr env, CPXLPptr lp) {
+
+	return CPXprimopt(env, lp);
+}
+
+//------------------------------------------------------------------------------
+// Optimize with the dual simplex.
+int cDualOpt(CPXENVptr env, CPXLPptr lp) {
+
+	return CPXdualopt(env, lp);
+}
+
+//------------------------------------------------------------------------------
+// Optimize with the barrier and the crossover set by the parameters.
+int cBarOpt(CPXENVptr env, CPXLPptr lp) {
+
+	return CPXbaropt(env, lp);
+}
+
+//------------------------------------------------------------------------------
+// Optimize with the barrier and the given crossover, CPX_ALG_NONE for none.
+int cHybBarOpt(CPXENVptr env, CPXLPptr lp, int method) {
+
+	return CPXhybbaropt(env, lp, method);
+}
+
+//------------------------------------------------------------------------------
+// Optimize with the network optimizer, then the given simplex method.
+int cHybNetOpt(CPXENVptr env, CPXLPptr lp, int method) {
+
+	return CPXhybnetopt(env, lp, method);
+}
+
+//------------------------------------------------------------------------------
+// Optimize a quadratic problem.
+int cQPOpt(CPXENVptr env, CPXLPptr lp) {
+
+	return CPXqpopt(env, lp);
+}
+
+//------------------------------------------------------------------------------
+// Optimize a mixed integer problem.
+int cMipOpt(CPXENVptr env, CPXLPptr lp) {
+
+	int status = 0;
+	
+	status = CPXmipopt(env, lp);	
+
+	return status;	
+}
+
+//------------------------------------------------------------------------------
+// Get number of rows
+int cGetNumRows(CPXENVptr env, CPXLPptr lp, int *numRows) {
+
+	int cur_numrows;
+	
+	*numRows = 0;
+	cur_numrows = CPXgetnumrows(env, lp);
+	
+	*numRows = cur_numrows;
+	
+	return 0;
+}
+
+//------------------------------------------------------------------------------
+// Get number of columns
+int cGetNumCols(CPXENVptr env, CPXLPptr lp, int *numCols) {
+
+	int cur_numcols;
+
+	*numCols = 0;	
+	cur_numcols = CPXgetnumcols(env, lp);
+	
+	*numCols = cur_numcols;
+	
+	return 0;
+}
+
+//------------------------------------------------------------------------------
+int cGetColNameSurplus(CPXENVptr env, CPXLPptr lp, int numCols, int *surplus) {
+	int status;
+	
+	status = CPXgetcolname(env, lp, NULL, NULL, 0, surplus, 0, numCols - 1);
+    if (( status != CPXERR_NEGATIVE_SURPLUS ) && ( status != 0 ))  {
+    	return status;
+	}
+
+	// Switch "negative surplus 'error'" to "this is not an error".
+	return 0;	
+}
+
+//------------------------------------------------------------------------------
+int cGetRowNameSurplus(CPXENVptr env, CPXLPptr lp, int numRows, int *surplus) {
+	int status;
+	
+	status = CPXgetrowname(env, lp, NULL, NULL, 0, surplus, 0, numRows - 1);
+    if (( status != CPXERR_NEGATIVE_SURPLUS ) && ( status != 0 ))  {
+    	return status;
+	}
+
+	// Switch "negative surplus 'error'" to "this is not an error".
+	return 0;	
+}
+
+//------------------------------------------------------------------------------
+// Get objective function value
+int cGetObjVal(CPXENVptr env, CPXLPptr lp, double *objval) {
+
+	int status;
+
+	status = CPXgetobjval(env, lp, objval);
+		
+	return status;
+}
+
+//------------------------------------------------------------------------------
+// Get solution status, 0 if no solution exists
+int cGetStat(CPXENVptr env, CPXLPptr lp) {
+
+	return CPXgetstat(env, lp);
+}
+
+//------------------------------------------------------------------------------
+// Get solution method, type and feasibility
+int cSolnInfo(CPXENVptr env, CPXLPptr lp, int *method, int *type, int *pfeas, int *dfeas) {
+
+	int status;
+
+	status = CPXsolninfo(env, lp, method, type, pfeas, dfeas);
+		
+	return status;
+}
+
+//------------------------------------------------------------------------------
+// Get best bound on the objective function value of a MIP
+int cGetBestObjVal(CPXENVptr env, CPXLPptr lp, double *objval) {
+
+	int status;
+
+	status = CPXgetbestobjval(env, lp, objval);
+		
+	return status;
+}
+
+//------------------------------------------------------------------------------
+// Get relative gap of a MIP solution
+int cGetMipRelGap(CPXENVptr env, CPXLPptr lp, double *gap) {
+
+	int status;
+
+	status = CPXgetmiprelgap(env, lp, gap);
+		
+	return status;
+}
+
+//------------------------------------------------------------------------------
+// Get Solution
+int cGetSolution(CPXENVptr env, CPXLPptr lp, double *objval, double *x, double *dj, double *pi, double *slack) {
+
+	int status;
+
+	// The solution status is obtained separately through cGetStat.
+	status = CPXsolution(env, lp, NULL, objval, x, pi, slack, dj);
+		
+	return status;
+}
+
+//------------------------------------------------------------------------------
+
+int cGetColNames(CPXENVptr env, CPXLPptr lp, int numCols, char **cur_colname, char *cur_colnamestore, int storeSize) {
+	
+	int status;
+	int surplus, cur_colspace;
+	int j;	
+
+	if (storeSize > 0) {
+
+	    status = CPXgetcolname (env, lp, cur_colname, cur_colnamestore, 
+					storeSize, &surplus, 0,numCols-1);
+
+	}
+	else {
+		return CPXERR_NO_NAMES;
+	}
+
+	return status;	
+}
+
+//------------------------------------------------------------------------------
+
+int cGetRowNames(CPXENVptr env, CPXLPptr lp, int numRows, char **cur_rowname, char *cur_rownamestore, int storeSize) {
+	
+	int status = 0;
+	int surplus;
+
+	if (storeSize > 0) {
+
+	    status = CPXgetrowname (env, lp, cur_rowname, cur_rownamestore, 
+					storeSize, &surplus, 0,numRows-1);
+
+	}
+	else {
+		return CPXERR_NO_NAMES;
+	}
+
+	return status;	
+}
+
+
+//------------------------------------------------------------------------------
+// Get the value of the variables
+int cGetX(CPXENVptr env, CPXLPptr lp, int numCols, double *cColVal) {
+
+	int status = 0;
+		
+	status = CPXgetx(env, lp, cColVal, 0, numCols - 1);
+
+	return status;	
+}
+
+//------------------------------------------------------------------------------
+// Get the slack of the rows
+int cGetSlack(CPXENVptr env, CPXLPptr lp, int numRows, double *cSlack) {
+
+	int status = 0;
+		
+	status = CPXgetslack(env, lp, cSlack, 0, numRows - 1);
+
+	return status;	
+}
+
+//------------------------------------------------------------------------------
+// Get the objective sense, CPX_MIN or CPX_MAX
+int cGetObjSen(CPXENVptr env, CPXLPptr lp, int *sense) {
+
+	*sense = CPXgetobjsen(env, lp);
+	if (*sense == 0) {
+		return CPXERR_NO_PROBLEM;
+	}
+
+	return 0;
+}
+
+//------------------------------------------------------------------------------
+// Get the sense, right hand side and range value of all rows
+int cGetRows(CPXENVptr env, CPXLPptr lp, int numRows, char *sense, double *rhs, double *rngval) {
+
+	int status = 0;
+
+	if (numRows == 0) {
+		return 0;
+	}
+
+	status = CPXgetsense(env, lp, sense, 0, numRows - 1);
+	if (status == 0) {
+		status = CPXgetrhs(env, lp, rhs, 0, numRows - 1);
+	}
+	if (status == 0) {
+		status = CPXgetrngval(env, lp, rngval, 0, numRows - 1);
+	}
+
+	return status;
+}
+
+//------------------------------------------------------------------------------
+// Get the type and bounds of all columns. The columns of a problem which is not
+// a MIP have no types in Cplex, and are reported as continuous.
+int cGetCols(CPXENVptr env, CPXLPptr lp, int numCols, char *ctype, double *lb, double *ub) {
+
+	int status = 0;
+
+	if (numCols == 0) {
+		return 0;
+	}
+
+	status = CPXgetlb(env, lp, lb, 0, numCols - 1);
+	if (status == 0) {
+		status = CPXgetub(env, lp, ub, 0, numCols - 1);
+	}
+	if (status == 0) {
+		status = CPXgetctype(env, lp, ctype, 0, numCols - 1);
+		if (status == CPXERR_NOT_MIP) {
+			memset(ctype, 'C', numCols);
+			status = 0;
+		}
+	}
+
+	return status;
+}
+
+//------------------------------------------------------------------------------
+// Get the objective function coefficients of all columns
+int cGetObj(CPXENVptr env, CPXLPptr lp, int numCols, double *obj) {
+
+	if (numCols == 0) {
+		return 0;
+	}
+
+	return CPXgetobj(env, lp, obj, 0, numCols - 1);
+}
+
+//------------------------------------------------------------------------------
+// Get the number of non-zero coefficients
+int cGetNumNz(CPXENVptr env, CPXLPptr lp, int *numNz) {
+
+	*numNz = CPXgetnumnz(env, lp);
+
+	return 0;
+}
+
+//------------------------------------------------------------------------------
+// Get the non-zero coefficients of all columns, column by column
+int cGetCoefs(CPXENVptr env, CPXLPptr lp, int numCols, int numNz, int *matbeg, int *matind, double *matval) {
+
+	int nzcnt   = 0;
+	int surplus = 0;
+
+	return CPXgetcols(env, lp, &nzcnt, matbeg, matind, matval, numNz, &surplus, 0, numCols - 1);
+}
+
+//------------------------------------------------------------------------------
+// Get the objective ranges of all columns
+int cObjSA(CPXENVptr env, CPXLPptr lp, int numCols, double *lower, double *upper) {
+
+	return CPXobjsa(env, lp, 0, numCols - 1, lower, upper);
+}
+
+//------------------------------------------------------------------------------
+// Get the right hand side ranges of all rows
+int cRhsSA(CPXENVptr env, CPXLPptr lp, int numRows, double *lower, double *upper) {
+
+	return CPXrhssa(env, lp, 0, numRows - 1, lower, upper);
+}
+
+//------------------------------------------------------------------------------
+// Get the bound ranges of all columns
+int cBoundSA(CPXENVptr env, CPXLPptr lp, int numCols, double *lbLower, double *lbUpper, double *ubLower, double *ubUpper) {
+
+	return CPXboundsa(env, lp, 0, numCols - 1, lbLower, lbUpper, ubLower, ubUpper);
+}
+
+
+//------------------------------------------------------------------------------
+
+int cReadCopyProb(CPXENVptr env, CPXLPptr lp, char *cFileName, char *cFileType) {
+
+	int status = 0;
+		
+	status = CPXreadcopyprob(env, lp, cFileName, cFileType);
+
+	return status;	
+}
+
+//------------------------------------------------------------------------------
+
+int cWriteProb(CPXENVptr env, CPXLPptr lp, char *cFileName, char *cFileType) {
+
+	int status = 0;
+		
+	status = CPXwriteprob(env, lp, cFileName, cFileType);
+
+	return status;	
+}
+
+//------------------------------------------------------------------------------
+
+int cSolWrite(CPXENVptr env, CPXLPptr lp, char *cFileName) {
+	
+	int status = 0;
+	
+	status = CPXsolwrite(env, lp, cFileName);
+	
+	return status;
+
+}
+
+//------------------------------------------------------------------------------
+// Get the basis status of all columns and rows
+int cGetBase(CPXENVptr env, CPXLPptr lp, int *cstat, int *rstat) {
+
+	return CPXgetbase(env, lp, cstat, rstat);
+}
+
+//------------------------------------------------------------------------------
+// Copy a starting basis for the next optimization
+int cCopyBase(CPXENVptr env, CPXLPptr lp, int *cstat, int *rstat) {
+
+	return CPXcopybase(env, lp, cstat, rstat);
+}
+
+//------------------------------------------------------------------------------
+// Read a starting basis from an MPS basis file
+int cReadBasis(CPXENVptr env, CPXLPptr lp, char *cFileName) {
+
+	return CPXreadcopybase(env, lp, cFileName);
+}
+
+//------------------------------------------------------------------------------
+// Write the basis to an MPS basis file
+int cWriteBasis(CPXENVptr env, CPXLPptr lp, char *cFileName) {
+
+	return CPXmbasewrite(env, lp, cFileName);
+}
+
+//------------------------------------------------------------------------------
+// Free the problem. This should be called from the Go functions and not from
+// the C functions if an error condition occurs.
+int cFreeProb(CPXENVptr env, CPXLPptr *lp) {
+
+	int status = 0;
+
+	if (*lp != NULL) {
+		status = CPXfreeprob (env, lp);
+	}
+
+	return status;
+}
+
+//------------------------------------------------------------------------------
+// Clean up and terminate CPLEX. This should be called from the Go functions
+// and not from the C functions if an error condition occurs. All problems
+// created in the environment must have been freed before this is called.
+int cCloseCplex(CPXENVptr *env) {
+
+	int status = 0;
+
+	if (*env != NULL) {
+		status = CPXcloseCPLEX(env);
+	}
+
+	return status;
+	
+} // End cCloseCplex
+
+
+ */
+import "C"
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// CplexBackend is the Backend which solves problems with the Cplex Callable
+// Library. It is only available when gpx is built with the "cplex" build tag,
+// in which case it is also installed as the DefaultBackend.
+var CplexBackend Backend = cplexBackend{}
+
+func init() {
+	DefaultBackend = CplexBackend
+}
+
+// cplexBackend implements Backend using the Cplex Callable Library.
+type cplexBackend struct{}
+
+// cplexEnv implements BackendEnv for a single Cplex environment.
+type cplexEnv struct {
+	env             C.CPXENVptr               // Pointer to the Cplex environment
+	logFn           func(LogChannel, string)  // Destination of the messages, nil if none
+	logDests        [4]C.uintptr_t            // Handles of the function destinations, 0 if none
+	progressFn      func(Progress)            // Destination of the progress events, nil if none
+	progressHandle  C.uintptr_t               // Handle passed to the progress callbacks, 0 if none
+}
+
+// cplexProb implements Solver for a single Cplex problem.
+type cplexProb struct {
+	env     *cplexEnv     // Environment in which the problem was created
+	lp      C.CPXLPptr    // Pointer to the Cplex problem
+}
+
+//==============================================================================
+
+// cplexError returns a CplexError for the status returned by Cplex when
+// operation op failed. The environment may be nil, e.g. if it could not be
+// opened.
+// This function uses CPXgeterrorstring.
+func cplexError(env C.CPXENVptr, op string, status C.int) error {
+
+	buf := make([]C.char, C.CPXMESSAGEBUFSIZE)
+	C.cGetErrorString(env, status, &buf[0])
+
+	return &CplexError{Op: op, Code: int(status), Msg: strings.TrimSpace(C.GoString(&buf[0]))}
+}
+
+//==============================================================================
+// ENVIRONMENT FUNCTIONS
+//==============================================================================
+
+// OpenEnv initializes a new Cplex environment with data checking turned on.
+// This function uses CPXopenCPLEX and CPXsetintparam.
+func (cplexBackend) OpenEnv() (BackendEnv, error) {
+	var status C.int      // Status returned from Cplex
+
+	e := &cplexEnv{}
+
+	status = C.cOpenCplex(&e.env)
+	if status != 0 {
+		return nil, cplexError(e.env, "OpenCplex", status)	
+	}
+
+	status = C.cCheckData(e.env)
+	if status != 0 {
+		err := cplexError(e.env, "OpenCplex", status)
+		_ = C.cCloseCplex(&e.env)
+		return nil, err
+	}	
+
+	return e, nil
+}
+
+//==============================================================================
+
+// CreateProb creates a new, empty Cplex problem.
+// This function uses CPXcreateprob.
+func (e *cplexEnv) CreateProb(name string) (Solver, error) {
+	var status C.int      // Status returned from Cplex
+
+	p := &cplexProb{env: e}
+
+	cString := C.CString(name)
+	defer C.free(unsafe.Pointer(cString))
+	status = C.cCreateProb(e.env, &p.lp, cString)
+	if status != 0 {
+		return nil, cplexError(e.env, "CreateProb", status)
+	}	
+
+	return p, nil
+}
+
+//==============================================================================
+
+// OutputToScreen turns Cplex output to screen on or off.
+// This function uses CPXsetintparam with CPXPARAM_ScreenOutput.
+func (e *cplexEnv) OutputToScreen(echoOn bool) error {
+	var status, cEchoState C.int  // Status returned from Cplex
+
+	// CPX_ON = 1, CPX_OFF = 0, but unfortunately we can't call these constants
+	// here, and to be on the safe side, we will re-map on the C side in case Cplex changes.		
+	if echoOn {
+		cEchoState = 1	
+	} else {
+		cEchoState = 0
+	}
+	
+	status = C.cOutputToScreen(e.env, cEchoState)
+	if status != 0 {
+		return cplexError(e.env, "OutputToScreen", status)
+	}	
+
+	return nil	
+}
+
+//==============================================================================
+
+// SetIntParam sets an integer parameter.
+// This function uses CPXsetintparam.
+func (e *cplexEnv) SetIntParam(id int, value int) error {
+	var status C.int    // Status returned by Cplex
+
+	status = C.cSetIntParam(e.env, C.int(id), C.int(value))
+	if status != 0 {
+		return cplexError(e.env, "SetIntParam", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// SetDblParam sets a double parameter.
+// This function uses CPXsetdblparam.
+func (e *cplexEnv) SetDblParam(id int, value float64) error {
+	var status C.int    // Status returned by Cplex
+
+	status = C.cSetDblParam(e.env, C.int(id), C.double(value))
+	if status != 0 {
+		return cplexError(e.env, "SetDblParam", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// SetLongParam sets a long integer parameter.
+// This function uses CPXsetlongparam.
+func (e *cplexEnv) SetLongParam(id int, value int64) error {
+	var status C.int    // Status returned by Cplex
+
+	status = C.cSetLongParam(e.env, C.int(id), C.longlong(value))
+	if status != 0 {
+		return cplexError(e.env, "SetLongParam", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// SetStrParam sets a string parameter.
+// This function uses CPXsetstrparam.
+func (e *cplexEnv) SetStrParam(id int, value string) error {
+	var status C.int    // Status returned by Cplex
+
+	cValue := C.CString(value)
+	defer C.free(unsafe.Pointer(cValue))
+
+	status = C.cSetStrParam(e.env, C.int(id), cValue)
+	if status != 0 {
+		return cplexError(e.env, "SetStrParam", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// GetIntParam returns the value of an integer parameter.
+// This function uses CPXgetintparam.
+func (e *cplexEnv) GetIntParam(id int) (int, error) {
+	var cValue  C.int    // Value returned by Cplex
+	var status  C.int    // Status returned by Cplex
+
+	status = C.cGetIntParam(e.env, C.int(id), &cValue)
+	if status != 0 {
+		return 0, cplexError(e.env, "GetIntParam", status)
+	}
+
+	return int(cValue), nil
+}
+
+//==============================================================================
+
+// GetDblParam returns the value of a double parameter.
+// This function uses CPXgetdblparam.
+func (e *cplexEnv) GetDblParam(id int) (float64, error) {
+	var cValue  C.double // Value returned by Cplex
+	var status  C.int    // Status returned by Cplex
+
+	status = C.cGetDblParam(e.env, C.int(id), &cValue)
+	if status != 0 {
+		return 0, cplexError(e.env, "GetDblParam", status)
+	}
+
+	return float64(cValue), nil
+}
+
+//==============================================================================
+
+// GetLongParam returns the value of a long integer parameter.
+// This function uses CPXgetlongparam.
+func (e *cplexEnv) GetLongParam(id int) (int64, error) {
+	var cValue  C.longlong // Value returned by Cplex
+	var status  C.int      // Status returned by Cplex
+
+	status = C.cGetLongParam(e.env, C.int(id), &cValue)
+	if status != 0 {
+		return 0, cplexError(e.env, "GetLongParam", status)
+	}
+
+	return int64(cValue), nil
+}
+
+//==============================================================================
+
+// GetStrParam returns the value of a string parameter.
+// This function uses CPXgetstrparam.
+func (e *cplexEnv) GetStrParam(id int) (string, error) {
+	var status  C.int    // Status returned by Cplex
+
+	buf := make([]C.char, C.CPX_STR_PARAM_MAX)
+	status = C.cGetStrParam(e.env, C.int(id), &buf[0])
+	if status != 0 {
+		return "", cplexError(e.env, "GetStrParam", status)
+	}
+
+	return C.GoString(&buf[0]), nil
+}
+
+//==============================================================================
+
+// SetDefaults resets all parameters to their defaults and turns data checking
+// back on, as in a newly opened environment.
+// This function uses CPXsetdefaults and CPXsetintparam.
+func (e *cplexEnv) SetDefaults() error {
+	var status C.int    // Status returned by Cplex
+
+	status = C.cSetDefaults(e.env)
+	if status != 0 {
+		return cplexError(e.env, "SetDefaults", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// ReadParamFile sets the parameters listed in a parameter file.
+// This function uses CPXreadcopyparam.
+func (e *cplexEnv) ReadParamFile(fileName string) error {
+	var status C.int    // Status returned by Cplex
+
+	cFileName := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cFileName))
+
+	status = C.cReadParamFile(e.env, cFileName)
+	if status != 0 {
+		return cplexError(e.env, "ReadParamFile", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// WriteParamFile writes the non-default parameters to a parameter file.
+// This function uses CPXwriteparam.
+func (e *cplexEnv) WriteParamFile(fileName string) error {
+	var status C.int    // Status returned by Cplex
+
+	cFileName := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cFileName))
+
+	status = C.cWriteParamFile(e.env, cFileName)
+	if status != 0 {
+		return cplexError(e.env, "WriteParamFile", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// CloseEnv closes and cleans up the Cplex environment, after removing the
+// function destinations added by SetLogFunc and the callbacks installed by
+// SetProgressFunc.
+// This function uses CPXcloseCPLEX.
+func (e *cplexEnv) CloseEnv() error {
+	var status C.int    // Status returned by Cplex
+
+	_ = e.SetLogFunc(nil)
+	_ = e.SetProgressFunc(nil)
+
+	status = C.cCloseCplex(&e.env)
+	if status != 0 {
+		return cplexError(nil, "CloseCplex", status)	
+	}
+	
+	return nil
+}
+
+//==============================================================================
+// PROBLEM FUNCTIONS
+//==============================================================================
+
+// FreeProb releases the problem.
+// This function uses CPXfreeprob.
+func (p *cplexProb) FreeProb() error {
+	var status C.int    // Status returned by Cplex
+
+	status = C.cFreeProb(p.env.env, &p.lp)
+	p.lp = nil
+	if status != 0 {
+		return cplexError(p.env.env, "FreeProb", status)	
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// ChgProbName changes the name of the problem.
+// This function uses CPXchgprobname.
+func (p *cplexProb) ChgProbName(name string) error {
+	var status C.int    // Status returned from Cplex
+
+	cString := C.CString(name)
+	defer C.free(unsafe.Pointer(cString))
+	status = C.cChgProbName(p.env.env, p.lp, cString)
+	if status != 0 {
+		return cplexError(p.env.env, "ChgProbName", status)
+	}	
+			
+	return nil
+}
+
+//==============================================================================
+
+// ChgObjSen changes the sense of the objective function (1 = min, -1 = max).
+// This function uses CPXchgobjsen.
+func (p *cplexProb) ChgObjSen(sense int) error {
+	var status C.int   // Status returned from Cplex
+
+	status = C.cChgObjSen(p.env.env, p.lp, C.int(sense))
+	if status != 0 {
+		return cplexError(p.env.env, "ChgObjSen", status)
+	}	
+
+	return nil	
+}
+
+//==============================================================================
+
+// NewRows passes the rows to Cplex.
+// This function uses CPXnewrows.
+func (p *cplexProb) NewRows(rList []InputRow) error {
+	var nameArray  []string	    // Array of row names passed to Cplex
+	var cChar        C.char     // Temporary variable for processing C chars
+	var cCharArray []C.char     // Char array needed for C functions
+	var status       C.int      // Status returned from Cplex
+	var cRhs       []C.double   // RHS value passed to Cplex
+	var cRngVal    []C.double   // Range values passed to Cplex
+
+	// Build C array of row names
+	for i := 0; i < len(rList); i++ {
+		nameArray = append(nameArray, rList[i].Name)
+	}			
+
+	cNameArray := C.makeCharArray(C.int(len(rList)))
+	defer C.freeCharArray(cNameArray, C.int(len(rList)))
+
+	for i, s := range nameArray {
+		cString := C.CString(s)
+        C.setArrayString(cNameArray, cString, C.int(i))
+		// The cString pointers are freed as part of freeCharArray function, not here.
+	}
+
+	// Construct the lists of the other C parameters	
+	for i := 0; i < len(rList); i++ {
+		runes     := []rune(rList[i].Sense)
+		cChar      = C.char(runes[0])
+		cCharArray = append(cCharArray, cChar)		
+		cRhs       = append(cRhs, C.double(rList[i].Rhs))
+		cRngVal    = append(cRngVal, C.double(rList[i].RngVal))
+	} // End for list of rows
+		
+	status = C.cCreateRows(p.env.env, p.lp, C.int(len(cRhs)), &cCharArray[0], cNameArray, &cRhs[0], &cRngVal[0])
+	if status != 0 {
+		return cplexError(p.env.env, "NewRows", status)
+	}	
+	
+	return nil
+	
+} // End NewRows
+
+//==============================================================================
+
+// NewCols passes the columns and their objective function coefficients to Cplex.
+// This function uses CPXnewcols.
+func (p *cplexProb) NewCols(objList []InputObjCoef, cList []InputCol) error {
+
+	var nameArray  []string	    // Array of column names
+	var cChar        C.char     // Temporary variable for processing C chars
+	var status       C.int      // Status returned from Cplex
+	var cCharArray []C.char     // Column names passed to Cplex     
+	var lb, ub     []C.double   // Upper and lower bounds of cols passed to Cplex
+	var isMip        C.int      // Flag set to true if problem is a MIP 
+
+	// Build C array of objective function coefficients.
+	obj := make([]C.double, len(cList))
+
+	for i := 0; i < len(objList); i++ {
+		obj[objList[i].ColIndex] = C.double(objList[i].Value)
+	}	
+
+	// Build C array of column names				
+	for i := 0; i < len(cList); i++ {
+		nameArray = append(nameArray, cList[i].Name)
+	}			
+
+	cNameArray := C.makeCharArray(C.int(len(nameArray)))
+	defer C.freeCharArray(cNameArray, C.int(len(nameArray)))
+
+	for i, s := range nameArray {
+		cString := C.CString(s)
+        C.setArrayString(cNameArray, cString, C.int(i))
+		// The cString pointers are freed as part of freeCharArray function, not here.
+	}
+
+	
+	// Build C array of upper and lower bounds of the variables.
+	// Due to implementation of CPXnewrows, we also need to check whether there are
+	// any non-contiguous variables so entire array (as opposed to NULL) can be passed
+	// to Cplex.
+	
+	isMip = 0
+				
+	for i := 0; i < len(cList); i++ {
+		runes     := []rune(cList[i].Type)
+		cChar      = C.char(runes[0])
+		cCharArray = append(cCharArray, cChar)		
+		lb         = append(lb, C.double(cList[i].BndLo))
+		ub         = append(ub, C.double(cList[i].BndUp))
+		
+		if cList[i].Type != "C" {
+			isMip = 1
+		}										
+	}	
+
+	// Call the C function which passes the arrays to Cplex.	
+	status = C.cCreateCols(p.env.env, p.lp, isMip, C.int(len(cList)), &obj[0], cNameArray, &cCharArray[0], &lb[0], &ub[0])
+	if status != 0 {
+		return cplexError(p.env.env, "NewCols", status)
+	}	
+			
+	return nil
+}
+
+//==============================================================================
+
+// cMatrixArrays returns the C arrays of the matrix, and pointers to the first
+// elements of the index and value arrays, nil if the matrix has no
+// coefficients. Beg always holds one entry per row or column.
+func cMatrixArrays(mat InputMatrix) ([]C.int, *C.int, *C.double) {
+
+	beg := make([]C.int, len(mat.Beg))
+	for k := range mat.Beg {
+		beg[k] = C.int(mat.Beg[k])
+	}
+
+	if len(mat.Ind) == 0 {
+		return beg, nil, nil
+	}
+
+	ind := make([]C.int, len(mat.Ind))
+	val := make([]C.double, len(mat.Val))
+	for n := range mat.Ind {
+		ind[n] = C.int(mat.Ind[n])
+		val[n] = C.double(mat.Val[n])
+	}
+
+	return beg, &ind[0], &val[0]
+}
+
+//==============================================================================
+
+// AddRows passes the new rows and their coefficients to Cplex.
+// This function uses CPXaddrows, and CPXchgrngval for ranged rows.
+func (p *cplexProb) AddRows(rList []InputRow, rMat InputMatrix) error {
+
+	sense  := make([]C.char, len(rList))
+	rhs    := make([]C.double, len(rList))
+	rngVal := make([]C.double, len(rList))
+
+	cNameArray := C.makeCharArray(C.int(len(rList)))
+	defer C.freeCharArray(cNameArray, C.int(len(rList)))
+
+	for i := range rList {
+		sense[i]  = C.char(rList[i].Sense[0])
+		rhs[i]    = C.double(rList[i].Rhs)
+		rngVal[i] = C.double(rList[i].RngVal)
+		C.setArrayString(cNameArray, C.CString(rList[i].Name), C.int(i))
+		// The C strings are freed as part of freeCharArray function, not here.
+	}
+
+	beg, ind, val := cMatrixArrays(rMat)
+
+	status := C.cAddRows(p.env.env, p.lp, C.int(len(rList)), C.int(len(rMat.Ind)), &rhs[0], &sense[0], &rngVal[0],
+		&beg[0], ind, val, cNameArray)
+	if status != 0 {
+		return cplexError(p.env.env, "AddRows", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// AddCols passes the new columns, their objective coefficients and their
+// coefficients to Cplex. As in NewCols, the types are only passed to Cplex if
+// any column is not continuous.
+// This function uses CPXaddcols, and CPXchgctype for a MIP.
+func (p *cplexProb) AddCols(objList []InputObjCoef, cList []InputCol, cMat InputMatrix) error {
+	var isMip C.int  // Flag set to true if any column is not continuous
+
+	obj   := make([]C.double, len(cList))
+	lb    := make([]C.double, len(cList))
+	ub    := make([]C.double, len(cList))
+	ctype := make([]C.char, len(cList))
+
+	for i := 0; i < len(objList); i++ {
+		obj[objList[i].ColIndex] = C.double(objList[i].Value)
+	}
+
+	cNameArray := C.makeCharArray(C.int(len(cList)))
+	defer C.freeCharArray(cNameArray, C.int(len(cList)))
+
+	for j := range cList {
+		lb[j]    = C.double(cList[j].BndLo)
+		ub[j]    = C.double(cList[j].BndUp)
+		ctype[j] = C.char(cList[j].Type[0])
+		if cList[j].Type != "C" {
+			isMip = 1
+		}
+		C.setArrayString(cNameArray, C.CString(cList[j].Name), C.int(j))
+		// The C strings are freed as part of freeCharArray function, not here.
+	}
+
+	beg, ind, val := cMatrixArrays(cMat)
+
+	status := C.cAddCols(p.env.env, p.lp, isMip, C.int(len(cList)), C.int(len(cMat.Ind)), &obj[0], &beg[0], ind, val,
+		&lb[0], &ub[0], cNameArray, &ctype[0])
+	if status != 0 {
+		return cplexError(p.env.env, "AddCols", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// ChgCoefList passes the non-zero coefficients to Cplex.
+// This function uses CPXchgcoeflist.
+func (p *cplexProb) ChgCoefList(eList []InputElem) error {
+
+	var rowlist, collist []C.int     // Arrays of indices for rows and columns
+	var vallist          []C.double  // Array of coefficient values 
+	var status             C.int     // Status returned from Cplex
+		
+	for i := 0; i < len(eList); i++ {
+		
+		rowlist = append(rowlist, C.int(eList[i].RowIndex))
+		collist = append(collist, C.int(eList[i].ColIndex))
+		vallist = append(vallist, C.double(eList[i].Value))
+		
+	} // End for all rows	
+
+    status = C.cChgCoefList(p.env.env, p.lp, C.int(len(rowlist)), &rowlist[0], &collist[0], &vallist[0])
+	if status != 0 {
+		return cplexError(p.env.env, "ChgCoefList", status)
+	}	
+	
+	return nil
+}
+
+//==============================================================================
+
+// ChgBds passes the new bounds of the columns to Cplex.
+// This function uses CPXchgbds.
+func (p *cplexProb) ChgBds(bList []InputBound) error {
+
+	indices := make([]C.int, len(bList))
+	lu      := make([]C.char, len(bList))
+	bd      := make([]C.double, len(bList))
+
+	for i := range bList {
+		indices[i] = C.int(bList[i].ColIndex)
+		lu[i]      = C.char(bList[i].Bound[0])
+		bd[i]      = C.double(bList[i].Value)
+	}
+
+	status := C.cChgBds(p.env.env, p.lp, C.int(len(bList)), &indices[0], &lu[0], &bd[0])
+	if status != 0 {
+		return cplexError(p.env.env, "ChgBds", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// rowValueArrays returns the C arrays of the row indices and values.
+func rowValueArrays(rList []InputRowValue) ([]C.int, []C.double) {
+
+	indices := make([]C.int, len(rList))
+	values  := make([]C.double, len(rList))
+
+	for i := range rList {
+		indices[i] = C.int(rList[i].RowIndex)
+		values[i]  = C.double(rList[i].Value)
+	}
+
+	return indices, values
+}
+
+//==============================================================================
+
+// ChgRhs passes the new right hand sides of the rows to Cplex.
+// This function uses CPXchgrhs.
+func (p *cplexProb) ChgRhs(rList []InputRowValue) error {
+
+	indices, values := rowValueArrays(rList)
+
+	status := C.cChgRhs(p.env.env, p.lp, C.int(len(rList)), &indices[0], &values[0])
+	if status != 0 {
+		return cplexError(p.env.env, "ChgRhs", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// ChgSense passes the new senses of the rows to Cplex.
+// This function uses CPXchgsense.
+func (p *cplexProb) ChgSense(sList []InputSense) error {
+
+	indices := make([]C.int, len(sList))
+	sense   := make([]C.char, len(sList))
+
+	for i := range sList {
+		indices[i] = C.int(sList[i].RowIndex)
+		sense[i]   = C.char(sList[i].Sense[0])
+	}
+
+	status := C.cChgSense(p.env.env, p.lp, C.int(len(sList)), &indices[0], &sense[0])
+	if status != 0 {
+		return cplexError(p.env.env, "ChgSense", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// ChgRngVal passes the new range values of the rows to Cplex.
+// This function uses CPXchgrngval.
+func (p *cplexProb) ChgRngVal(rList []InputRowValue) error {
+
+	indices, values := rowValueArrays(rList)
+
+	status := C.cChgRngVal(p.env.env, p.lp, C.int(len(rList)), &indices[0], &values[0])
+	if status != 0 {
+		return cplexError(p.env.env, "ChgRngVal", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// ChgObj passes the new objective function coefficients to Cplex.
+// This function uses CPXchgobj.
+func (p *cplexProb) ChgObj(oList []InputColValue) error {
+
+	indices := make([]C.int, len(oList))
+	values  := make([]C.double, len(oList))
+
+	for i := range oList {
+		indices[i] = C.int(oList[i].ColIndex)
+		values[i]  = C.double(oList[i].Value)
+	}
+
+	status := C.cChgObj(p.env.env, p.lp, C.int(len(oList)), &indices[0], &values[0])
+	if status != 0 {
+		return cplexError(p.env.env, "ChgObj", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// ChgCType passes the new types of the columns to Cplex, changing the problem
+// type between LP and MIP as needed.
+// This function uses CPXchgctype, CPXgetctype and CPXchgprobtype.
+func (p *cplexProb) ChgCType(tList []InputCType) error {
+
+	indices := make([]C.int, len(tList))
+	ctype   := make([]C.char, len(tList))
+
+	for i := range tList {
+		indices[i] = C.int(tList[i].ColIndex)
+		ctype[i]   = C.char(tList[i].Type[0])
+	}
+
+	status := C.cChgCType(p.env.env, p.lp, C.int(len(tList)), &indices[0], &ctype[0])
+	if status != 0 {
+		return cplexError(p.env.env, "ChgCType", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// chgNames passes the new names of columns (cols set) or rows to Cplex.
+// This function uses CPXchgcolname or CPXchgrowname.
+func (p *cplexProb) chgNames(cols bool, nList []InputName) error {
+	var status C.int  // Status returned from Cplex
+
+	indices := make([]C.int, len(nList))
+	for i := range nList {
+		indices[i] = C.int(nList[i].Index)
+	}
+
+	cNameArray := C.makeCharArray(C.int(len(nList)))
+	defer C.freeCharArray(cNameArray, C.int(len(nList)))
+
+	for i := range nList {
+		C.setArrayString(cNameArray, C.CString(nList[i].NewName), C.int(i))
+		// The C strings are freed as part of freeCharArray function, not here.
+	}
+
+	if cols {
+		status = C.cChgColName(p.env.env, p.lp, C.int(len(nList)), &indices[0], cNameArray)
+		if status != 0 {
+			return cplexError(p.env.env, "ChgColName", status)
+		}
+	} else {
+		status = C.cChgRowName(p.env.env, p.lp, C.int(len(nList)), &indices[0], cNameArray)
+		if status != 0 {
+			return cplexError(p.env.env, "ChgRowName", status)
+		}
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// ChgColName passes the new names of the columns to Cplex.
+// This function uses CPXchgcolname.
+func (p *cplexProb) ChgColName(nList []InputName) error {
+
+	return p.chgNames(true, nList)
+}
+
+//==============================================================================
+
+// ChgRowName passes the new names of the rows to Cplex.
+// This function uses CPXchgrowname.
+func (p *cplexProb) ChgRowName(nList []InputName) error {
+
+	return p.chgNames(false, nList)
+}
+
+//==============================================================================
+
+// DelRows deletes a range of rows in Cplex.
+// This function uses CPXdelrows.
+func (p *cplexProb) DelRows(begin, end int) error {
+
+	status := C.cDelRows(p.env.env, p.lp, C.int(begin), C.int(end))
+	if status != 0 {
+		return cplexError(p.env.env, "DelRows", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// DelCols deletes a range of columns in Cplex.
+// This function uses CPXdelcols.
+func (p *cplexProb) DelCols(begin, end int) error {
+
+	status := C.cDelCols(p.env.env, p.lp, C.int(begin), C.int(end))
+	if status != 0 {
+		return cplexError(p.env.env, "DelCols", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// DelSetRows deletes the rows set to 1 in delStat in Cplex, and copies the new
+// row indices returned by Cplex into delStat.
+// This function uses CPXdelsetrows.
+func (p *cplexProb) DelSetRows(delStat []int) error {
+
+	cStat := make([]C.int, len(delStat))
+	for i := range delStat {
+		cStat[i] = C.int(delStat[i])
+	}
+
+	status := C.cDelSetRows(p.env.env, p.lp, &cStat[0])
+	if status != 0 {
+		return cplexError(p.env.env, "DelSetRows", status)
+	}
+
+	for i := range delStat {
+		delStat[i] = int(cStat[i])
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// DelSetCols deletes the columns set to 1 in delStat in Cplex, and copies the
+// new column indices returned by Cplex into delStat.
+// This function uses CPXdelsetcols.
+func (p *cplexProb) DelSetCols(delStat []int) error {
+
+	cStat := make([]C.int, len(delStat))
+	for j := range delStat {
+		cStat[j] = C.int(delStat[j])
+	}
+
+	status := C.cDelSetCols(p.env.env, p.lp, &cStat[0])
+	if status != 0 {
+		return cplexError(p.env.env, "DelSetCols", status)
+	}
+
+	for j := range delStat {
+		delStat[j] = int(cStat[j])
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// CopyQuad passes the quadratic objective matrix to Cplex, column by column.
+// The coefficients are sorted by column.
+// This function uses CPXcopyquad, and CPXchgprobtype for an LP or MIP.
+func (p *cplexProb) CopyQuad(qList []InputQuadCoef) error {
+	var indPtr *C.int     // First element of qmatind, nil if Q is empty
+	var valPtr *C.double  // First element of qmatval, nil if Q is empty
+
+	numCols := p.NumCols()
+	if numCols == 0 {
+		return nil
+	}
+
+	qmatBeg := make([]C.int, numCols)
+	qmatCnt := make([]C.int, numCols)
+	qmatInd := make([]C.int, len(qList))
+	qmatVal := make([]C.double, len(qList))
+
+	for k, q := range qList {
+		qmatCnt[q.ColIndex]++
+		qmatInd[k] = C.int(q.RowIndex)
+		qmatVal[k] = C.double(q.Value)
+	}
+	for j := 1; j < numCols; j++ {
+		qmatBeg[j] = qmatBeg[j - 1] + qmatCnt[j - 1]
+	}
+
+	if len(qList) > 0 {
+		indPtr, valPtr = &qmatInd[0], &qmatVal[0]
+	}
+
+	status := C.cCopyQuad(p.env.env, p.lp, &qmatBeg[0], &qmatCnt[0], indPtr, valPtr)
+	if status != 0 {
+		return cplexError(p.env.env, "CopyQuad", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// ChgQPCoef passes the changed coefficients of the quadratic objective matrix
+// to Cplex.
+// This function uses CPXchgqpcoef, and CPXchgprobtype for an LP or MIP.
+func (p *cplexProb) ChgQPCoef(qList []InputQuadCoef) error {
+
+	rows   := make([]C.int, len(qList))
+	cols   := make([]C.int, len(qList))
+	values := make([]C.double, len(qList))
+
+	for k, q := range qList {
+		rows[k]   = C.int(q.RowIndex)
+		cols[k]   = C.int(q.ColIndex)
+		values[k] = C.double(q.Value)
+	}
+
+	status := C.cChgQPCoef(p.env.env, p.lp, C.int(len(qList)), &rows[0], &cols[0], &values[0])
+	if status != 0 {
+		return cplexError(p.env.env, "ChgQPCoef", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// Quad returns the non-zero coefficients of the quadratic objective matrix,
+// column by column.
+// This function uses CPXgetnumqpnz and CPXgetquad.
+func (p *cplexProb) Quad() ([]InputQuadCoef, error) {
+	var numNz C.int  // Number of non-zero coefficients in Q
+
+	numCols := p.NumCols()
+	_ = C.cGetNumQPNz(p.env.env, p.lp, &numNz)
+
+	if numCols == 0 || numNz <= 0 {
+		return nil, nil
+	}
+
+	qmatBeg := make([]C.int, numCols)
+	qmatInd := make([]C.int, int(numNz))
+	qmatVal := make([]C.double, int(numNz))
+
+	status := C.cGetQuad(p.env.env, p.lp, C.int(numCols), numNz, &qmatBeg[0], &qmatInd[0], &qmatVal[0])
+	if status != 0 {
+		return nil, cplexError(p.env.env, "GetQuad", status)
+	}
+
+	quad := make([]InputQuadCoef, 0, int(numNz))
+	for j := 0; j < numCols; j++ {
+		end := int(numNz)
+		if j + 1 < numCols {
+			end = int(qmatBeg[j + 1])
+		}
+		for k := int(qmatBeg[j]); k < end; k++ {
+			quad = append(quad, InputQuadCoef{RowIndex: int(qmatInd[k]), ColIndex: j, Value: float64(qmatVal[k])})
+		}
+	}
+
+	return quad, nil
+}
+
+//==============================================================================
+
+// AddQConstrs passes the quadratic constraints to Cplex, one at a time. A
+// constraint without a name is given the default name of Cplex.
+// This function uses CPXaddqconstr.
+func (p *cplexProb) AddQConstrs(qList []InputQConstr) error {
+
+	for _, q := range qList {
+		var linInd  *C.int     // First element of the linear indices, nil if there are none
+		var linVal  *C.double  // First element of the linear values, nil if there are none
+		var cName   *C.char    // Name of the constraint, nil if it has none
+
+		linIndices := make([]C.int, len(q.Lin))
+		linValues  := make([]C.double, len(q.Lin))
+		for k, l := range q.Lin {
+			linIndices[k] = C.int(l.ColIndex)
+			linValues[k]  = C.double(l.Value)
+		}
+		if len(q.Lin) > 0 {
+			linInd, linVal = &linIndices[0], &linValues[0]
+		}
+
+		quadRows := make([]C.int, len(q.Quad))
+		quadCols := make([]C.int, len(q.Quad))
+		quadVals := make([]C.double, len(q.Quad))
+		for k, c := range q.Quad {
+			quadRows[k] = C.int(c.RowIndex)
+			quadCols[k] = C.int(c.ColIndex)
+			quadVals[k] = C.double(c.Value)
+		}
+
+		if q.Name != "" {
+			cName = C.CString(q.Name)
+		}
+
+		status := C.cAddQConstr(p.env.env, p.lp, C.int(len(q.Lin)), C.int(len(q.Quad)), C.double(q.Rhs),
+			C.char(q.Sense[0]), linInd, linVal, &quadRows[0], &quadCols[0], &quadVals[0], cName)
+		C.free(unsafe.Pointer(cName))
+		if status != 0 {
+			return cplexError(p.env.env, "AddQConstr", status)
+		}
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// NumQConstrs returns the number of quadratic constraints in the problem.
+// This function uses CPXgetnumqconstrs.
+func (p *cplexProb) NumQConstrs() int {
+	var numQConstrs C.int  // Number of quadratic constraints in the model
+
+	_ = C.cGetNumQConstrs(p.env.env, p.lp, &numQConstrs)
+
+	return int(numQConstrs)
+}
+
+//==============================================================================
+
+// QConstrNames returns the names of all quadratic constraints in the problem.
+// This function uses CPXgetqconstrname.
+func (p *cplexProb) QConstrNames() ([]string, error) {
+
+	return p.constrNames("GetQConstrName", p.NumQConstrs(),
+		func(i C.int, buf *C.char, space C.int, surplus *C.int) C.int {
+			return C.cGetQConstrName(p.env.env, p.lp, i, buf, space, surplus)
+		})
+}
+
+//==============================================================================
+
+// constrNames returns the names of count constraints, obtained one at a time
+// by getName, which is first called with no space to find the size of each
+// name.
+func (p *cplexProb) constrNames(op string, count int,
+	getName func(i C.int, buf *C.char, space C.int, surplus *C.int) C.int) ([]string, error) {
+
+	names := make([]string, count)
+	for i := range names {
+		var surplus C.int  // Parameter from Cplex needed to calculate size of the name
+
+		status := getName(C.int(i), nil, 0, &surplus)
+		if status != 0 {
+			return nil, cplexError(p.env.env, op, status)
+		}
+		if surplus == 0 {
+			continue
+		}
+
+		buf := (*C.char)(C.malloc(C.size_t(-surplus)))
+		status = getName(C.int(i), buf, -surplus, &surplus)
+		if status == 0 {
+			names[i] = C.GoString(buf)
+		}
+		C.free(unsafe.Pointer(buf))
+		if status != 0 {
+			return nil, cplexError(p.env.env, op, status)
+		}
+	}
+
+	return names, nil
+}
+
+//==============================================================================
+
+// QConstrSlack copies the slacks of the quadratic constraints into the slice.
+// This function uses CPXgetqconstrslack.
+func (p *cplexProb) QConstrSlack(slack []float64) error {
+
+	if len(slack) == 0 {
+		return nil
+	}
+
+	cSlack := make([]C.double, len(slack))
+	status := C.cGetQConstrSlack(p.env.env, p.lp, C.int(len(slack)), &cSlack[0])
+	if status != 0 {
+		return cplexError(p.env.env, "GetQConstrSlack", status)
+	}
+
+	for i := range slack {
+		slack[i] = float64(cSlack[i])
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// QConstrDSlack returns the non-zero entries of the dual slack vector of a
+// quadratic constraint.
+// This function uses CPXgetqconstrdslack.
+func (p *cplexProb) QConstrDSlack(index int) ([]InputLinCoef, error) {
+	var surplus C.int  // Parameter from Cplex needed to calculate the number of non-zeros
+
+	status := C.cGetQConstrDSlack(p.env.env, p.lp, C.int(index), nil, nil, 0, &surplus)
+	if status != 0 {
+		return nil, cplexError(p.env.env, "GetQConstrDSlack", status)
+	}
+	if surplus == 0 {
+		return nil, nil
+	}
+
+	ind := make([]C.int, int(-surplus))
+	val := make([]C.double, int(-surplus))
+	status = C.cGetQConstrDSlack(p.env.env, p.lp, C.int(index), &ind[0], &val[0], -surplus, &surplus)
+	if status != 0 {
+		return nil, cplexError(p.env.env, "GetQConstrDSlack", status)
+	}
+
+	dslack := make([]InputLinCoef, len(ind))
+	for k := range ind {
+		dslack[k] = InputLinCoef{ColIndex: int(ind[k]), Value: float64(val[k])}
+	}
+
+	return dslack, nil
+}
+
+//==============================================================================
+
+// AddIndConstrs passes the indicator constraints to Cplex, one at a time. A
+// constraint without a name is given the default name of Cplex.
+// This function uses CPXaddindconstr.
+func (p *cplexProb) AddIndConstrs(iList []InputIndicator) error {
+
+	for _, ind := range iList {
+		var linInd  *C.int     // First element of the linear indices, nil if there are none
+		var linVal  *C.double  // First element of the linear values, nil if there are none
+		var cName   *C.char    // Name of the constraint, nil if it has none
+
+		linIndices := make([]C.int, len(ind.Lin))
+		linValues  := make([]C.double, len(ind.Lin))
+		for k, l := range ind.Lin {
+			linIndices[k] = C.int(l.ColIndex)
+			linValues[k]  = C.double(l.Value)
+		}
+		if len(ind.Lin) > 0 {
+			linInd, linVal = &linIndices[0], &linValues[0]
+		}
+
+		if ind.Name != "" {
+			cName = C.CString(ind.Name)
+		}
+
+		status := C.cAddIndConstr(p.env.env, p.lp, C.int(ind.ColIndex), C.int(1 - ind.ActiveValue),
+			C.int(len(ind.Lin)), C.double(ind.Rhs), C.char(ind.Sense[0]), linInd, linVal, cName)
+		C.free(unsafe.Pointer(cName))
+		if status != 0 {
+			return cplexError(p.env.env, "AddIndConstr", status)
+		}
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// NumIndConstrs returns the number of indicator constraints in the problem.
+// This function uses CPXgetnumindconstrs.
+func (p *cplexProb) NumIndConstrs() int {
+	var numIndConstrs C.int  // Number of indicator constraints in the model
+
+	_ = C.cGetNumIndConstrs(p.env.env, p.lp, &numIndConstrs)
+
+	return int(numIndConstrs)
+}
+
+//==============================================================================
+
+// IndConstrs copies the indicator constraints, without their names, into the
+// slice.
+// This function uses CPXgetindconstr.
+func (p *cplexProb) IndConstrs(iList []InputIndicator) error {
+
+	for i := range iList {
+		var indVar       C.int     // Index of the binary column
+		var complemented C.int     // 1 if the constraint holds when the column is 0
+		var rhs          C.double  // Right hand side of the linear constraint
+		var sense        C.char    // Sense of the linear constraint
+		var surplus      C.int     // Parameter from Cplex needed to calculate the number of coefficients
+
+		status := C.cGetIndConstr(p.env.env, p.lp, C.int(i), &indVar, &complemented, &rhs, &sense,
+			nil, nil, 0, &surplus)
+		if status != 0 {
+			return cplexError(p.env.env, "GetIndConstr", status)
+		}
+
+		var lin []InputLinCoef
+		if surplus < 0 {
+			linInd := make([]C.int, int(-surplus))
+			linVal := make([]C.double, int(-surplus))
+			status = C.cGetIndConstr(p.env.env, p.lp, C.int(i), &indVar, &complemented, &rhs, &sense,
+				&linInd[0], &linVal[0], -surplus, &surplus)
+			if status != 0 {
+				return cplexError(p.env.env, "GetIndConstr", status)
+			}
+			lin = make([]InputLinCoef, len(linInd))
+			for k := range linInd {
+				lin[k] = InputLinCoef{ColIndex: int(linInd[k]), Value: float64(linVal[k])}
+			}
+		}
+
+		iList[i] = InputIndicator{ColIndex: int(indVar), ActiveValue: 1 - int(complemented), Lin: lin,
+			Sense: string(byte(sense)), Rhs: float64(rhs)}
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// IndConstrNames returns the names of all indicator constraints in the problem.
+// This function uses CPXgetindconstrname.
+func (p *cplexProb) IndConstrNames() ([]string, error) {
+
+	return p.constrNames("GetIndConstrName", p.NumIndConstrs(),
+		func(i C.int, buf *C.char, space C.int, surplus *C.int) C.int {
+			return C.cGetIndConstrName(p.env.env, p.lp, i, buf, space, surplus)
+		})
+}
+
+//==============================================================================
+
+// DelIndConstrs deletes a range of indicator constraints.
+// This function uses CPXdelindconstrs.
+func (p *cplexProb) DelIndConstrs(begin, end int) error {
+
+	status := C.cDelIndConstrs(p.env.env, p.lp, C.int(begin), C.int(end))
+	if status != 0 {
+		return cplexError(p.env.env, "DelIndConstrs", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// AddSOS passes the special ordered sets to Cplex, set by set.
+// This function uses CPXaddsos, and CPXchgprobtype for an LP or QP.
+func (p *cplexProb) AddSOS(sList []InputSOS) error {
+
+	numNz := 0
+	for _, s := range sList {
+		numNz += len(s.Members)
+	}
+
+	types := make([]C.char, len(sList))
+	beg   := make([]C.int, len(sList))
+	ind   := make([]C.int, 0, numNz)
+	wt    := make([]C.double, 0, numNz)
+
+	cNameArray := C.makeCharArray(C.int(len(sList)))
+	defer C.freeCharArray(cNameArray, C.int(len(sList)))
+
+	for i, s := range sList {
+		types[i] = C.CPX_TYPE_SOS1
+		if s.Type == 2 {
+			types[i] = C.CPX_TYPE_SOS2
+		}
+		beg[i] = C.int(len(ind))
+		for _, m := range s.Members {
+			ind = append(ind, C.int(m.ColIndex))
+			wt  = append(wt, C.double(m.Weight))
+		}
+		C.setArrayString(cNameArray, C.CString(s.Name), C.int(i))
+	}
+
+	status := C.cAddSOS(p.env.env, p.lp, C.int(len(sList)), C.int(numNz), &types[0], &beg[0], &ind[0], &wt[0],
+		cNameArray)
+	if status != 0 {
+		return cplexError(p.env.env, "AddSOS", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// NumSOS returns the number of special ordered sets in the problem.
+// This function uses CPXgetnumsos.
+func (p *cplexProb) NumSOS() int {
+	var numSOS C.int  // Number of special ordered sets in the model
+
+	_ = C.cGetNumSOS(p.env.env, p.lp, &numSOS)
+
+	return int(numSOS)
+}
+
+//==============================================================================
+
+// SOS copies the special ordered sets, without their names, into the slice.
+// This function uses CPXgetsos.
+func (p *cplexProb) SOS(sList []InputSOS) error {
+	var numNz C.int  // Number of members of all sets
+
+	if len(sList) == 0 {
+		return nil
+	}
+
+	status := C.cGetNumSOSNz(p.env.env, p.lp, C.int(len(sList)), &numNz)
+	if status != 0 {
+		return cplexError(p.env.env, "GetSOS", status)
+	}
+
+	types := make([]C.char, len(sList))
+	beg   := make([]C.int, len(sList))
+	ind   := make([]C.int, int(numNz) + 1)
+	wt    := make([]C.double, int(numNz) + 1)
+
+	status = C.cGetSOS(p.env.env, p.lp, C.int(len(sList)), numNz, &types[0], &beg[0], &ind[0], &wt[0])
+	if status != 0 {
+		return cplexError(p.env.env, "GetSOS", status)
+	}
+
+	for i := range sList {
+		end := int(numNz)
+		if i + 1 < len(sList) {
+			end = int(beg[i + 1])
+		}
+
+		s := InputSOS{Type: 1}
+		if types[i] == C.CPX_TYPE_SOS2 {
+			s.Type = 2
+		}
+		for k := int(beg[i]); k < end; k++ {
+			s.Members = append(s.Members, InputSOSMember{ColIndex: int(ind[k]), Weight: float64(wt[k])})
+		}
+		sList[i] = s
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// SOSNames returns the names of all special ordered sets in the problem.
+// This function uses CPXgetsosname.
+func (p *cplexProb) SOSNames() ([]string, error) {
+	var surplus C.int  // Parameter from Cplex needed to calculate size of name array
+
+	numSOS := C.int(p.NumSOS())
+	if numSOS == 0 {
+		return nil, nil
+	}
+
+	status := C.cGetSOSNameSurplus(p.env.env, p.lp, numSOS, &surplus)
+	if status != 0 {
+		return nil, cplexError(p.env.env, "GetSOSName", status)
+	}
+	if surplus == 0 {
+		return make([]string, int(numSOS)), nil
+	}
+
+	// Create memory for the name array.
+	cName := C.makeCharArray(numSOS)
+	defer C.free(unsafe.Pointer(cName))
+	cNameStore := C.makeNameStore(-surplus)
+	defer C.free(unsafe.Pointer(cNameStore))
+
+	status = C.cGetSOSNames(p.env.env, p.lp, numSOS, cName, cNameStore, -surplus)
+	if status != 0 {
+		return nil, cplexError(p.env.env, "GetSOSName", status)
+	}
+
+	names := make([]string, int(numSOS))
+	for i := range names {
+		cString := C.cGetArrayItem(cName, C.int(i))
+		names[i] = C.GoString(cString)
+		C.free(unsafe.Pointer(cString))
+	}
+
+	return names, nil
+}
+
+//==============================================================================
+
+// DelSOS deletes a range of special ordered sets.
+// This function uses CPXdelsos.
+func (p *cplexProb) DelSOS(begin, end int) error {
+
+	status := C.cDelSOS(p.env.env, p.lp, C.int(begin), C.int(end))
+	if status != 0 {
+		return cplexError(p.env.env, "DelSOS", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// AddPwl passes the piecewise linear constraints to Cplex, one at a time. A
+// constraint without a name is given the default name of Cplex.
+// This function uses CPXaddpwl, and CPXchgprobtype for an LP or QP.
+func (p *cplexProb) AddPwl(pList []InputPwl) error {
+
+	for _, pwl := range pList {
+		var cName *C.char  // Name of the constraint, nil if it has none
+
+		breakX := make([]C.double, len(pwl.Breaks))
+		breakY := make([]C.double, len(pwl.Breaks))
+		for k, b := range pwl.Breaks {
+			breakX[k] = C.double(b.X)
+			breakY[k] = C.double(b.Y)
+		}
+
+		if pwl.Name != "" {
+			cName = C.CString(pwl.Name)
+		}
+
+		status := C.cAddPwl(p.env.env, p.lp, C.int(pwl.YCol), C.int(pwl.XCol), C.double(pwl.PreSlope),
+			C.double(pwl.PostSlope), C.int(len(pwl.Breaks)), &breakX[0], &breakY[0], cName)
+		C.free(unsafe.Pointer(cName))
+		if status != 0 {
+			return cplexError(p.env.env, "AddPwl", status)
+		}
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// NumPwl returns the number of piecewise linear constraints in the problem.
+// This function uses CPXgetnumpwl.
+func (p *cplexProb) NumPwl() int {
+	var numPwl C.int  // Number of piecewise linear constraints in the model
+
+	_ = C.cGetNumPwl(p.env.env, p.lp, &numPwl)
+
+	return int(numPwl)
+}
+
+//==============================================================================
+
+// Pwl copies the piecewise linear constraints, without their names, into the
+// slice.
+// This function uses CPXgetpwl.
+func (p *cplexProb) Pwl(pList []InputPwl) error {
+
+	for i := range pList {
+		var yCol      C.int     // Index of the column y
+		var xCol      C.int     // Index of the column x
+		var preSlope  C.double  // Slope before the first breakpoint
+		var postSlope C.double  // Slope after the last breakpoint
+		var surplus   C.int     // Parameter from Cplex needed to calculate the number of breakpoints
+
+		status := C.cGetPwl(p.env.env, p.lp, C.int(i), &yCol, &xCol, &preSlope, &postSlope, nil, nil, 0, &surplus)
+		if status != 0 {
+			return cplexError(p.env.env, "GetPwl", status)
+		}
+
+		pwl := InputPwl{YCol: int(yCol), XCol: int(xCol), PreSlope: float64(preSlope),
+			PostSlope: float64(postSlope)}
+		if surplus < 0 {
+			breakX := make([]C.double, int(-surplus))
+			breakY := make([]C.double, int(-surplus))
+			status = C.cGetPwl(p.env.env, p.lp, C.int(i), &yCol, &xCol, &preSlope, &postSlope,
+				&breakX[0], &breakY[0], -surplus, &surplus)
+			if status != 0 {
+				return cplexError(p.env.env, "GetPwl", status)
+			}
+			pwl.Breaks = make([]InputPwlBreak, len(breakX))
+			for k := range breakX {
+				pwl.Breaks[k] = InputPwlBreak{X: float64(breakX[k]), Y: float64(breakY[k])}
+			}
+		}
+		pList[i] = pwl
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// PwlNames returns the names of all piecewise linear constraints in the
+// problem.
+// This function uses CPXgetpwlname.
+func (p *cplexProb) PwlNames() ([]string, error) {
+
+	return p.constrNames("GetPwlName", p.NumPwl(),
+		func(i C.int, buf *C.char, space C.int, surplus *C.int) C.int {
+			return C.cGetPwlName(p.env.env, p.lp, i, buf, space, surplus)
+		})
+}
+
+//==============================================================================
+
+// DelPwl deletes a range of piecewise linear constraints.
+// This function uses CPXdelpwl.
+func (p *cplexProb) DelPwl(begin, end int) error {
+
+	status := C.cDelPwl(p.env.env, p.lp, C.int(begin), C.int(end))
+	if status != 0 {
+		return cplexError(p.env.env, "DelPwl", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// mipStartArrays returns the arrays which pass the MIP starts to Cplex, start
+// by start.
+func mipStartArrays(mList []InputMIPStart) (beg, ind []C.int, val []C.double, effort []C.int) {
+
+	beg    = make([]C.int, len(mList))
+	effort = make([]C.int, len(mList))
+	for i, m := range mList {
+		beg[i]    = C.int(len(ind))
+		effort[i] = C.int(m.Effort)
+		for _, v := range m.Values {
+			ind = append(ind, C.int(v.ColIndex))
+			val = append(val, C.double(v.Value))
+		}
+	}
+
+	return beg, ind, val, effort
+}
+
+//==============================================================================
+
+// AddMIPStarts passes the MIP starts to Cplex, start by start.
+// This function uses CPXaddmipstarts.
+func (p *cplexProb) AddMIPStarts(mList []InputMIPStart) error {
+
+	beg, ind, val, effort := mipStartArrays(mList)
+
+	cNameArray := C.makeCharArray(C.int(len(mList)))
+	defer C.freeCharArray(cNameArray, C.int(len(mList)))
+
+	for i, m := range mList {
+		C.setArrayString(cNameArray, C.CString(m.Name), C.int(i))
+	}
+
+	status := C.cAddMIPStarts(p.env.env, p.lp, C.int(len(mList)), C.int(len(ind)), &beg[0], &ind[0], &val[0],
+		&effort[0], cNameArray)
+	if status != 0 {
+		return cplexError(p.env.env, "AddMIPStarts", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// ChgMIPStarts changes the efforts and values of the MIP starts with the
+// indices given.
+// This function uses CPXchgmipstarts.
+func (p *cplexProb) ChgMIPStarts(indices []int, mList []InputMIPStart) error {
+
+	beg, ind, val, effort := mipStartArrays(mList)
+
+	cIndices := make([]C.int, len(indices))
+	for i, index := range indices {
+		cIndices[i] = C.int(index)
+	}
+
+	status := C.cChgMIPStarts(p.env.env, p.lp, C.int(len(mList)), &cIndices[0], C.int(len(ind)), &beg[0],
+		&ind[0], &val[0], &effort[0])
+	if status != 0 {
+		return cplexError(p.env.env, "ChgMIPStarts", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// NumMIPStarts returns the number of MIP starts in the problem.
+// This function uses CPXgetnummipstarts.
+func (p *cplexProb) NumMIPStarts() int {
+	var numStarts C.int  // Number of MIP starts in the model
+
+	_ = C.cGetNumMIPStarts(p.env.env, p.lp, &numStarts)
+
+	return int(numStarts)
+}
+
+//==============================================================================
+
+// MIPStarts copies the MIP starts, without their names, into the slice.
+// This function uses CPXgetmipstarts.
+func (p *cplexProb) MIPStarts(mList []InputMIPStart) error {
+	var numNz C.int  // Number of values of all starts
+
+	if len(mList) == 0 {
+		return nil
+	}
+
+	status := C.cGetNumMIPStartNz(p.env.env, p.lp, C.int(len(mList)), &numNz)
+	if status != 0 {
+		return cplexError(p.env.env, "GetMIPStarts", status)
+	}
+
+	beg    := make([]C.int, len(mList))
+	effort := make([]C.int, len(mList))
+	ind    := make([]C.int, int(numNz) + 1)
+	val    := make([]C.double, int(numNz) + 1)
+
+	status = C.cGetMIPStarts(p.env.env, p.lp, C.int(len(mList)), numNz, &beg[0], &ind[0], &val[0], &effort[0])
+	if status != 0 {
+		return cplexError(p.env.env, "GetMIPStarts", status)
+	}
+
+	for i := range mList {
+		end := int(numNz)
+		if i + 1 < len(mList) {
+			end = int(beg[i + 1])
+		}
+
+		m := InputMIPStart{Effort: MIPStartEffort(effort[i])}
+		for k := int(beg[i]); k < end; k++ {
+			m.Values = append(m.Values, InputColValue{ColIndex: int(ind[k]), Value: float64(val[k])})
+		}
+		mList[i] = m
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// MIPStartNames returns the names of all MIP starts in the problem.
+// This function uses CPXgetmipstartname.
+func (p *cplexProb) MIPStartNames() ([]string, error) {
+
+	return p.constrNames("GetMIPStartName", p.NumMIPStarts(),
+		func(i C.int, buf *C.char, space C.int, surplus *C.int) C.int {
+			return C.cGetMIPStartName(p.env.env, p.lp, i, buf, space, surplus)
+		})
+}
+
+//==============================================================================
+
+// PoolNumSolns returns the number of solutions in the solution pool.
+// This function uses CPXgetsolnpoolnumsolns.
+func (p *cplexProb) PoolNumSolns() int {
+	var numSolns C.int  // Number of solutions in the pool
+
+	_ = C.cGetPoolNumSolns(p.env.env, p.lp, &numSolns)
+
+	return int(numSolns)
+}
+
+//==============================================================================
+
+// PoolSolution copies the column values and slacks of pool solution i into the
+// slices, and returns its objective value.
+// This function uses CPXgetsolnpoolobjval, CPXgetsolnpoolx and
+// CPXgetsolnpoolslack.
+func (p *cplexProb) PoolSolution(i int, x, slack []float64) (float64, error) {
+	var objVal C.double  // Objective value of the solution
+
+	cX     := make([]C.double, len(x) + 1)
+	cSlack := make([]C.double, len(slack) + 1)
+
+	status := C.cGetPoolSolution(p.env.env, p.lp, C.int(i), &objVal, C.int(len(x)), &cX[0],
+		C.int(len(slack)), &cSlack[0])
+	if status != 0 {
+		return 0, cplexError(p.env.env, "GetPoolSolution", status)
+	}
+
+	for j := range x {
+		x[j] = float64(cX[j])
+	}
+	for k := range slack {
+		slack[k] = float64(cSlack[k])
+	}
+
+	return float64(objVal), nil
+}
+
+//==============================================================================
+
+// AddPoolFilters passes the solution pool filters to Cplex, one at a time.
+// This function uses CPXaddsolnpooldivfilter and CPXaddsolnpoolrngfilter.
+func (p *cplexProb) AddPoolFilters(fList []InputPoolFilter) error {
+	var status C.int  // Status returned by Cplex
+
+	for _, f := range fList {
+		ind    := make([]C.int, len(f.Cols))
+		val    := make([]C.double, len(f.Cols))
+		refVal := make([]C.double, len(f.Cols))
+		for k, c := range f.Cols {
+			ind[k]    = C.int(c.ColIndex)
+			val[k]    = C.double(c.Value)
+			refVal[k] = C.double(c.RefValue)
+		}
+
+		cName := C.CString(f.Name)
+		if f.Type == "D" {
+			status = C.cAddPoolDivFilter(p.env.env, p.lp, C.double(f.LowerBound), C.double(f.UpperBound),
+				C.int(len(f.Cols)), &ind[0], &val[0], &refVal[0], cName)
+		} else {
+			status = C.cAddPoolRngFilter(p.env.env, p.lp, C.double(f.LowerBound), C.double(f.UpperBound),
+				C.int(len(f.Cols)), &ind[0], &val[0], cName)
+		}
+		C.free(unsafe.Pointer(cName))
+
+		if status != 0 {
+			return cplexError(p.env.env, "AddPoolFilters", status)
+		}
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// NumPoolFilters returns the number of solution pool filters.
+// This function uses CPXgetsolnpoolnumfilters.
+func (p *cplexProb) NumPoolFilters() int {
+	var numFilters C.int  // Number of filters of the problem
+
+	_ = C.cGetPoolNumFilters(p.env.env, p.lp, &numFilters)
+
+	return int(numFilters)
+}
+
+//==============================================================================
+
+// DelPoolFilters deletes a range of solution pool filters.
+// This function uses CPXdelsolnpoolfilters.
+func (p *cplexProb) DelPoolFilters(begin, end int) error {
+
+	status := C.cDelPoolFilters(p.env.env, p.lp, C.int(begin), C.int(end))
+	if status != 0 {
+		return cplexError(p.env.env, "DelPoolFilters", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// QPOpt solves the problem as a QP, aborting the solve once ctx is done.
+// This function uses CPXqpopt.
+func (p *cplexProb) QPOpt(ctx context.Context) error {
+
+	return p.env.terminable(ctx, "QPOpt", func() C.int {
+		return C.cQPOpt(p.env.env, p.lp)
+	})
+}
+
+//==============================================================================
+
+// LpOpt solves the problem as an LP, aborting the solve once ctx is done.
+// This function uses CPXlpopt.
+func (p *cplexProb) LpOpt(ctx context.Context) error {
+
+	return p.env.terminable(ctx, "LpOpt", func() C.int {
+		return C.cLpOpt(p.env.env, p.lp)
+	})
+}
+
+//==============================================================================
+
+// PrimOpt solves the problem with the primal simplex, aborting the solve once
+// ctx is done.
+// This function uses CPXprimopt.
+func (p *cplexProb) PrimOpt(ctx context.Context) error {
+
+	return p.env.terminable(ctx, "PrimOpt", func() C.int {
+		return C.cPrimOpt(p.env.env, p.lp)
+	})
+}
+
+//==============================================================================
+
+// DualOpt solves the problem with the dual simplex, aborting the solve once
+// ctx is done.
+// This function uses CPXdualopt.
+func (p *cplexProb) DualOpt(ctx context.Context) error {
+
+	return p.env.terminable(ctx, "DualOpt", func() C.int {
+		return C.cDualOpt(p.env.env, p.lp)
+	})
+}
+
+//==============================================================================
+
+// BarOpt solves the problem with the barrier, followed by the crossover passed
+// into this function, or the one set by the parameters for AlgAuto. The solve
+// is aborted once ctx is done.
+// This function uses CPXbaropt or CPXhybbaropt.
+func (p *cplexProb) BarOpt(ctx context.Context, crossover Algorithm) error {
+
+	return p.env.terminable(ctx, "BarOpt", func() C.int {
+		if crossover == AlgAuto {
+			return C.cBarOpt(p.env.env, p.lp)
+		}
+		return C.cHybBarOpt(p.env.env, p.lp, C.int(crossover))
+	})
+}
+
+//==============================================================================
+
+// HybNetOpt solves the network embedded in the problem, then the problem with
+// the simplex method passed into this function, aborting the solve once ctx is
+// done.
+// This function uses CPXhybnetopt.
+func (p *cplexProb) HybNetOpt(ctx context.Context, method Algorithm) error {
+
+	return p.env.terminable(ctx, "HybNetOpt", func() C.int {
+		return C.cHybNetOpt(p.env.env, p.lp, C.int(method))
+	})
+}
+
+//==============================================================================
+
+// MipOpt solves the problem as a MIP, aborting the solve once ctx is done.
+// This function uses CPXmipopt.
+func (p *cplexProb) MipOpt(ctx context.Context) error {
+
+	return p.env.terminable(ctx, "MipOpt", func() C.int {
+		return C.cMipOpt(p.env.env, p.lp)
+	})
+}
+
+//==============================================================================
+
+// Populate fills the solution pool, aborting once ctx is done.
+// This function uses CPXpopulate.
+func (p *cplexProb) Populate(ctx context.Context) error {
+
+	return p.env.terminable(ctx, "Populate", func() C.int {
+		return C.cPopulate(p.env.env, p.lp)
+	})
+}
+
+//==============================================================================
+
+// terminable runs the optimizer passed into this function. If ctx can be
+// cancelled, a termination flag allocated in C memory is installed in the
+// environment for the duration of the solve, and set by a goroutine once ctx
+// is done. Cplex checks the flag regularly, and stops with an aborted status
+// when it is set.
+// This function uses CPXsetterminate.
+func (e *cplexEnv) terminable(ctx context.Context, op string, solve func() C.int) error {
+	var status  C.int            // Status returned from Cplex
+	var wg      sync.WaitGroup   // Waits for the goroutine watching ctx
+
+	if ctx.Done() == nil {
+		if status = solve(); status != 0 {
+			return cplexError(e.env, op, status)
+		}
+		return nil
+	}
+
+	flag := (*C.int)(C.malloc(C.size_t(unsafe.Sizeof(C.int(0)))))
+	defer C.free(unsafe.Pointer(flag))
+	*flag = 0
+
+	status = C.cSetTerminate(e.env, flag)
+	if status != 0 {
+		return cplexError(e.env, op, status)
+	}
+	defer C.cSetTerminate(e.env, nil)
+
+	finished := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		select {
+		case <-ctx.Done():
+			*flag = 1
+		case <-finished:
+		}
+	}()
+
+	status = solve()
+	close(finished)
+	wg.Wait()
+
+	if status != 0 {
+		return cplexError(e.env, op, status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// NumRows returns the number of rows in the problem.
+// This function uses CPXgetnumrows.
+func (p *cplexProb) NumRows() int {
+	var cNumRows C.int      // Number of rows in the Cplex model
+	
+	_ = C.cGetNumRows(p.env.env, p.lp, &cNumRows)
+
+	return int(cNumRows)	
+}
+
+//==============================================================================
+
+// NumCols returns the number of columns in the problem.
+// This function uses CPXgetnumcols.
+func (p *cplexProb) NumCols() int {
+	var cNumCols C.int    // Number of columns in the Cplex model
+	
+	_ = C.cGetNumCols(p.env.env, p.lp, &cNumCols)
+
+	return int(cNumCols)	
+}
+
+//==============================================================================
+
+// ObjSen returns the objective sense, 1 to minimize and -1 to maximize.
+// This function uses CPXgetobjsen.
+func (p *cplexProb) ObjSen() (int, error) {
+	var sense C.int  // Objective sense returned by Cplex
+
+	status := C.cGetObjSen(p.env.env, p.lp, &sense)
+	if status != 0 {
+		return 0, cplexError(p.env.env, "GetObjSen", status)
+	}
+
+	if sense == C.CPX_MAX {
+		return -1, nil
+	}
+
+	return 1, nil
+}
+
+//==============================================================================
+
+// Rows populates the sense, right hand side and range value of all rows.
+// This function uses CPXgetsense, CPXgetrhs and CPXgetrngval.
+func (p *cplexProb) Rows(rList []InputRow) error {
+
+	if len(rList) == 0 {
+		return nil
+	}
+
+	sense  := make([]C.char, len(rList))
+	rhs    := make([]C.double, len(rList))
+	rngVal := make([]C.double, len(rList))
+
+	status := C.cGetRows(p.env.env, p.lp, C.int(len(rList)), &sense[0], &rhs[0], &rngVal[0])
+	if status != 0 {
+		return cplexError(p.env.env, "GetRows", status)
+	}
+
+	for i := range rList {
+		rList[i].Sense  = string(rune(sense[i]))
+		rList[i].Rhs    = float64(rhs[i])
+		rList[i].RngVal = float64(rngVal[i])
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// Cols populates the type and bounds of all columns.
+// This function uses CPXgetctype, CPXgetlb and CPXgetub.
+func (p *cplexProb) Cols(cList []InputCol) error {
+
+	if len(cList) == 0 {
+		return nil
+	}
+
+	ctype := make([]C.char, len(cList))
+	lb    := make([]C.double, len(cList))
+	ub    := make([]C.double, len(cList))
+
+	status := C.cGetCols(p.env.env, p.lp, C.int(len(cList)), &ctype[0], &lb[0], &ub[0])
+	if status != 0 {
+		return cplexError(p.env.env, "GetCols", status)
+	}
+
+	for j := range cList {
+		cList[j].Type  = string(rune(ctype[j]))
+		cList[j].BndLo = float64(lb[j])
+		cList[j].BndUp = float64(ub[j])
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// Obj populates the objective function coefficients of all columns.
+// This function uses CPXgetobj.
+func (p *cplexProb) Obj(obj []float64) error {
+
+	if len(obj) == 0 {
+		return nil
+	}
+
+	cObj := make([]C.double, len(obj))
+
+	status := C.cGetObj(p.env.env, p.lp, C.int(len(obj)), &cObj[0])
+	if status != 0 {
+		return cplexError(p.env.env, "GetObj", status)
+	}
+
+	for j := range obj {
+		obj[j] = float64(cObj[j])
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// Coefs returns the non-zero coefficients of the model, column by column.
+// This function uses CPXgetnumnz and CPXgetcols.
+func (p *cplexProb) Coefs() ([]InputElem, error) {
+	var numNz C.int  // Number of non-zero coefficients
+
+	numCols := p.NumCols()
+	_ = C.cGetNumNz(p.env.env, p.lp, &numNz)
+
+	if numCols == 0 || numNz == 0 {
+		return nil, nil
+	}
+
+	matBeg := make([]C.int, numCols)
+	matInd := make([]C.int, int(numNz))
+	matVal := make([]C.double, int(numNz))
+
+	status := C.cGetCoefs(p.env.env, p.lp, C.int(numCols), numNz, &matBeg[0], &matInd[0], &matVal[0])
+	if status != 0 {
+		return nil, cplexError(p.env.env, "GetCoefs", status)
+	}
+
+	elems := make([]InputElem, 0, int(numNz))
+	for j := 0; j < numCols; j++ {
+		end := int(numNz)
+		if j + 1 < numCols {
+			end = int(matBeg[j + 1])
+		}
+		for k := int(matBeg[j]); k < end; k++ {
+			elems = append(elems, InputElem{RowIndex: int(matInd[k]), ColIndex: j, Value: float64(matVal[k])})
+		}
+	}
+
+	return elems, nil
+}
+
+//==============================================================================
+
+// ObjVal returns the objective function value of the current solution.
+// This function uses CPXgetobjval.
+func (p *cplexProb) ObjVal() (float64, error) {
+	var cObjVal C.double    // Objective function value calculated by Cplex
+	var status  C.int       // Status returned by Cplex
+
+	status = C.cGetObjVal(p.env.env, p.lp, &cObjVal)
+	if status != 0 {
+		return 0, cplexError(p.env.env, "GetObjVal", status)		
+	}
+
+	return float64(cObjVal), nil	
+}
+
+//==============================================================================
+
+// Status returns the status of the current solution, StatNone if no solution
+// exists.
+// This function uses CPXgetstat.
+func (p *cplexProb) Status() (SolutionStatus, error) {
+
+	return SolutionStatus(C.cGetStat(p.env.env, p.lp)), nil
+}
+
+//==============================================================================
+
+// SolnInfo returns the method, type and feasibility of the current solution.
+// This function uses CPXsolninfo.
+func (p *cplexProb) SolnInfo() (SolnInfo, error) {
+	var cMethod, cType  C.int   // Solution method and type
+	var cPfeas, cDfeas  C.int   // Primal and dual feasibility indicators
+	var status          C.int   // Status returned by Cplex
+
+	status = C.cSolnInfo(p.env.env, p.lp, &cMethod, &cType, &cPfeas, &cDfeas)
+	if status != 0 {
+		return SolnInfo{Method: AlgNone, Type: SolnNone}, cplexError(p.env.env, "GetSolnInfo", status)
+	}
+
+	if SolnType(cType) == SolnNone {
+		cMethod = C.int(AlgNone)
+	}
+
+	return SolnInfo{
+		Method:         Algorithm(cMethod),
+		Type:           SolnType(cType),
+		PrimalFeasible: cPfeas != 0,
+		DualFeasible:   cDfeas != 0,
+	}, nil
+}
+
+//==============================================================================
+
+// BestObjVal returns the best bound on the optimal objective function value.
+// This function uses CPXgetbestobjval.
+func (p *cplexProb) BestObjVal() (float64, error) {
+	var cObjVal C.double    // Best objective function value calculated by Cplex
+	var status  C.int       // Status returned by Cplex
+
+	status = C.cGetBestObjVal(p.env.env, p.lp, &cObjVal)
+	if status != 0 {
+		return 0, cplexError(p.env.env, "GetBestObjVal", status)		
+	}
+
+	return float64(cObjVal), nil	
+}
+
+//==============================================================================
+
+// MipRelGap returns the relative gap of the current MIP solution.
+// This function uses CPXgetmiprelgap.
+func (p *cplexProb) MipRelGap() (float64, error) {
+	var cGap    C.double    // Relative gap calculated by Cplex
+	var status  C.int       // Status returned by Cplex
+
+	status = C.cGetMipRelGap(p.env.env, p.lp, &cGap)
+	if status != 0 {
+		return 0, cplexError(p.env.env, "GetMipRelGap", status)		
+	}
+
+	return float64(cGap), nil	
+}
+
+//==============================================================================
+
+// Solution fills the slices with the values, reduced costs, duals and slacks of
+// the current solution and returns the objective function value.
+// This function uses CPXsolution.
+func (p *cplexProb) Solution(x, dj, pi, slack []float64) (float64, error) {
+	var cObjVal  C.double   // Objective function value returned by Cplex
+	var status   C.int      // Status returned by Cplex
+
+	cXval  := make([]C.double, len(x) + 1)
+	cRcost := make([]C.double, len(dj) + 1)
+	cPi    := make([]C.double, len(pi) + 1)
+	cSlack := make([]C.double, len(slack) + 1)
+
+	// Get the solution using the C data structures.				
+	status = C.cGetSolution(p.env.env, p.lp, &cObjVal, &cXval[0], &cRcost[0], &cPi[0], &cSlack[0])	
+	if status != 0 {
+		return 0, cplexError(p.env.env, "GetSolution", status)
+	}	
+
+	for i := range x {
+		x[i]  = float64(cXval[i])
+		dj[i] = float64(cRcost[i])
+	}
+
+	for i := range pi {
+		pi[i]    = float64(cPi[i])
+		slack[i] = float64(cSlack[i])
+	}
+
+	return float64(cObjVal), nil
+}
+
+//==============================================================================
+
+// X fills the slice with the values of the columns in the current solution.
+// This function uses CPXgetx.
+func (p *cplexProb) X(x []float64) error {
+	var status    C.int   // Status returned by Cplex
+
+	if len(x) == 0 {
+		return nil
+	}
+
+	cXval  := make([]C.double, len(x))
+
+	// Get the solution using the C data structures.				
+	status = C.cGetX(p.env.env, p.lp, C.int(len(x)), &cXval[0])	
+	if status != 0 {
+		return cplexError(p.env.env, "GetX", status)
+	}	
+
+	for i := range x {
+		x[i] = float64(cXval[i])
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// Slack fills the slice with the slacks of the rows in the current solution.
+// This function uses CPXgetslack.
+func (p *cplexProb) Slack(slack []float64) error {
+	var status    C.int  // Status returned by Cplex
+
+	if len(slack) == 0 {
+		return nil
+	}
+
+	cSlack  := make([]C.double, len(slack))
+
+	// Get the solution using the C data structures.				
+	status = C.cGetSlack(p.env.env, p.lp, C.int(len(slack)), &cSlack[0])	
+	if status != 0 {
+		return cplexError(p.env.env, "GetSlack", status)
+	}	
+
+	for i := range slack {
+		slack[i] = float64(cSlack[i])
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// ObjSA copies the objective ranges of the columns into the slices.
+// This function uses CPXobjsa.
+func (p *cplexProb) ObjSA(lower, upper []float64) error {
+	var status    C.int  // Status returned by Cplex
+
+	if len(lower) == 0 {
+		return nil
+	}
+
+	cLower := make([]C.double, len(lower))
+	cUpper := make([]C.double, len(upper))
+
+	status = C.cObjSA(p.env.env, p.lp, C.int(len(lower)), &cLower[0], &cUpper[0])
+	if status != 0 {
+		return cplexError(p.env.env, "GetObjSA", status)
+	}
+
+	for j := range lower {
+		lower[j] = float64(cLower[j])
+		upper[j] = float64(cUpper[j])
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// RhsSA copies the right hand side ranges of the rows into the slices.
+// This function uses CPXrhssa.
+func (p *cplexProb) RhsSA(lower, upper []float64) error {
+	var status    C.int  // Status returned by Cplex
+
+	if len(lower) == 0 {
+		return nil
+	}
+
+	cLower := make([]C.double, len(lower))
+	cUpper := make([]C.double, len(upper))
+
+	status = C.cRhsSA(p.env.env, p.lp, C.int(len(lower)), &cLower[0], &cUpper[0])
+	if status != 0 {
+		return cplexError(p.env.env, "GetRHSSA", status)
+	}
+
+	for i := range lower {
+		lower[i] = float64(cLower[i])
+		upper[i] = float64(cUpper[i])
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// BoundSA copies the lower and upper bound ranges of the columns into the
+// slices. This function uses CPXboundsa.
+func (p *cplexProb) BoundSA(lbLo, lbUp, ubLo, ubUp []float64) error {
+	var status    C.int  // Status returned by Cplex
+
+	if len(lbLo) == 0 {
+		return nil
+	}
+
+	cLbLo := make([]C.double, len(lbLo))
+	cLbUp := make([]C.double, len(lbLo))
+	cUbLo := make([]C.double, len(lbLo))
+	cUbUp := make([]C.double, len(lbLo))
+
+	status = C.cBoundSA(p.env.env, p.lp, C.int(len(lbLo)), &cLbLo[0], &cLbUp[0], &cUbLo[0], &cUbUp[0])
+	if status != 0 {
+		return cplexError(p.env.env, "GetBoundSA", status)
+	}
+
+	for j := range lbLo {
+		lbLo[j] = float64(cLbLo[j])
+		lbUp[j] = float64(cLbUp[j])
+		ubLo[j] = float64(cUbLo[j])
+		ubUp[j] = float64(cUbUp[j])
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// ColNames returns the names of all columns in the problem.
+// This function uses CPXgetnumcols and CPXgetcolname.
+func (p *cplexProb) ColNames() ([]string, error) {
+	var numCols  C.int   // Number of columns in the model
+	var surplus  C.int   // Parameter from Cplex needed to calculate size of name array
+	var colSpace C.int   // Parameter needed by Cplex to get the column names
+	var status   C.int   // Status returned from Cplex
+
+	_ = C.cGetNumCols(p.env.env, p.lp, &numCols)
+	
+    status = C.cGetColNameSurplus(p.env.env, p.lp, numCols, &surplus)
+	if status != 0 {
+		return nil, cplexError(p.env.env, "GetColName", status)
+	}	
+
+	colSpace = -surplus	
+
+	// Create memory for the name array.
+	cColName := C.makeCharArray(numCols)
+	defer C.free(unsafe.Pointer(cColName))
+	cColNameStore := C.makeNameStore(colSpace)
+	defer C.free(unsafe.Pointer(cColNameStore))
+
+	status = C.cGetColNames(p.env.env, p.lp, numCols, cColName, cColNameStore, colSpace)
+	if status != 0 {
+		return nil, cplexError(p.env.env, "GetColName", status)
+	}	
+	
+	names := make([]string, int(numCols))
+	for i := 0; i < int(numCols); i++ {
+		cString := C.cGetArrayItem(cColName, C.int(i))	
+		names[i] = C.GoString(cString)
+		C.free(unsafe.Pointer(cString))			
+	}
+
+	return names, nil
+}
+
+//==============================================================================
+
+// RowNames returns the names of all rows in the problem.
+// This function uses CPXgetnumrows and CPXgetrowname.
+func (p *cplexProb) RowNames() ([]string, error) {
+	var numRows  C.int  // Number of rows in the model
+	var surplus  C.int  // Parameter from Cplex needed to calculate size of name array
+	var rowSpace C.int  // Parameter needed by Cplex to get row names
+	var status   C.int  // Status returned by Cplex
+
+	_ = C.cGetNumRows(p.env.env, p.lp, &numRows)
+	
+    status = C.cGetRowNameSurplus(p.env.env, p.lp, numRows, &surplus)
+	if status != 0 {
+		return nil, cplexError(p.env.env, "GetRowName", status)
+	}	
+
+	rowSpace = -surplus
+	
+	// Create memory for the name array.
+	cRowName := C.makeCharArray(numRows)
+	defer C.free(unsafe.Pointer(cRowName))
+	cRowNameStore := C.makeNameStore(rowSpace)
+	defer C.free(unsafe.Pointer(cRowNameStore))
+
+	status = C.cGetRowNames(p.env.env, p.lp, numRows, cRowName, cRowNameStore, rowSpace)
+	if status != 0 {
+		return nil, cplexError(p.env.env, "GetRowName", status)
+	}	
+	
+	names := make([]string, int(numRows))
+	for i := 0; i < int(numRows); i++ {
+		cString := C.cGetArrayItem(cRowName, C.int(i))	
+		names[i] = C.GoString(cString)
+		C.free(unsafe.Pointer(cString))			
+	}
+		
+	return names, nil
+}
+
+//==============================================================================
+
+// ReadCopyProb reads the problem from a data file.
+// This function uses CPXreadcopyprob.
+func (p *cplexProb) ReadCopyProb(fileName string, fileType string) error {
+	var status C.int  // Status returned by Cplex
+
+	cFileName := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cFileName))
+	cFileType := C.CString(fileType)
+	defer C.free(unsafe.Pointer(cFileType))
+	
+	status = C.cReadCopyProb(p.env.env, p.lp, cFileName, cFileType)
+	if status != 0 {
+		return cplexError(p.env.env, "ReadCopyProb", status)
+	}	
+
+	return nil	
+}
+
+//==============================================================================
+
+// WriteProb writes the problem to a data file.
+// This function uses CPXwriteprob.
+func (p *cplexProb) WriteProb(fileName string, fileType string) error {
+	var status C.int  // Status returned by Cplex
+
+	cFileName := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cFileName))
+	cFileType := C.CString(fileType)
+	defer C.free(unsafe.Pointer(cFileType))
+	
+	status = C.cWriteProb(p.env.env, p.lp, cFileName, cFileType)
+	if status != 0 {
+		return cplexError(p.env.env, "WriteProb", status)
+	}	
+
+	return nil	
+}
+
+//==============================================================================
+
+// SolWrite writes the current solution to a file.
+// This function uses CPXsolwrite.
+func (p *cplexProb) SolWrite(fileName string) error {
+	var status C.int  // Status returned by Cplex
+
+	cFileName := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cFileName))
+
+	status = C.cSolWrite(p.env.env, p.lp, cFileName)
+	if status != 0 {
+		return cplexError(p.env.env, "SolWrite", status)
+	}	
+
+	return nil	
+}
+
+//==============================================================================
+
+// cBasisArray returns a C array holding the basis statuses, and a pointer to
+// its first element, nil if it is empty.
+func cBasisArray(stat []BasisStatus) ([]C.int, *C.int) {
+
+	cStat := make([]C.int, len(stat))
+	for i := range stat {
+		cStat[i] = C.int(stat[i])
+	}
+
+	if len(cStat) == 0 {
+		return cStat, nil
+	}
+
+	return cStat, &cStat[0]
+}
+
+//==============================================================================
+
+// Base copies the basis status of the columns and rows into the slices.
+// This function uses CPXgetbase.
+func (p *cplexProb) Base(cStat, rStat []BasisStatus) error {
+	var status C.int  // Status returned by Cplex
+
+	cCols, cColPtr := cBasisArray(cStat)
+	cRows, cRowPtr := cBasisArray(rStat)
+
+	status = C.cGetBase(p.env.env, p.lp, cColPtr, cRowPtr)
+	if status != 0 {
+		return cplexError(p.env.env, "GetBase", status)
+	}
+
+	for j := range cStat {
+		cStat[j] = BasisStatus(cCols[j])
+	}
+	for i := range rStat {
+		rStat[i] = BasisStatus(cRows[i])
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// CopyBase copies a starting basis into the problem.
+// This function uses CPXcopybase.
+func (p *cplexProb) CopyBase(cStat, rStat []BasisStatus) error {
+	var status C.int  // Status returned by Cplex
+
+	_, cColPtr := cBasisArray(cStat)
+	_, cRowPtr := cBasisArray(rStat)
+
+	status = C.cCopyBase(p.env.env, p.lp, cColPtr, cRowPtr)
+	if status != 0 {
+		return cplexError(p.env.env, "CopyBase", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// ReadBasis reads a starting basis from an MPS basis file.
+// This function uses CPXreadcopybase.
+func (p *cplexProb) ReadBasis(fileName string) error {
+	var status C.int  // Status returned by Cplex
+
+	cFileName := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cFileName))
+
+	status = C.cReadBasis(p.env.env, p.lp, cFileName)
+	if status != 0 {
+		return cplexError(p.env.env, "ReadBasis", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// WriteBasis writes the basis to an MPS basis file.
+// This function uses CPXmbasewrite.
+func (p *cplexProb) WriteBasis(fileName string) error {
+	var status C.int  // Status returned by Cplex
+
+	cFileName := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cFileName))
+
+	status = C.cWriteBasis(p.env.env, p.lp, cFileName)
+	if status != 0 {
+		return cplexError(p.env.env, "WriteBasis", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// ReadMIPStarts reads MIP starts from an MST file.
+// This function uses CPXreadcopymipstarts.
+func (p *cplexProb) ReadMIPStarts(fileName string) error {
+	var status C.int  // Status returned by Cplex
+
+	cFileName := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cFileName))
+
+	status = C.cReadMIPStarts(p.env.env, p.lp, cFileName)
+	if status != 0 {
+		return cplexError(p.env.env, "ReadMIPStarts", status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// WriteMIPStarts writes all MIP starts to an MST file.
+// This function uses CPXwritemipstarts.
+func (p *cplexProb) WriteMIPStarts(fileName string) error {
+	var status C.int  // Status returned by Cplex
+
+	cFileName := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cFileName))
+
+	status = C.cWriteMIPStarts(p.env.env, p.lp, cFileName, C.int(p.NumMIPStarts()))
+	if status != 0 {
+		return cplexError(p.env.env, "WriteMIPStarts", status)
+	}
+
+	return nil
+}
+
+//============================ END OF FILE =====================================
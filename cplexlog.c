@@ -0,0 +1,67 @@
+// 01   Oct. 16, 2026   Initial version, function destinations for Cplex channels
+
+//go:build cplex
+
+// C helpers for cplexlog.go. They are kept in a separate file because a Go file
+// exporting functions to C may only declare C functions in its preamble.
+
+#include <stdint.h>
+#include <ilcplex/cplex.h>
+#include "_cgo_export.h"
+
+//------------------------------------------------------------------------------
+// Pass a message received on a channel to Go. The handle identifies the
+// environment and channel.
+static void CPXPUBLIC cLogMessage(void *handle, const char *msg) {
+
+	goLogMessage((uintptr_t) handle, (char *) msg);
+}
+
+//------------------------------------------------------------------------------
+// Add a function destination to the results, warning, error and log channels,
+// in this order, using the handles passed in.
+int cAddLogDests(CPXENVptr env, uintptr_t *handles) {
+
+	CPXCHANNELptr channels[4];
+	int status;
+	int i;
+
+	status = CPXgetchannels(env, &channels[0], &channels[1], &channels[2], &channels[3]);
+	if (status) {
+		return status;
+	}
+
+	for (i = 0; i < 4; i++) {
+		status = CPXaddfuncdest(env, channels[i], (void *) handles[i], cLogMessage);
+		if (status) {
+			return status;
+		}
+	}
+
+	return 0;
+}
+
+//------------------------------------------------------------------------------
+// Remove the function destinations added by cAddLogDests. All channels are
+// processed, and the first error encountered is returned.
+int cDelLogDests(CPXENVptr env, uintptr_t *handles) {
+
+	CPXCHANNELptr channels[4];
+	int status;
+	int first = 0;
+	int i;
+
+	status = CPXgetchannels(env, &channels[0], &channels[1], &channels[2], &channels[3]);
+	if (status) {
+		return status;
+	}
+
+	for (i = 0; i < 4; i++) {
+		status = CPXdelfuncdest(env, channels[i], (void *) handles[i], cLogMessage);
+		if (status && !first) {
+			first = status;
+		}
+	}
+
+	return first;
+}
@@ -0,0 +1,91 @@
+// 01   Oct. 16, 2026   Initial version, Cplex messages sent to Go through function destinations
+
+//go:build cplex
+
+package gpx
+
+/*
+// Only declarations may appear here, since this file exports a Go function to
+// C. The C helpers are defined in cplexlog.c.
+
+#include <stdint.h>
+#include <ilcplex/cplex.h>
+
+int cAddLogDests(CPXENVptr env, uintptr_t *handles);
+int cDelLogDests(CPXENVptr env, uintptr_t *handles);
+*/
+import "C"
+
+import (
+	"runtime/cgo"
+)
+
+// cplexLogDest identifies the environment and channel of a function destination
+// registered with Cplex. It is passed to C as a cgo.Handle.
+type cplexLogDest struct {
+	env       *cplexEnv     // Environment owning the channel
+	channel   LogChannel    // Channel to which the destination is attached
+}
+
+//==============================================================================
+
+// goLogMessage is called by Cplex, through cLogMessage, for each message sent to
+// a channel with a function destination added by SetLogFunc.
+//
+//export goLogMessage
+func goLogMessage(handle C.uintptr_t, msg *C.char) {
+
+	d := cgo.Handle(handle).Value().(*cplexLogDest)
+	if fn := d.env.logFn; fn != nil {
+		fn(d.channel, C.GoString(msg))
+	}
+}
+
+//==============================================================================
+
+// SetLogFunc sends the messages of the results, warning, error and log channels
+// to fn. A function destination is added to each channel the first time, and
+// removed again when fn is nil.
+// This function uses CPXgetchannels, CPXaddfuncdest and CPXdelfuncdest.
+func (e *cplexEnv) SetLogFunc(fn func(LogChannel, string)) error {
+	var status C.int    // Status returned by Cplex
+
+	e.logFn = fn
+
+	switch {
+	case fn != nil && e.logDests[0] == 0:
+		for i := range e.logDests {
+			e.logDests[i] = C.uintptr_t(cgo.NewHandle(&cplexLogDest{env: e, channel: LogChannel(i)}))
+		}
+		status = C.cAddLogDests(e.env, &e.logDests[0])
+		if status != 0 {
+			C.cDelLogDests(e.env, &e.logDests[0])
+			e.deleteLogHandles()
+			return cplexError(e.env, "SetLogFunc", status)
+		}
+
+	case fn == nil && e.logDests[0] != 0:
+		status = C.cDelLogDests(e.env, &e.logDests[0])
+		e.deleteLogHandles()
+		if status != 0 {
+			return cplexError(e.env, "SetLogFunc", status)
+		}
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// deleteLogHandles releases the handles of the function destinations.
+func (e *cplexEnv) deleteLogHandles() {
+
+	for i := range e.logDests {
+		if e.logDests[i] != 0 {
+			cgo.Handle(e.logDests[i]).Delete()
+			e.logDests[i] = 0
+		}
+	}
+}
+
+//============================ END OF FILE =====================================
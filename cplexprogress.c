@@ -0,0 +1,78 @@
+// 01   Oct. 16, 2026   Initial version, callbacks reporting progress to Go
+
+//go:build cplex
+
+// C helpers for cplexprogress.go. They are kept in a separate file because a Go
+// file exporting functions to C may only declare C functions in its preamble.
+
+#include <stdint.h>
+#include <ilcplex/cplex.h>
+#include "_cgo_export.h"
+
+//------------------------------------------------------------------------------
+// Informational callback, called regularly during MipOpt. Values which cannot
+// be retrieved are reported as zero.
+static int CPXPUBLIC cMipInfoCallback(CPXCENVptr env, void *cbdata, int wherefrom, void *cbhandle) {
+
+	CPXLONG nodes = 0;
+	CPXLONG iter = 0;
+	int feas = 0;
+	double bestInt = 0;
+	double bound = 0;
+
+	CPXgetcallbackinfo(env, cbdata, wherefrom, CPX_CALLBACK_INFO_NODE_COUNT_LONG, &nodes);
+	CPXgetcallbackinfo(env, cbdata, wherefrom, CPX_CALLBACK_INFO_MIP_ITERATIONS_LONG, &iter);
+	CPXgetcallbackinfo(env, cbdata, wherefrom, CPX_CALLBACK_INFO_MIP_FEAS, &feas);
+	CPXgetcallbackinfo(env, cbdata, wherefrom, CPX_CALLBACK_INFO_BEST_REMAINING, &bound);
+	if (feas) {
+		CPXgetcallbackinfo(env, cbdata, wherefrom, CPX_CALLBACK_INFO_BEST_INTEGER, &bestInt);
+	}
+
+	goProgress((uintptr_t) cbhandle, 1, (long long) iter, 0, (long long) nodes, feas, bestInt, bound);
+
+	return 0;
+}
+
+//------------------------------------------------------------------------------
+// LP callback, called at each iteration of the LP optimizers. Values which
+// cannot be retrieved are reported as zero.
+static int CPXPUBLIC cLpCallback(CPXCENVptr env, void *cbdata, int wherefrom, void *cbhandle) {
+
+	CPXLONG iter = 0;
+	double obj = 0;
+
+	CPXgetcallbackinfo(env, cbdata, wherefrom, CPX_CALLBACK_INFO_ITCOUNT_LONG, &iter);
+	CPXgetcallbackinfo(env, cbdata, wherefrom, CPX_CALLBACK_INFO_PRIMAL_OBJ, &obj);
+
+	goProgress((uintptr_t) cbhandle, 0, (long long) iter, obj, 0, 0, 0, 0);
+
+	return 0;
+}
+
+//------------------------------------------------------------------------------
+// Install both callbacks, passing the handle through to Go.
+int cSetProgressCallbacks(CPXENVptr env, uintptr_t handle) {
+
+	int status;
+
+	status = CPXsetinfocallbackfunc(env, cMipInfoCallback, (void *) handle);
+	if (status) {
+		return status;
+	}
+
+	return CPXsetlpcallbackfunc(env, cLpCallback, (void *) handle);
+}
+
+//------------------------------------------------------------------------------
+// Remove both callbacks.
+int cClearProgressCallbacks(CPXENVptr env) {
+
+	int status;
+
+	status = CPXsetinfocallbackfunc(env, NULL, NULL);
+	if (status) {
+		return status;
+	}
+
+	return CPXsetlpcallbackfunc(env, NULL, NULL);
+}
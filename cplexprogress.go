@@ -0,0 +1,82 @@
+// 01   Oct. 16, 2026   Initial version, progress events from Cplex callbacks
+
+//go:build cplex
+
+package gpx
+
+/*
+// Only declarations may appear here, since this file exports a Go function to
+// C. The C helpers are defined in cplexprogress.c.
+
+#include <stdint.h>
+#include <ilcplex/cplex.h>
+
+int cSetProgressCallbacks(CPXENVptr env, uintptr_t handle);
+int cClearProgressCallbacks(CPXENVptr env);
+*/
+import "C"
+
+import (
+	"runtime/cgo"
+)
+
+//==============================================================================
+
+// goProgress is called by the informational and LP callbacks installed by
+// SetProgressFunc, with the values retrieved by CPXgetcallbackinfo.
+//
+//export goProgress
+func goProgress(handle C.uintptr_t, mip C.int, iter C.longlong, obj C.double, nodes C.longlong,
+	feas C.int, bestInt C.double, bound C.double) {
+
+	e := cgo.Handle(handle).Value().(*cplexEnv)
+	if fn := e.progressFn; fn != nil {
+		fn(Progress{
+			MIP:          mip != 0,
+			Iterations:   int64(iter),
+			Objective:    float64(obj),
+			Nodes:        int64(nodes),
+			HasIncumbent: feas != 0,
+			BestInteger:  float64(bestInt),
+			BestBound:    float64(bound),
+		})
+	}
+}
+
+//==============================================================================
+
+// SetProgressFunc sends progress events to fn. The informational callback
+// reports MIP progress, and the LP callback reports the progress of the simplex
+// and barrier optimizers. Both are removed when fn is nil.
+// This function uses CPXsetinfocallbackfunc and CPXsetlpcallbackfunc.
+func (e *cplexEnv) SetProgressFunc(fn func(Progress)) error {
+	var status C.int    // Status returned by Cplex
+
+	e.progressFn = fn
+
+	if e.progressHandle != 0 {
+		status = C.cClearProgressCallbacks(e.env)
+		cgo.Handle(e.progressHandle).Delete()
+		e.progressHandle = 0
+		if status != 0 {
+			return cplexError(e.env, "SetProgressFunc", status)
+		}
+	}
+
+	if fn == nil {
+		return nil
+	}
+
+	e.progressHandle = C.uintptr_t(cgo.NewHandle(e))
+	status = C.cSetProgressCallbacks(e.env, e.progressHandle)
+	if status != 0 {
+		C.cClearProgressCallbacks(e.env)
+		cgo.Handle(e.progressHandle).Delete()
+		e.progressHandle = 0
+		return cplexError(e.env, "SetProgressFunc", status)
+	}
+
+	return nil
+}
+
+//============================ END OF FILE =====================================
@@ -22,14 +22,497 @@ The CPX function(s) called are listed in the comments section of the
 relevant gpx function calling them. Please refer to the CPLEX documentation for details 
 (https://www.ibm.com/support/knowledgecenter/en/SSSA5P_12.4.0/ilog.odms.cplex.help/CPLEX/maps/CPLEX_1.html).
 
+Environments and Problems
+
+The package-level functions (CreateProb, NewRows, LpOpt, GetSolution, CloseCplex,
+etc.) operate on a single default problem, which is created by CreateProb and
+released by CloseCplex. Programs which need to hold several models at the same time
+can instead open an Env with OpenCplex, create any number of Problem values in it
+with Env.CreateProb, and call the Problem methods, which mirror the package-level
+functions. Each problem is released with Problem.FreeProb, and the environment
+(including any problems not yet freed) with Env.CloseCplex.
+
+  env, err := gpx.OpenCplex()
+  ...
+  prob1, err := env.CreateProb("first")
+  prob2, err := env.CreateProb("second")
+  ...
+  err = prob1.LpOpt()
+  err = prob2.LpOpt()
+  ...
+  err = env.CloseCplex()
+
+Backends
+
+All calls to the solver are made through the Backend interface. The Cplex backend
+(CplexBackend) is compiled only when the "cplex" build tag is given, and is then
+installed as DefaultBackend, which is used by OpenCplex and the package-level
+functions. Without the tag, gpx builds on computers without Cplex or a C compiler,
+and DefaultBackend must be set by the program, or an environment opened with
+OpenEnv and an explicit backend.
+
+FakeBackend is an in-memory backend which records the calls made through it and
+answers LpOpt and MipOpt with scripted solutions. It allows programs which drive
+gpx to be unit tested without a Cplex licence:
+
+  fake := gpx.NewFakeBackend()
+  fake.Solutions = []gpx.FakeSolution{{ObjVal: 12.5, X: []float64{1, 0, 3}}}
+  gpx.DefaultBackend = fake
+  ...
+
+GoBackend solves LPs with a bounded-variable simplex method written entirely in Go,
+and MIPs with columns of type "B" or "I" by LP-based branch and bound, with a node
+limit and relative and absolute gap tolerances set in its fields (NodeLimit, RelGap,
+AbsGap). After MipOpt, GetMipSolution returns the incumbent, and GetBestObjVal and
+GetMipRelGap report how far it may be from optimal. GoBackend needs neither Cplex
+nor a C compiler, and is intended for small models and for cross-checking results
+obtained from Cplex. It does not read or write files. The LP solver is also
+available without any backend through SolveLP, which takes the gpx input data
+structures and populates the solution data structures directly:
+
+  gpx.DefaultBackend = gpx.NewGoBackend()
+  ...
+  status, err := gpx.SolveLP(1, rows, cols, elems, obj, &objVal, &sRows, &sCols)
+
+Adding Rows and Columns with Coefficients
+
+NewRows and NewCols create rows and columns without coefficients, which ChgCoefList
+adds afterwards. AddRows and AddCols (CPXaddrows, CPXaddcols) create rows or columns
+together with their coefficients in existing columns or rows, passed in compressed
+sparse form in an InputMatrix, which is much faster for large sparse models and for
+column generation. The coefficients of the k-th new column below are at positions
+Beg[k] to Beg[k+1]-1 of Ind (row indices) and Val:
+
+  err = prob.AddCols(obj, cols, gpx.InputMatrix{
+      Beg: []int{0, 2},
+      Ind: []int{0, 3, 1},
+      Val: []float64{1, 1, 4},
+  })
+
+Changing the Model
+
+A problem can be changed in place and solved again without being rebuilt. ChgBds,
+ChgRhs, ChgSense, ChgRngVal, ChgObj and ChgCType change bounds, right hand sides,
+senses, range values, objective coefficients and column types, and ChgColName and
+ChgRowName rename columns and rows. Each function takes a slice of changes, and each
+change refers to its column or row by index, or by name if the name is set:
+
+  err = prob.ChgRhs([]gpx.InputRowValue{{RowName: "capacity", Value: 120}})
+  err = prob.ChgBds([]gpx.InputBound{{ColIndex: 3, Bound: "U", Value: 10}})
+  err = prob.LpOpt()
+
+The next solve starts from the basis of the previous one (see Basis and Warm
+Starts). Changing a column to a type other than "C" turns the problem into a MIP,
+and changing all columns back to "C" turns it back into an LP.
+
+DelRows and DelCols delete a range of rows or columns, and DelSetRows and DelSetCols
+any set of them. The rows and columns which follow move up to fill the gaps, so each
+function returns a table giving the new index of every row or column, or -1 for those
+deleted, with which indices kept by the program can be updated:
+
+  newIndex, err := prob.DelSetRows([]int{0, 5})
+  ...
+  for i := range elems {
+      elems[i].RowIndex = newIndex[elems[i].RowIndex]
+  }
+
+Reading the Model
+
+GetModel reads the model held by the solver back into the data structures used to
+create it, e.g. to analyse or transform in Go a model read from a file by
+ReadCopyProb. GetRows, GetCols, GetCoefs and GetObj return each part separately, and
+GetObjSen obtains the objective sense. The results can be passed unchanged to the
+functions which create a problem:
+
+  var rows  []gpx.InputRow
+  var cols  []gpx.InputCol
+  var elems []gpx.InputElem
+  var obj   []gpx.InputObjCoef
+  var sense int
+  err = src.GetModel(&rows, &cols, &elems, &obj)
+  err = src.GetObjSen(&sense)
+  ...
+  err = dst.NewRows(rows)
+  err = dst.NewCols(obj, cols)
+  err = dst.ChgCoefList(elems)
+  err = dst.ChgObjSen(sense)
+
+LP Optimizers
+
+LpOpt lets Cplex choose the optimizer, as set by ParamLpMethod. PrimOpt, DualOpt and
+BarOpt call the primal simplex, dual simplex and barrier optimizers directly;
+HybBarOpt runs the barrier with a primal or dual crossover, or without crossover
+(AlgNone) when a non-basic solution is good enough, and HybNetOpt solves the
+embedded network before finishing with a simplex method. LpOptMethod selects any
+optimizer for a single solve, including sifting and concurrent optimization,
+without changing ParamLpMethod, and returns the algorithm which produced the
+solution:
+
+  alg, err := prob.LpOptMethod(gpx.AlgBarrier)
+  if err != nil {
+      ...
+  }
+  log.Printf("solved by %s", alg)
+
+GoBackend solves every LP with its primal simplex method, and reports AlgPrimal.
+
+Quadratic Objectives
+
+CopyQuad sets the matrix Q of a quadratic objective c'x + 1/2 x'Qx, turning an LP
+into a QP and a MIP into a MIQP, ChgQPCoef changes some of its coefficients, and
+GetQuad reads it back. Each InputQuadCoef gives one coefficient of Q, whose row and
+column are both columns of the problem; off-diagonal coefficients given once are
+used for both symmetric positions. A Markowitz model minimizing the variance of a
+portfolio of two assets could use:
+
+  err = prob.CopyQuad([]gpx.InputQuadCoef{
+      {RowIndex: 0, ColIndex: 0, Value: 2 * 0.04},
+      {RowIndex: 0, ColIndex: 1, Value: 2 * 0.01},
+      {RowIndex: 1, ColIndex: 1, Value: 2 * 0.09},
+  })
+  err = prob.QPOpt()
+  err = prob.GetSolution(&objVal, &sRows, &sCols)
+
+After QPOpt, GetSolution returns the duals of the rows (Pi) and the reduced costs
+of the columns (RedCost) as for an LP. A MIQP is solved by MipOpt and its solution
+obtained by GetMipSolution. GoBackend stores quadratic objectives but cannot solve
+QPs or MIQPs.
+
+Quadratic Constraints
+
+AddQConstrs adds quadratic constraints, each with a linear and a quadratic part, a
+sense ("L" or "G") and a right hand side, turning the problem into a QCP, or a
+MIQCP if it has integer columns. Unlike the quadratic objective, each
+coefficient of the quadratic part adds one term Value * x[RowIndex] * x[ColIndex].
+A QCP is solved by BarOpt, and a MIQCP by MipOpt. A risk constraint limiting the
+variance of a portfolio of two assets to 0.02 could be written:
+
+  err = prob.AddQConstrs([]gpx.InputQConstr{{
+      Name:  "risk",
+      Sense: "L",
+      Rhs:   0.02,
+      Quad:  []gpx.InputQuadCoef{
+          {RowIndex: 0, ColIndex: 0, Value: 0.04},
+          {RowIndex: 0, ColIndex: 1, Value: 0.02},
+          {RowIndex: 1, ColIndex: 1, Value: 0.09},
+      },
+  }})
+  err = prob.BarOpt()
+  err = prob.GetQConstrSolution(&sQConstrs)
+
+Second-order cone constraints, such as x0*x0 + x1*x1 - t*t <= 0 with t >= 0, are
+recognized by Cplex. GetQConstrSolution returns the slack of each quadratic
+constraint and its dual slack vector, which Cplex provides instead of a single
+dual value; the linear rows and the columns are obtained by GetSolution as usual.
+GoBackend stores quadratic constraints but cannot solve problems which have them.
+
+Indicator Constraints
+
+AddIndConstr adds indicator constraints to a MIP, each made of a binary column, the
+value of that column (0 or 1) for which the constraint is active, and a linear
+constraint which must hold while it is. They replace big-M rows, which often cause
+numerical trouble. Forcing the start time of a job (column 1) to be at least 8
+when it runs on the night shift (binary column 0) could be written:
+
+  err = prob.AddIndConstr([]gpx.InputIndicator{{
+      Name:        "night",
+      ColIndex:    0,
+      ActiveValue: 1,
+      Lin:         []gpx.InputLinCoef{{ColIndex: 1, Value: 1}},
+      Sense:       "G",
+      Rhs:         8,
+  }})
+  err = prob.MipOpt()
+
+GetIndConstr returns the indicator constraints of the problem. GoBackend stores
+indicator constraints but cannot solve problems which have them.
+
+Special Ordered Sets
+
+AddSOS adds special ordered sets to a problem, which becomes a MIP solved by MipOpt.
+At most one member of a set of type 1 may be non-zero, which models an exclusive
+choice; at most two members of a set of type 2 may be non-zero, and they must be
+adjacent in the order of the weights, which models a piecewise linear function
+over breakpoints. The weights must be distinct:
+
+  err = prob.AddSOS([]gpx.InputSOS{{
+      Name:    "curve",
+      Type:    2,
+      Members: []gpx.InputSOSMember{
+          {ColIndex: 0, Weight: 1}, {ColIndex: 1, Weight: 2}, {ColIndex: 2, Weight: 3},
+      },
+  }})
+
+GetSOS returns the sets of the problem, and DelSOS deletes a range of them. GoBackend
+stores special ordered sets but cannot solve problems which have them.
+
+Piecewise Linear and General Constraints
+
+AddPwl adds piecewise linear constraints y = f(x), where f is given by its
+breakpoints and by its slopes before the first and after the last breakpoint; the
+problem becomes a MIP. A cost rising by 2 per unit up to 10 units, and by 5 per
+unit beyond, could be written:
+
+  err = prob.AddPwl([]gpx.InputPwl{{
+      Name:      "cost",
+      YCol:      1,
+      XCol:      0,
+      PreSlope:  0,
+      PostSlope: 5,
+      Breaks:    []gpx.InputPwlBreak{{X: 0, Y: 0}, {X: 10, Y: 20}},
+  }})
+
+GetPwl returns the piecewise linear constraints of the problem. They require
+Cplex 12.9 or later.
+
+AddGenConstr sets a result column to the absolute value of a column ("ABS"), to
+the minimum or maximum of several columns ("MIN", "MAX"), or to the logical and
+or or of several binary columns ("AND", "OR"). Since the Cplex callable library has
+no functions for these constraints, gpx adds them as a piecewise linear
+constraint for "ABS", as rows for "AND" and "OR", and as rows, binary columns and
+indicator constraints for "MIN" and "MAX"; the new rows and columns are appended to
+the problem, and named after the constraint:
+
+  err = prob.AddGenConstr([]gpx.InputGenConstr{
+      {Name: "dev",  Type: "ABS", ResCol: 3, Cols: []int{2}},
+      {Name: "peak", Type: "MAX", ResCol: 4, Cols: []int{0, 1, 2}},
+  })
+
+GoBackend stores piecewise linear constraints but cannot solve problems which have
+them.
+
+MIP Starts
+
+AddMIPStarts gives MipOpt one or more known solutions to start from, with an effort
+level which tells Cplex how much work to spend on turning each of them into a first
+integer solution. A start need not give a value to every column, and each value
+refers to its column by index or by name, so that a problem which is built again
+every night can start from the solution of the previous night:
+
+  var objVal float64
+  var rows []gpx.SolnRow
+  var cols []gpx.SolnCol
+  err = yesterday.GetMipSolution(&objVal, &rows, &cols)
+
+  start := gpx.InputMIPStart{Name: "yesterday", Effort: gpx.EffortRepair}
+  for _, c := range cols {
+      start.Values = append(start.Values, gpx.InputColValue{ColName: c.Name, Value: c.Value})
+  }
+  err = today.AddMIPStarts([]gpx.InputMIPStart{start})
+
+ChgMIPStarts changes the effort and values of existing starts, and GetMIPStarts
+returns them. WriteMIPStarts and ReadMIPStarts save the starts to an MST file and
+read them back, so that they can be kept between runs. GoBackend uses a start which
+gives a value to every column and is feasible as the first incumbent, whatever its
+effort level, and ignores the others.
+
+Solution Pool
+
+GetMipSolution returns the incumbent only. MipOpt also keeps the integer solutions
+it finds in the solution pool, and Populate generates further solutions, so that
+several near-optimal alternatives can be compared. ParamPopulateLimit sets how
+many solutions Populate generates, ParamPoolCapacity how many the pool keeps and
+ParamPoolReplace which of them a full pool replaces, while ParamPoolRelGap and
+ParamPoolAbsGap keep only solutions close to the incumbent. To collect up to 100
+plans within 2% of the optimum:
+
+  err = env.SetIntParam(gpx.ParamPopulateLimit, 100)
+  err = env.SetDblParam(gpx.ParamPoolRelGap, 0.02)
+  err = prob.Populate()
+
+  var numSolns int
+  err = prob.GetPoolNumSolns(&numSolns)
+  for i := 0; i < numSolns; i++ {
+      err = prob.GetPoolSolution(i, &objVal, &rows, &cols)
+      ...
+  }
+
+AddPoolFilters restricts the solutions which may enter the pool, either by a
+range on a linear expression, or by their distance to a reference solution, so
+that the alternatives differ in at least a given number of binary columns.
+GoBackend enumerates the solutions in its branch and bound tree, and keeps only
+the pool of the last MipOpt or Populate.
+
+Solution Status
+
+LpOpt and MipOpt only return an error if the optimizer could not be run. Whether
+the problem was solved to optimality, proven infeasible or unbounded, or stopped
+at a limit is reported by GetStatus as a SolutionStatus, whose values are the Cplex
+solution status codes (StatOptimal, StatInfeasible, MipOptimal, MipNodeLimFeas,
+etc.), and which provides String, IsOptimal, IsInfeasible, IsUnbounded and
+HitLimit. GetSolnInfo reports the algorithm which produced the solution, the type
+of solution, and whether it is primal and dual feasible. GetSolution and
+GetMipSolution return an error if no primal feasible solution exists:
+
+  if err = gpx.LpOpt(); err != nil {
+      return errors.Wrap(err, "Failed to solve the LP")
+  }
+  var status gpx.SolutionStatus
+  if err = gpx.GetStatus(&status); err == nil && !status.IsOptimal() {
+      return errors.Errorf("LP not solved to optimality: %s", status)
+  }
+
+LpOptContext and MipOptContext solve the problem like LpOpt and MipOpt, but stop
+the optimizer once a context is cancelled or its deadline passes (Cplex checks a
+termination flag installed with CPXsetterminate). The status is then StatAbortUser
+or MipAbortFeas/MipAbortInfeas (see IsAborted), the best integer solution found so
+far can still be retrieved with GetMipSolution, and the function returns an error
+wrapping ctx.Err():
+
+  err = prob.MipOptContext(r.Context())
+  if errors.Is(err, context.Canceled) {
+      ... client went away, prob.GetMipSolution still returns the incumbent ...
+  }
+
+Sensitivity Analysis
+
+After LpOpt has found an optimal basis, GetObjSA, GetRHSSA and GetBoundSA report how
+far the data may move before the basis changes (CPXobjsa, CPXrhssa, CPXboundsa).
+Each returns one entry per column or row, named like SolnCol and SolnRow: the range
+of each objective coefficient over which the basis remains optimal, and the ranges
+of each right hand side and bound over which it remains feasible, so that the duals
+of GetSolution keep pricing the rows. Ranges without a limit are reported as
+-/+1.0e20 (CPX_INFBOUND). The functions are not available after MipOpt:
+
+  var saCols []gpx.SolnObjSA
+  if err = prob.GetObjSA(&saCols); err != nil {
+      ...
+  }
+  for _, c := range saCols {
+      fmt.Printf("%s: optimal for prices in [%g, %g]\n", c.Name, c.Lower, c.Upper)
+  }
+
+GoBackend computes the ranges from the optimal basis of its simplex solver, and
+FakeBackend returns the ranges scripted in FakeSolution.
+
+Basis and Warm Starts
+
+GetBase returns the status of each column and row in the basis found by LpOpt as a
+BasisStatus (BasisBasic, BasisAtLower, BasisAtUpper, BasisFreeSuper), and CopyBase
+installs a starting basis for the next LpOpt (CPXgetbase, CPXcopybase). ReadBasis
+and WriteBasis exchange bases with other tools through MPS basis files (.bas).
+A problem which is modified and solved again starts from the basis of the previous
+solve, as long as ParamAdvance is 1 (the default), so the usual warm start needs no
+code at all. CopyBase accepts a basis shorter than the problem, placing appended
+columns at their lower bound and making the slacks of appended rows basic, so that
+a basis saved from one problem can seed a rebuilt or extended copy of it:
+
+  var cStat, rStat []gpx.BasisStatus
+  if err = base.GetBase(&cStat, &rStat); err != nil {
+      ...
+  }
+  ... build variant with extra rows and columns ...
+  if err = variant.CopyBase(cStat, rStat); err != nil {
+      ...
+  }
+  err = variant.LpOpt()
+
+GoBackend starts from the basis when it is primal feasible for the modified
+problem, and from the slack basis otherwise.
+
+Solver Messages
+
+OutputToScreen only turns the echo of Cplex messages to stdout on or off. To capture
+the messages, SetLogWriter, SetLogger or SetLogFunc attach a destination to the
+results, warning, error and log channels of an environment (CPXaddfuncdest).
+Messages sent while a problem is being solved, read or written are tagged with the
+name of the problem given to CreateProb or ChgProbName: SetLogWriter prefixes each
+line with the name in brackets, and SetLogger adds "problem" and "channel"
+attributes to each record, logging errors and warnings at the matching slog levels:
+
+  logger := slog.Default().With("component", "planner")
+  if err = env.SetLogger(logger); err != nil {
+      ...
+  }
+
+GoBackend sends a one-line summary of each solve to the results channel, and
+FakeBackend sends the Log messages of its scripted solutions.
+
+Progress
+
+SetProgressFunc and SetProgressChan deliver Progress events while a problem is solved
+by LpOpt, MipOpt or their Context variants. MIP events carry the node and iteration
+counts, the best integer solution and bound, and the relative gap; LP events carry the
+iteration count and the current objective. Events are throttled to a minimum
+interval, except that a new incumbent is always reported. The channel variant never
+blocks the optimizer, events are dropped while the channel is full:
+
+  ch := make(chan gpx.Progress, 16)
+  if err = prob.SetProgressChan(ch, 500*time.Millisecond); err != nil {
+      ...
+  }
+  go func() {
+      for pr := range ch {
+          fmt.Printf("%6d nodes  gap %.2f%%\n", pr.Nodes, 100*pr.RelGap)
+      }
+  }()
+
+The Cplex backend uses the informational callback for MIPs and the LP callback for
+LPs (CPXsetinfocallbackfunc, CPXsetlpcallbackfunc). GoBackend reports MIP progress
+only, and FakeBackend delivers the Progress events of its scripted solutions.
+
+Errors
+
+When a Cplex function fails, gpx returns a *CplexError holding the name of the gpx
+operation, the Cplex error code, and the message Cplex associates with the code
+(CPXgeterrorstring). gpx itself does not print anything to stderr. The error may be
+wrapped, so it should be retrieved with errors.As. The common cases can also be
+tested with errors.Is against the sentinel errors ErrNoProblem, ErrNoSolution,
+ErrNotForMIP, ErrNoMemory and ErrFileOpen, which are also returned by gpx and the
+Go backends when the same condition is detected without calling Cplex:
+
+  err = gpx.ReadCopyProb(fileName, fileType)
+  var cerr *gpx.CplexError
+  switch {
+  case errors.Is(err, gpx.ErrFileOpen):
+      ...
+  case errors.As(err, &cerr):
+      log.Printf("Cplex error %d in %s: %s", cerr.Code, cerr.Op, cerr.Msg)
+  }
+
+Parameters
+
+Cplex parameters apply to all problems in an environment, and are set and retrieved
+with SetIntParam, SetDblParam, SetLongParam, SetStrParam and the matching Get
+functions, either on an Env or, through the package-level functions, on the
+environment of the default problem. Commonly used parameters are declared with
+their Cplex id and the type of value they hold (ParamTimeLimit, ParamMipGap,
+ParamThreads, ParamMipEmphasis, ParamLpMethod, ParamNodeLimit, ParamFeasibilityTol,
+etc.), so that passing one to the wrong function is a compile-time error. Params
+lists them with their Cplex names and default values. SetDefaults resets all
+parameters to the values they have in a newly opened environment:
+
+  if err = gpx.SetDblParam(gpx.ParamTimeLimit, 60); err != nil {
+      ...
+  }
+  err = gpx.SetIntParam(gpx.ParamMipEmphasis, gpx.MipEmphasisFeasibility)
+
+GoBackend honours the iteration, node and time limits and the MIP gap and
+integrality tolerances, and FakeBackend stores the values set and reports them back.
+
+ReadParamFile and WriteParamFile read and write Cplex parameter files. Settings can
+also be kept on the Go side in a ParamSet, whose text form is the format of these
+files (one "CPXPARAM_<name> <value>" per line, "#" starting a comment). Sets can be
+loaded with ParseParamSet or ReadParamSet, combined with Merge, compared with Diff,
+and applied to the environment of a problem with ApplyParamSet. GetParamSet
+retrieves the parameters of an environment which are not at their default:
+
+  tuned, err := gpx.ReadParamSet("scheduling.prm")
+  ...
+  set := base.Clone()
+  set.Merge(tuned)
+  if err = prob.ApplyParamSet(set); err != nil {
+      ...
+  }
+
 The executable provided with the package illustrates how the gpx package can be
 used and contains an exerciser to allow each function to be tested independently.
 
 Dependencies
 
 Package gpx requires the following:
-  - Cplex to be installed and configured correctly.
-  - C compiler to be installed and configured correctly.
+  - Cplex to be installed and configured correctly (only with the "cplex" build tag).
+  - C compiler to be installed and configured correctly (only with the "cplex" build tag).
   - Package github.com/pkg/errors to be installed.
 
 Example
@@ -0,0 +1,89 @@
+// 01   Oct. 16, 2026   Initial version, CplexError and sentinel errors
+// 02   Oct. 16, 2026   Added ErrNoBasis
+
+package gpx
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+)
+
+// Sentinel errors for the common failure cases. They can be tested with
+// errors.Is, both on errors returned by gpx itself and on CplexError values
+// carrying the corresponding Cplex error code:
+//
+//	if err = prob.GetSolution(&objVal, &sRows, &sCols); errors.Is(err, gpx.ErrNoSolution) {
+//	    ...
+//	}
+var (
+	ErrNoProblem   = errors.New("Problem does not exist or has been freed")   // CPXERR_NO_PROBLEM
+	ErrNoSolution  = errors.New("No solution exists")                          // CPXERR_NO_SOLN
+	ErrNotForMIP   = errors.New("Not available for mixed integer problems")    // CPXERR_NOT_FOR_MIP
+	ErrNoMemory    = errors.New("Out of memory")                               // CPXERR_NO_MEMORY
+	ErrFileOpen    = errors.New("Could not open file")                         // CPXERR_FAIL_OPEN_READ/WRITE
+	ErrNoBasis     = errors.New("No basis exists")                             // CPXERR_NO_BASIS
+)
+
+// Cplex error codes matched by the sentinel errors.
+const (
+	cpxErrNoMemory      = 1001    // CPXERR_NO_MEMORY
+	cpxErrNoProblem     = 1009    // CPXERR_NO_PROBLEM
+	cpxErrNotForMIP     = 1017    // CPXERR_NOT_FOR_MIP
+	cpxErrNoSoln        = 1217    // CPXERR_NO_SOLN
+	cpxErrNoBasicSoln   = 1261    // CPXERR_NO_BASIC_SOLN
+	cpxErrNoBasis       = 1262    // CPXERR_NO_BASIS
+	cpxErrFailOpenWrite = 1422    // CPXERR_FAIL_OPEN_WRITE
+	cpxErrFailOpenRead  = 1423    // CPXERR_FAIL_OPEN_READ
+)
+
+// CplexError is returned when a Cplex function fails. It holds the error code
+// returned by Cplex, the message Cplex associates with it, and the gpx
+// operation which failed. Callers can retrieve it with errors.As:
+//
+//	var cerr *gpx.CplexError
+//	if errors.As(err, &cerr) && cerr.Code == 1423 {
+//	    ...
+//	}
+type CplexError struct {
+	Op      string    // Name of the gpx operation which failed (e.g. "ReadCopyProb")
+	Code    int       // Error code returned by Cplex
+	Msg     string    // Message returned by CPXgeterrorstring, empty if unknown
+}
+
+//==============================================================================
+
+// Error returns the operation, error code and message as a single string.
+func (e *CplexError) Error() string {
+
+	if e.Msg == "" {
+		return fmt.Sprintf("%s failed with Cplex error %d", e.Op, e.Code)
+	}
+
+	return fmt.Sprintf("%s failed: %s", e.Op, e.Msg)
+}
+
+//==============================================================================
+
+// Is reports whether the error code corresponds to the sentinel error target,
+// so that errors.Is(err, ErrNoSolution) succeeds for CPXERR_NO_SOLN.
+func (e *CplexError) Is(target error) bool {
+
+	switch target {
+	case ErrNoProblem:
+		return e.Code == cpxErrNoProblem
+	case ErrNoSolution:
+		return e.Code == cpxErrNoSoln
+	case ErrNotForMIP:
+		return e.Code == cpxErrNotForMIP
+	case ErrNoMemory:
+		return e.Code == cpxErrNoMemory
+	case ErrNoBasis:
+		return e.Code == cpxErrNoBasis || e.Code == cpxErrNoBasicSoln
+	case ErrFileOpen:
+		return e.Code == cpxErrFailOpenRead || e.Code == cpxErrFailOpenWrite
+	}
+
+	return false
+}
+
+//============================ END OF FILE =====================================
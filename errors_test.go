@@ -0,0 +1,78 @@
+package gpx
+
+import (
+	"github.com/pkg/errors"
+	"testing"
+)
+
+func TestCplexErrorIs(t *testing.T) {
+
+	sentinels := []error{ErrNoProblem, ErrNoSolution, ErrNotForMIP, ErrNoMemory, ErrFileOpen, ErrNoBasis}
+
+	tests := []struct {
+		code  int
+		want  error   // Sentinel matched by the code, nil for none
+	}{
+		{cpxErrNoMemory, ErrNoMemory},
+		{cpxErrNoProblem, ErrNoProblem},
+		{cpxErrNotForMIP, ErrNotForMIP},
+		{cpxErrNoSoln, ErrNoSolution},
+		{cpxErrNoBasicSoln, ErrNoBasis},
+		{cpxErrNoBasis, ErrNoBasis},
+		{cpxErrFailOpenWrite, ErrFileOpen},
+		{cpxErrFailOpenRead, ErrFileOpen},
+		{1003, nil},
+	}
+
+	for _, tt := range tests {
+
+		// The error is wrapped, as the Problem methods do.
+		err := errors.Wrap(&CplexError{Op: "Op", Code: tt.code}, "Operation failed")
+
+		for _, s := range sentinels {
+			if got := errors.Is(err, s); got != (s == tt.want) {
+				t.Errorf("errors.Is(code %d, %q) = %t, want %t", tt.code, s, got, s == tt.want)
+			}
+		}
+
+		var cerr *CplexError
+		if !errors.As(err, &cerr) || cerr.Code != tt.code {
+			t.Errorf("errors.As(code %d) = %v, want the CplexError", tt.code, cerr)
+		}
+	}
+
+	if errors.Is(&CplexError{Code: cpxErrNoSoln}, errors.New("No solution exists")) {
+		t.Error("CplexError matches an error other than the sentinel")
+	}
+}
+
+//==============================================================================
+
+func TestCplexErrorMessage(t *testing.T) {
+
+	tests := []struct {
+		err   *CplexError
+		want  string
+	}{
+		{&CplexError{"LpOpt", 1001, ""}, "LpOpt failed with Cplex error 1001"},
+		{&CplexError{"ReadCopyProb", 1423, "CPLEX Error  1423: Could not open file 'x.lp' for reading."},
+			"ReadCopyProb failed: CPLEX Error  1423: Could not open file 'x.lp' for reading."},
+	}
+
+	for _, tt := range tests {
+		if got := tt.err.Error(); got != tt.want {
+			t.Errorf("Error() = %q, want %q", got, tt.want)
+		}
+	}
+
+	// Errors scripted on the fake backend reach the caller unchanged.
+	fake := NewFakeBackend()
+	_, prob := newTestProb(t, fake, fakeModel("C"))
+	fake.Errors["LpOpt"] = &CplexError{Op: "LpOpt", Code: cpxErrNoMemory}
+
+	if err := prob.LpOpt(); !errors.Is(err, ErrNoMemory) {
+		t.Errorf("LpOpt = %v, want %v", err, ErrNoMemory)
+	}
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,1480 @@
+// 01   Oct. 16, 2026   Initial version, in-memory backend for unit tests
+// 02   Oct. 16, 2026   Added BestObjVal and MipRelGap
+// 03   Oct. 16, 2026   Added Status and SolnInfo
+// 04   Oct. 16, 2026   Return sentinel errors
+// 05   Oct. 16, 2026   Added parameter functions
+// 06   Oct. 16, 2026   LpOpt and MipOpt take a context, added FakeSolution.Delay
+// 07   Oct. 16, 2026   Added SetLogFunc and FakeSolution.Log
+// 08   Oct. 16, 2026   Added SetProgressFunc and FakeSolution.Progress
+// 09   Oct. 16, 2026   Added ObjSA, RhsSA and BoundSA with scripted ranges
+// 10   Oct. 16, 2026   Added Base, CopyBase, ReadBasis and WriteBasis
+// 11   Oct. 16, 2026   Added PrimOpt, DualOpt, BarOpt and HybNetOpt
+// 12   Oct. 16, 2026   Added ChgBds, ChgRhs, ChgSense, ChgRngVal, ChgObj, ChgCType and renaming
+// 13   Oct. 16, 2026   Added DelRows, DelCols, DelSetRows and DelSetCols
+// 14   Oct. 16, 2026   Added ObjSen, Rows, Cols, Obj and Coefs
+// 15   Oct. 16, 2026   Added AddRows and AddCols
+// 16   Oct. 16, 2026   Added CopyQuad, ChgQPCoef, Quad and QPOpt
+// 17   Oct. 16, 2026   Added quadratic constraints, scripted QSlack and QDualSlack
+// 18   Oct. 16, 2026   Added indicator constraints
+// 19   Oct. 16, 2026   Added special ordered sets
+// 20   Oct. 16, 2026   Added piecewise linear constraints
+// 21   Oct. 16, 2026   Added MIP starts
+// 22   Oct. 16, 2026   Added Populate, scripted FakeSolution.Pool, and solution pool filters
+// 23   Oct. 16, 2026   Added DelIndConstrs and DelPwl
+// 24   Oct. 16, 2026   Quadratic constraints are kept as received, gpx having copied them
+// 25   Oct. 16, 2026   Indicator constraints are kept as received
+// 26   Oct. 16, 2026   Special ordered sets are kept as received
+// 27   Oct. 16, 2026   Piecewise linear constraints are kept as received
+// 28   Oct. 16, 2026   MIP starts are kept as received
+// 29   Oct. 16, 2026   Solution pool filters are kept as received
+
+package gpx
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"sync"
+	"time"
+)
+
+// FakeBackend is an in-memory Backend which does not solve anything. It keeps
+// the rows, columns, and coefficients passed to it, records the calls made
+// through it, and answers LpOpt and MipOpt with scripted solutions. It is
+// intended for unit testing programs which drive gpx on computers where Cplex
+// is not installed, for example:
+//
+//	fake := gpx.NewFakeBackend()
+//	fake.Solutions = []gpx.FakeSolution{{ObjVal: 3, X: []float64{1, 2}}}
+//	gpx.DefaultBackend = fake
+//	... code under test calls CreateProb, NewRows, LpOpt, GetSolution ...
+//	calls := fake.Calls()
+//
+// ReadCopyProb, WriteProb, ReadBasis and WriteBasis only record the call, the
+// model is not changed.
+// Parameters are stored and reported back, but do not affect the solutions;
+// parameters which have not been set report their default value.
+// Pure queries (NumRows, NumCols, ColNames, RowNames, Get*Param) are not
+// recorded.
+// Errors lets tests simulate Cplex failures, e.g.
+// fake.Errors["ReadCopyProb"] = &gpx.CplexError{Op: "ReadCopyProb", Code: 1423}.
+// A FakeBackend may be shared by several environments and problems.
+type FakeBackend struct {
+	Solutions []FakeSolution     // Solutions returned by successive LpOpt and MipOpt calls
+	Errors    map[string]error   // Errors returned by operations, keyed by name (e.g. "MipOpt")
+
+	mu        sync.Mutex         // Protects all fields of the backend
+	calls     []FakeCall         // Calls recorded so far
+}
+
+// FakeCall records one call made through a FakeBackend.
+type FakeCall struct {
+	Prob   string           // Name of the problem, empty for environment calls
+	Op     string           // Name of the operation (e.g. "NewRows")
+	Args   []interface{}    // Copies of the arguments passed to the operation
+}
+
+// FakeSolution defines a scripted solution returned by a FakeBackend. Slices
+// shorter than the number of rows or columns are padded with zeros. If Err is
+// set, the solve returns it and leaves the problem without a solution.
+// If Status is not set, StatOptimal is reported after LpOpt, MipOptimal after
+// MipOpt and MipOptimalPopulated after Populate. The solution is reported as primal feasible if the status
+// implies that a feasible solution exists (e.g. StatOptimal or MipNodeLimFeas),
+// and as dual feasible if it is an optimal LP solution.
+// If Delay is set, the solve takes that long, unless the context passed to
+// LpOptContext or MipOptContext is done first; the solve then reports
+// StatAbortUser after LpOpt, and MipAbortFeas (or MipAbortInfeas if X is not
+// set) after MipOpt, keeping the scripted values as the incumbent.
+// The sensitivity ranges are returned by GetObjSA, GetRHSSA and GetBoundSA
+// after LpOpt; after MipOpt these fail with ErrNotForMIP, as they do with Cplex.
+// After LpOpt, GetBase returns the scripted basis, with columns at their lower
+// bound and rows basic where ColBasis and RowBasis are short, until it is
+// replaced by CopyBase. QSlack and QDualSlack are returned by
+// GetQConstrSolution, one entry per quadratic constraint. Pool holds the
+// solutions returned by GetPoolSolution after MipOpt or Populate, of which only
+// ObjVal, X and Slack are used.
+type FakeSolution struct {
+	Status     SolutionStatus   // Status reported after the solve
+	ObjVal     float64          // Objective function value
+	X          []float64        // Column values
+	RedCost    []float64        // Column reduced costs
+	Pi         []float64        // Row duals
+	Slack      []float64        // Row slacks
+	BestObjVal float64          // Best bound, returned by BestObjVal after MipOpt
+	Err        error            // Error returned by the solve
+	Delay      time.Duration    // Time the solve takes
+	Log        []string         // Messages sent to the results channel by the solve
+	Progress   []Progress       // Progress events sent by the solve
+	ObjLower   []float64        // Lower ends of the objective ranges
+	ObjUpper   []float64        // Upper ends of the objective ranges
+	RhsLower   []float64        // Lower ends of the right hand side ranges
+	RhsUpper   []float64        // Upper ends of the right hand side ranges
+	LbLower    []float64        // Lower ends of the lower bound ranges
+	LbUpper    []float64        // Upper ends of the lower bound ranges
+	UbLower    []float64        // Lower ends of the upper bound ranges
+	UbUpper    []float64        // Upper ends of the upper bound ranges
+	ColBasis   []BasisStatus    // Basis status of the columns after LpOpt
+	RowBasis   []BasisStatus    // Basis status of the rows after LpOpt
+	QSlack     []float64        // Quadratic constraint slacks
+	QDualSlack [][]InputLinCoef // Dual slack vectors of the quadratic constraints
+	Pool       []FakeSolution   // Solutions in the solution pool
+}
+
+// fakeEnv implements BackendEnv for a FakeBackend.
+type fakeEnv struct {
+	paramStore              // Parameters set in the environment
+	b         *FakeBackend  // Backend which opened the environment
+	logFn     func(LogChannel, string) // Destination of the messages, nil if none
+	progressFn func(Progress) // Destination of the progress events, nil if none
+}
+
+// fakeProb implements Solver for a FakeBackend.
+type fakeProb struct {
+	memModel                // Model passed to the problem
+	b         *FakeBackend  // Backend which owns the problem
+	env       *fakeEnv      // Environment in which the problem was created
+	soln      *FakeSolution // Current solution, nil if not solved
+	mip       bool          // Flag set if the current solution was found by MipOpt
+	base      *lpBasis      // Current basis, nil if none
+	method    Algorithm     // Optimizer reported by SolnInfo after an LP optimizer
+	nonbasic  bool          // Flag set if the solution was found by BarOpt without crossover
+}
+
+//==============================================================================
+
+// NewFakeBackend returns an empty FakeBackend with no scripted solutions.
+func NewFakeBackend() *FakeBackend {
+
+	return &FakeBackend{Errors: make(map[string]error)}
+}
+
+//==============================================================================
+
+// Calls returns a copy of the calls recorded by the backend, in the order in
+// which they were made.
+func (b *FakeBackend) Calls() []FakeCall {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]FakeCall(nil), b.calls...)
+}
+
+//==============================================================================
+
+// record appends a call to the list and returns the error scripted for the
+// operation, if any. The caller must not hold the lock.
+func (b *FakeBackend) record(prob string, op string, args ...interface{}) error {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.calls = append(b.calls, FakeCall{Prob: prob, Op: op, Args: args})
+
+	return b.Errors[op]
+}
+
+//==============================================================================
+
+// nextSolution removes and returns the next scripted solution, or an all-zero
+// solution if none remain.
+func (b *FakeBackend) nextSolution() FakeSolution {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.Solutions) == 0 {
+		return FakeSolution{}
+	}
+
+	s := b.Solutions[0]
+	b.Solutions = b.Solutions[1:]
+
+	return s
+}
+
+//==============================================================================
+// ENVIRONMENT FUNCTIONS
+//==============================================================================
+
+// OpenEnv opens a new fake environment.
+func (b *FakeBackend) OpenEnv() (BackendEnv, error) {
+
+	if err := b.record("", "OpenEnv"); err != nil {
+		return nil, err
+	}
+
+	e := &fakeEnv{b: b}
+	e.hook = func(op string, args ...interface{}) error {
+		return b.record("", op, args...)
+	}
+
+	return e, nil
+}
+
+// CreateProb creates a new, empty fake problem.
+func (e *fakeEnv) CreateProb(name string) (Solver, error) {
+
+	if err := e.b.record(name, "CreateProb", name); err != nil {
+		return nil, err
+	}
+
+	return &fakeProb{memModel: memModel{name: name, sense: 1}, b: e.b, env: e}, nil
+}
+
+// OutputToScreen records the requested setting.
+func (e *fakeEnv) OutputToScreen(echoOn bool) error {
+
+	return e.b.record("", "OutputToScreen", echoOn)
+}
+
+// SetLogFunc records the call and sets the destination of the messages of
+// the scripted solutions.
+func (e *fakeEnv) SetLogFunc(fn func(LogChannel, string)) error {
+
+	if err := e.b.record("", "SetLogFunc", fn != nil); err != nil {
+		return err
+	}
+
+	e.logFn = fn
+
+	return nil
+}
+
+// SetProgressFunc sets the destination of the progress events of the scripted
+// solutions. The call is not recorded, since gpx makes it around each solve.
+func (e *fakeEnv) SetProgressFunc(fn func(Progress)) error {
+
+	e.progressFn = fn
+
+	return nil
+}
+
+// CloseEnv closes the fake environment.
+func (e *fakeEnv) CloseEnv() error {
+
+	return e.b.record("", "CloseEnv")
+}
+
+//==============================================================================
+// PROBLEM FUNCTIONS
+//==============================================================================
+
+// FreeProb releases the fake problem.
+func (p *fakeProb) FreeProb() error {
+
+	return p.b.record(p.name, "FreeProb")
+}
+
+// ChgProbName changes the name of the problem.
+func (p *fakeProb) ChgProbName(name string) error {
+
+	if err := p.b.record(p.name, "ChgProbName", name); err != nil {
+		return err
+	}
+
+	p.name = name
+
+	return nil
+}
+
+// ChgObjSen changes the objective sense.
+func (p *fakeProb) ChgObjSen(sense int) error {
+
+	if err := p.b.record(p.name, "ChgObjSen", sense); err != nil {
+		return err
+	}
+
+	p.sense = sense
+
+	return nil
+}
+
+// NewRows appends the rows to the model.
+func (p *fakeProb) NewRows(rList []InputRow) error {
+
+	rows := append([]InputRow(nil), rList...)
+
+	if err := p.b.record(p.name, "NewRows", rows); err != nil {
+		return err
+	}
+
+	p.newRows(rows)
+	p.soln = nil
+
+	return nil
+}
+
+// NewCols appends the columns and their objective coefficients to the model.
+func (p *fakeProb) NewCols(objList []InputObjCoef, cList []InputCol) error {
+
+	objs := append([]InputObjCoef(nil), objList...)
+	cols := append([]InputCol(nil), cList...)
+
+	if err := p.b.record(p.name, "NewCols", objs, cols); err != nil {
+		return err
+	}
+
+	p.newCols(objs, cols)
+	p.soln = nil
+
+	return nil
+}
+
+// AddRows appends the rows and their coefficients to the model.
+func (p *fakeProb) AddRows(rList []InputRow, rMat InputMatrix) error {
+
+	rows := append([]InputRow(nil), rList...)
+	mat  := copyMatrix(rMat)
+
+	if err := p.b.record(p.name, "AddRows", rows, mat); err != nil {
+		return err
+	}
+
+	first := len(p.rows)
+	p.newRows(rows)
+	p.addRowElems(first, mat)
+	p.soln = nil
+
+	return nil
+}
+
+// AddCols appends the columns, their objective coefficients and their
+// coefficients to the model.
+func (p *fakeProb) AddCols(objList []InputObjCoef, cList []InputCol, cMat InputMatrix) error {
+
+	objs := append([]InputObjCoef(nil), objList...)
+	cols := append([]InputCol(nil), cList...)
+	mat  := copyMatrix(cMat)
+
+	if err := p.b.record(p.name, "AddCols", objs, cols, mat); err != nil {
+		return err
+	}
+
+	first := len(p.cols)
+	p.newCols(objs, cols)
+	p.addColElems(first, mat)
+	p.soln = nil
+
+	return nil
+}
+
+// copyMatrix returns a copy of the matrix, so that the recorded arguments are
+// not changed by the caller.
+func copyMatrix(mat InputMatrix) InputMatrix {
+
+	return InputMatrix{
+		Beg: append([]int(nil), mat.Beg...),
+		Ind: append([]int(nil), mat.Ind...),
+		Val: append([]float64(nil), mat.Val...),
+	}
+}
+
+// ChgCoefList sets the non-zero coefficients, replacing any existing value at
+// the same position. Like Cplex, it rejects indices outside the model.
+func (p *fakeProb) ChgCoefList(eList []InputElem) error {
+
+	elems := append([]InputElem(nil), eList...)
+
+	if err := p.b.record(p.name, "ChgCoefList", elems); err != nil {
+		return err
+	}
+
+	if err := p.chgCoefList(elems); err != nil {
+		return err
+	}
+	p.soln = nil
+
+	return nil
+}
+
+// ChgBds changes the bounds of the columns.
+func (p *fakeProb) ChgBds(bList []InputBound) error {
+
+	bounds := append([]InputBound(nil), bList...)
+
+	if err := p.b.record(p.name, "ChgBds", bounds); err != nil {
+		return err
+	}
+
+	p.chgBds(bounds)
+	p.soln = nil
+
+	return nil
+}
+
+// ChgRhs changes the right hand sides of the rows.
+func (p *fakeProb) ChgRhs(rList []InputRowValue) error {
+
+	rows := append([]InputRowValue(nil), rList...)
+
+	if err := p.b.record(p.name, "ChgRhs", rows); err != nil {
+		return err
+	}
+
+	p.chgRhs(rows)
+	p.soln = nil
+
+	return nil
+}
+
+// ChgSense changes the senses of the rows.
+func (p *fakeProb) ChgSense(sList []InputSense) error {
+
+	senses := append([]InputSense(nil), sList...)
+
+	if err := p.b.record(p.name, "ChgSense", senses); err != nil {
+		return err
+	}
+
+	p.chgSense(senses)
+	p.soln = nil
+
+	return nil
+}
+
+// ChgRngVal changes the range values of the rows.
+func (p *fakeProb) ChgRngVal(rList []InputRowValue) error {
+
+	rows := append([]InputRowValue(nil), rList...)
+
+	if err := p.b.record(p.name, "ChgRngVal", rows); err != nil {
+		return err
+	}
+
+	p.chgRngVal(rows)
+	p.soln = nil
+
+	return nil
+}
+
+// ChgObj changes the objective function coefficients.
+func (p *fakeProb) ChgObj(oList []InputColValue) error {
+
+	objs := append([]InputColValue(nil), oList...)
+
+	if err := p.b.record(p.name, "ChgObj", objs); err != nil {
+		return err
+	}
+
+	p.chgObj(objs)
+	p.soln = nil
+
+	return nil
+}
+
+// ChgCType changes the types of the columns.
+func (p *fakeProb) ChgCType(tList []InputCType) error {
+
+	types := append([]InputCType(nil), tList...)
+
+	if err := p.b.record(p.name, "ChgCType", types); err != nil {
+		return err
+	}
+
+	p.chgCType(types)
+	p.soln = nil
+
+	return nil
+}
+
+// ChgColName renames the columns. The solution is kept.
+func (p *fakeProb) ChgColName(nList []InputName) error {
+
+	names := append([]InputName(nil), nList...)
+
+	if err := p.b.record(p.name, "ChgColName", names); err != nil {
+		return err
+	}
+
+	p.chgColName(names)
+
+	return nil
+}
+
+// ChgRowName renames the rows. The solution is kept.
+func (p *fakeProb) ChgRowName(nList []InputName) error {
+
+	names := append([]InputName(nil), nList...)
+
+	if err := p.b.record(p.name, "ChgRowName", names); err != nil {
+		return err
+	}
+
+	p.chgRowName(names)
+
+	return nil
+}
+
+// DelRows deletes a range of rows.
+func (p *fakeProb) DelRows(begin, end int) error {
+
+	if err := p.b.record(p.name, "DelRows", begin, end); err != nil {
+		return err
+	}
+
+	p.delRows(rangeStat(len(p.rows), begin, end))
+
+	return nil
+}
+
+// DelCols deletes a range of columns.
+func (p *fakeProb) DelCols(begin, end int) error {
+
+	if err := p.b.record(p.name, "DelCols", begin, end); err != nil {
+		return err
+	}
+
+	p.delCols(rangeStat(len(p.cols), begin, end))
+
+	return nil
+}
+
+// DelSetRows deletes the rows set to 1 in delStat.
+func (p *fakeProb) DelSetRows(delStat []int) error {
+
+	if err := p.b.record(p.name, "DelSetRows", append([]int(nil), delStat...)); err != nil {
+		return err
+	}
+
+	p.delRows(delStat)
+
+	return nil
+}
+
+// DelSetCols deletes the columns set to 1 in delStat.
+func (p *fakeProb) DelSetCols(delStat []int) error {
+
+	if err := p.b.record(p.name, "DelSetCols", append([]int(nil), delStat...)); err != nil {
+		return err
+	}
+
+	p.delCols(delStat)
+
+	return nil
+}
+
+// delRows deletes the rows set to 1 in delStat from the model and the basis,
+// and discards the solution.
+func (p *fakeProb) delRows(delStat []int) {
+
+	p.delSetRows(delStat)
+	p.base = p.base.without(nil, delStat)
+	p.soln = nil
+}
+
+// delCols deletes the columns set to 1 in delStat from the model and the
+// basis, and discards the solution.
+func (p *fakeProb) delCols(delStat []int) {
+
+	p.delSetCols(delStat)
+	p.base = p.base.without(delStat, nil)
+	p.soln = nil
+}
+
+// CopyQuad sets the quadratic objective matrix.
+func (p *fakeProb) CopyQuad(qList []InputQuadCoef) error {
+
+	quad := append([]InputQuadCoef(nil), qList...)
+
+	if err := p.b.record(p.name, "CopyQuad", quad); err != nil {
+		return err
+	}
+
+	p.quad = append([]InputQuadCoef(nil), quad...)
+	p.soln = nil
+
+	return nil
+}
+
+// ChgQPCoef changes coefficients of the quadratic objective matrix.
+func (p *fakeProb) ChgQPCoef(qList []InputQuadCoef) error {
+
+	quad := append([]InputQuadCoef(nil), qList...)
+
+	if err := p.b.record(p.name, "ChgQPCoef", quad); err != nil {
+		return err
+	}
+
+	p.chgQPCoef(quad)
+	p.soln = nil
+
+	return nil
+}
+
+// Quad returns a copy of the coefficients of the quadratic objective matrix.
+func (p *fakeProb) Quad() ([]InputQuadCoef, error) {
+
+	return append([]InputQuadCoef(nil), p.quad...), nil
+}
+
+// AddQConstrs appends the quadratic constraints to the model.
+func (p *fakeProb) AddQConstrs(qList []InputQConstr) error {
+
+	if err := p.b.record(p.name, "AddQConstrs", qList); err != nil {
+		return err
+	}
+
+	p.qconstrs = append(p.qconstrs, qList...)
+	p.soln     = nil
+
+	return nil
+}
+
+// AddIndConstrs appends the indicator constraints to the model.
+func (p *fakeProb) AddIndConstrs(iList []InputIndicator) error {
+
+	if err := p.b.record(p.name, "AddIndConstrs", iList); err != nil {
+		return err
+	}
+
+	p.inds = append(p.inds, iList...)
+	p.soln = nil
+
+	return nil
+}
+
+// DelIndConstrs deletes a range of indicator constraints.
+func (p *fakeProb) DelIndConstrs(begin, end int) error {
+
+	if err := p.b.record(p.name, "DelIndConstrs", begin, end); err != nil {
+		return err
+	}
+
+	p.delIndConstrs(begin, end)
+	p.soln = nil
+
+	return nil
+}
+
+// AddSOS appends the special ordered sets to the model.
+func (p *fakeProb) AddSOS(sList []InputSOS) error {
+
+	if err := p.b.record(p.name, "AddSOS", sList); err != nil {
+		return err
+	}
+
+	p.sos  = append(p.sos, sList...)
+	p.soln = nil
+
+	return nil
+}
+
+// AddPwl appends the piecewise linear constraints to the model.
+func (p *fakeProb) AddPwl(pList []InputPwl) error {
+
+	if err := p.b.record(p.name, "AddPwl", pList); err != nil {
+		return err
+	}
+
+	p.pwl  = append(p.pwl, pList...)
+	p.soln = nil
+
+	return nil
+}
+
+// DelPwl deletes a range of piecewise linear constraints.
+func (p *fakeProb) DelPwl(begin, end int) error {
+
+	if err := p.b.record(p.name, "DelPwl", begin, end); err != nil {
+		return err
+	}
+
+	p.delPwl(begin, end)
+	p.soln = nil
+
+	return nil
+}
+
+// AddMIPStarts appends the MIP starts to the model.
+func (p *fakeProb) AddMIPStarts(mList []InputMIPStart) error {
+
+	if err := p.b.record(p.name, "AddMIPStarts", mList); err != nil {
+		return err
+	}
+
+	p.starts = append(p.starts, mList...)
+
+	return nil
+}
+
+// ChgMIPStarts changes the efforts and values of MIP starts.
+func (p *fakeProb) ChgMIPStarts(indices []int, mList []InputMIPStart) error {
+
+	if err := p.b.record(p.name, "ChgMIPStarts", indices, mList); err != nil {
+		return err
+	}
+
+	p.chgMIPStarts(indices, mList)
+
+	return nil
+}
+
+// AddPoolFilters appends the solution pool filters to the model.
+func (p *fakeProb) AddPoolFilters(fList []InputPoolFilter) error {
+
+	if err := p.b.record(p.name, "AddPoolFilters", fList); err != nil {
+		return err
+	}
+
+	p.filters = append(p.filters, fList...)
+
+	return nil
+}
+
+// DelPoolFilters deletes a range of solution pool filters.
+func (p *fakeProb) DelPoolFilters(begin, end int) error {
+
+	if err := p.b.record(p.name, "DelPoolFilters", begin, end); err != nil {
+		return err
+	}
+
+	p.delPoolFilters(begin, end)
+
+	return nil
+}
+
+// NumPoolFilters returns the number of solution pool filters in the model.
+func (p *fakeProb) NumPoolFilters() int {
+
+	return len(p.filters)
+}
+
+// DelSOS deletes a range of special ordered sets.
+func (p *fakeProb) DelSOS(begin, end int) error {
+
+	if err := p.b.record(p.name, "DelSOS", begin, end); err != nil {
+		return err
+	}
+
+	p.delSOS(begin, end)
+	p.soln = nil
+
+	return nil
+}
+
+// LpOpt installs the next scripted solution. Like CPXlpopt, it rejects models
+// containing columns which are not continuous. The solution is reported as
+// found by the method set by ParamLpMethod, or by the dual simplex method, which
+// Cplex selects by default, if the method is AlgAuto or AlgConcurrent.
+func (p *fakeProb) LpOpt(ctx context.Context) error {
+
+	method := AlgDual
+	if value, _ := p.env.GetIntParam(int(ParamLpMethod)); value != int(AlgAuto) && value != int(AlgConcurrent) {
+		method = Algorithm(value)
+	}
+
+	return p.lpOpt(ctx, "LpOpt", method)
+}
+
+// PrimOpt installs the next scripted solution, as LpOpt does.
+func (p *fakeProb) PrimOpt(ctx context.Context) error {
+
+	return p.lpOpt(ctx, "PrimOpt", AlgPrimal)
+}
+
+// DualOpt installs the next scripted solution, as LpOpt does.
+func (p *fakeProb) DualOpt(ctx context.Context) error {
+
+	return p.lpOpt(ctx, "DualOpt", AlgDual)
+}
+
+// BarOpt installs the next scripted solution, as LpOpt does, and records the
+// crossover.
+func (p *fakeProb) BarOpt(ctx context.Context, crossover Algorithm) error {
+
+	return p.lpOpt(ctx, "BarOpt", AlgBarrier, crossover)
+}
+
+// HybNetOpt installs the next scripted solution, as LpOpt does, and records the
+// simplex method.
+func (p *fakeProb) HybNetOpt(ctx context.Context, method Algorithm) error {
+
+	return p.lpOpt(ctx, "HybNetOpt", method, method)
+}
+
+// QPOpt installs the next scripted solution, as LpOpt does. The solution is
+// reported as found by the method set by ParamQpMethod, or by the barrier
+// method if the method is AlgAuto.
+func (p *fakeProb) QPOpt(ctx context.Context) error {
+
+	method := AlgBarrier
+	if value, _ := p.env.GetIntParam(int(ParamQpMethod)); value != int(AlgAuto) {
+		method = Algorithm(value)
+	}
+
+	return p.lpOpt(ctx, "QPOpt", method)
+}
+
+// lpOpt records the call to an LP optimizer and installs the next scripted
+// solution, reported as found by the method passed into this function.
+func (p *fakeProb) lpOpt(ctx context.Context, op string, method Algorithm, args ...interface{}) error {
+
+	if err := p.b.record(p.name, op, args...); err != nil {
+		return err
+	}
+
+	if p.isMip() {
+		return errors.Wrapf(ErrNotForMIP, "%s cannot solve a problem with integer columns", op)
+	}
+
+	p.method   = method
+	p.nonbasic = op == "BarOpt" && args[0] == AlgNone
+
+	return p.solve(ctx, false, StatOptimal)
+}
+
+// MipOpt installs the next scripted solution.
+func (p *fakeProb) MipOpt(ctx context.Context) error {
+
+	if err := p.b.record(p.name, "MipOpt"); err != nil {
+		return err
+	}
+
+	return p.solve(ctx, true, MipOptimal)
+}
+
+// Populate installs the next scripted solution, as MipOpt does.
+func (p *fakeProb) Populate(ctx context.Context) error {
+
+	if err := p.b.record(p.name, "Populate"); err != nil {
+		return err
+	}
+
+	return p.solve(ctx, true, MipOptimalPopulated)
+}
+
+// solve takes the next scripted solution and pads it to the size of the model.
+// It waits for the delay of the solution, and reports an aborted status if ctx
+// is done before the delay has passed. The status optimal is reported if the
+// solution sets none.
+func (p *fakeProb) solve(ctx context.Context, mip bool, optimal SolutionStatus) error {
+
+	s := p.b.nextSolution()
+	p.soln = nil
+	p.mip  = mip
+
+	if p.env.logFn != nil {
+		for _, msg := range s.Log {
+			p.env.logFn(LogResults, msg)
+		}
+	}
+
+	if p.env.progressFn != nil {
+		for _, pr := range s.Progress {
+			p.env.progressFn(pr)
+		}
+	}
+
+	if s.Delay > 0 {
+		timer := time.NewTimer(s.Delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			switch {
+			case !mip:
+				s.Status = StatAbortUser
+			case len(s.X) > 0:
+				s.Status = MipAbortFeas
+			default:
+				s.Status = MipAbortInfeas
+			}
+			s.Err = nil
+		}
+	}
+
+	if s.Err != nil {
+		return s.Err
+	}
+
+	if s.Status == StatNone {
+		s.Status = optimal
+	}
+
+	s.X        = fakePad(s.X, len(p.cols))
+	s.RedCost  = fakePad(s.RedCost, len(p.cols))
+	s.Pi       = fakePad(s.Pi, len(p.rows))
+	s.Slack    = fakePad(s.Slack, len(p.rows))
+	s.ObjLower = fakePad(s.ObjLower, len(p.cols))
+	s.ObjUpper = fakePad(s.ObjUpper, len(p.cols))
+	s.RhsLower = fakePad(s.RhsLower, len(p.rows))
+	s.RhsUpper = fakePad(s.RhsUpper, len(p.rows))
+	s.LbLower  = fakePad(s.LbLower, len(p.cols))
+	s.LbUpper  = fakePad(s.LbUpper, len(p.cols))
+	s.UbLower  = fakePad(s.UbLower, len(p.cols))
+	s.UbUpper  = fakePad(s.UbUpper, len(p.cols))
+	s.QSlack   = fakePad(s.QSlack, len(p.qconstrs))
+	s.Pool     = append([]FakeSolution(nil), s.Pool...)
+	for i := range s.Pool {
+		s.Pool[i].X     = fakePad(s.Pool[i].X, len(p.cols))
+		s.Pool[i].Slack = fakePad(s.Pool[i].Slack, len(p.rows))
+	}
+	p.soln     = &s
+
+	if !mip {
+		p.base = &lpBasis{cols: make([]BasisStatus, len(p.cols)), rows: make([]BasisStatus, len(p.rows))}
+		for i := range p.base.rows {
+			p.base.rows[i] = BasisBasic
+		}
+		copy(p.base.cols, s.ColBasis)
+		copy(p.base.rows, s.RowBasis)
+	}
+
+	return nil
+}
+
+// fakePad returns a copy of the values with exactly n entries.
+func fakePad(values []float64, n int) []float64 {
+
+	padded := make([]float64, n)
+	copy(padded, values)
+
+	return padded
+}
+
+// NumRows returns the number of rows in the model.
+func (p *fakeProb) NumRows() int {
+
+	return len(p.rows)
+}
+
+// NumCols returns the number of columns in the model.
+func (p *fakeProb) NumCols() int {
+
+	return len(p.cols)
+}
+
+// NumQConstrs returns the number of quadratic constraints in the model.
+func (p *fakeProb) NumQConstrs() int {
+
+	return len(p.qconstrs)
+}
+
+// NumIndConstrs returns the number of indicator constraints in the model.
+func (p *fakeProb) NumIndConstrs() int {
+
+	return len(p.inds)
+}
+
+// IndConstrs copies the indicator constraints of the model.
+func (p *fakeProb) IndConstrs(iList []InputIndicator) error {
+
+	copy(iList, copyIndicators(p.inds))
+
+	return nil
+}
+
+// NumSOS returns the number of special ordered sets in the model.
+func (p *fakeProb) NumSOS() int {
+
+	return len(p.sos)
+}
+
+// SOS copies the special ordered sets of the model.
+func (p *fakeProb) SOS(sList []InputSOS) error {
+
+	copy(sList, copySOS(p.sos))
+
+	return nil
+}
+
+// NumPwl returns the number of piecewise linear constraints in the model.
+func (p *fakeProb) NumPwl() int {
+
+	return len(p.pwl)
+}
+
+// Pwl copies the piecewise linear constraints of the model.
+func (p *fakeProb) Pwl(pList []InputPwl) error {
+
+	copy(pList, copyPwl(p.pwl))
+
+	return nil
+}
+
+// NumMIPStarts returns the number of MIP starts in the model.
+func (p *fakeProb) NumMIPStarts() int {
+
+	return len(p.starts)
+}
+
+// MIPStarts copies the MIP starts of the model.
+func (p *fakeProb) MIPStarts(mList []InputMIPStart) error {
+
+	copy(mList, copyMIPStarts(p.starts))
+
+	return nil
+}
+
+// ObjSen returns the objective sense.
+func (p *fakeProb) ObjSen() (int, error) {
+
+	return p.sense, nil
+}
+
+// Rows copies the rows of the model.
+func (p *fakeProb) Rows(rList []InputRow) error {
+
+	copy(rList, p.rows)
+
+	return nil
+}
+
+// Cols copies the columns of the model.
+func (p *fakeProb) Cols(cList []InputCol) error {
+
+	copy(cList, p.cols)
+
+	return nil
+}
+
+// Obj copies the objective function coefficients.
+func (p *fakeProb) Obj(obj []float64) error {
+
+	copy(obj, p.obj)
+
+	return nil
+}
+
+// Coefs returns a copy of the non-zero coefficients.
+func (p *fakeProb) Coefs() ([]InputElem, error) {
+
+	return append([]InputElem(nil), p.elems...), nil
+}
+
+// checkSoln returns an error if the problem has not been solved.
+func (p *fakeProb) checkSoln() error {
+
+	if p.soln == nil {
+		return ErrNoSolution
+	}
+
+	return nil
+}
+
+// ObjVal returns the scripted objective value.
+func (p *fakeProb) ObjVal() (float64, error) {
+
+	if err := p.b.record(p.name, "ObjVal"); err != nil {
+		return 0, err
+	}
+
+	if err := p.checkSoln(); err != nil {
+		return 0, err
+	}
+
+	return p.soln.ObjVal, nil
+}
+
+// Solution copies the scripted solution into the slices.
+func (p *fakeProb) Solution(x, dj, pi, slack []float64) (float64, error) {
+
+	if err := p.b.record(p.name, "Solution"); err != nil {
+		return 0, err
+	}
+
+	if err := p.checkSoln(); err != nil {
+		return 0, err
+	}
+
+	copy(x, p.soln.X)
+	copy(dj, p.soln.RedCost)
+	copy(pi, p.soln.Pi)
+	copy(slack, p.soln.Slack)
+
+	return p.soln.ObjVal, nil
+}
+
+// X copies the scripted column values into the slice.
+func (p *fakeProb) X(x []float64) error {
+
+	if err := p.b.record(p.name, "X"); err != nil {
+		return err
+	}
+
+	if err := p.checkSoln(); err != nil {
+		return err
+	}
+
+	copy(x, p.soln.X)
+
+	return nil
+}
+
+// QConstrSlack copies the scripted quadratic constraint slacks into the slice.
+func (p *fakeProb) QConstrSlack(slack []float64) error {
+
+	if err := p.b.record(p.name, "QConstrSlack"); err != nil {
+		return err
+	}
+
+	if err := p.checkSoln(); err != nil {
+		return err
+	}
+
+	copy(slack, p.soln.QSlack)
+
+	return nil
+}
+
+// QConstrDSlack returns a copy of the scripted dual slack vector of a quadratic
+// constraint, empty if none was scripted.
+func (p *fakeProb) QConstrDSlack(index int) ([]InputLinCoef, error) {
+
+	if err := p.b.record(p.name, "QConstrDSlack", index); err != nil {
+		return nil, err
+	}
+
+	if err := p.checkSoln(); err != nil {
+		return nil, err
+	}
+
+	if index >= len(p.soln.QDualSlack) {
+		return nil, nil
+	}
+
+	return append([]InputLinCoef(nil), p.soln.QDualSlack[index]...), nil
+}
+
+// Slack copies the scripted row slacks into the slice.
+func (p *fakeProb) Slack(slack []float64) error {
+
+	if err := p.b.record(p.name, "Slack"); err != nil {
+		return err
+	}
+
+	if err := p.checkSoln(); err != nil {
+		return err
+	}
+
+	copy(slack, p.soln.Slack)
+
+	return nil
+}
+
+// PoolNumSolns returns the number of scripted pool solutions, 0 if the problem
+// has not been solved.
+func (p *fakeProb) PoolNumSolns() int {
+
+	if p.soln == nil {
+		return 0
+	}
+
+	return len(p.soln.Pool)
+}
+
+// PoolSolution copies a scripted pool solution into the slices, and returns
+// its objective value.
+func (p *fakeProb) PoolSolution(i int, x, slack []float64) (float64, error) {
+
+	if err := p.b.record(p.name, "PoolSolution", i); err != nil {
+		return 0, err
+	}
+
+	if i < 0 || i >= p.PoolNumSolns() {
+		return 0, errors.Wrapf(ErrNoSolution, "Pool has no solution %d", i)
+	}
+
+	copy(x, p.soln.Pool[i].X)
+	copy(slack, p.soln.Pool[i].Slack)
+
+	return p.soln.Pool[i].ObjVal, nil
+}
+
+// Status returns the scripted status, StatNone if the problem has not been
+// solved.
+func (p *fakeProb) Status() (SolutionStatus, error) {
+
+	if err := p.b.record(p.name, "Status"); err != nil {
+		return StatNone, err
+	}
+
+	if p.soln == nil {
+		return StatNone, nil
+	}
+
+	return p.soln.Status, nil
+}
+
+// SolnInfo describes the scripted solution. LP optimizers are reported as
+// using the method given by the call (see LpOpt), with a basic solution unless
+// BarOpt was called without crossover, and MipOpt as giving no solution unless
+// the status implies an integer feasible one.
+func (p *fakeProb) SolnInfo() (SolnInfo, error) {
+
+	if err := p.b.record(p.name, "SolnInfo"); err != nil {
+		return SolnInfo{Method: AlgNone, Type: SolnNone}, err
+	}
+
+	if p.soln == nil || (p.mip && !p.soln.Status.primalFeasible()) {
+		return SolnInfo{Method: AlgNone, Type: SolnNone}, nil
+	}
+
+	if p.mip {
+		return SolnInfo{Method: AlgMip, Type: SolnPrimal, PrimalFeasible: true}, nil
+	}
+
+	soln := SolnBasic
+	if p.nonbasic {
+		soln = SolnNonbasic
+	}
+
+	return SolnInfo{Method: p.method, Type: soln,
+		PrimalFeasible: p.soln.Status.primalFeasible(),
+		DualFeasible:   p.soln.Status == StatOptimal}, nil
+}
+
+// BestObjVal returns the scripted best bound.
+func (p *fakeProb) BestObjVal() (float64, error) {
+
+	if err := p.b.record(p.name, "BestObjVal"); err != nil {
+		return 0, err
+	}
+
+	if err := p.checkSoln(); err != nil {
+		return 0, err
+	}
+
+	return p.soln.BestObjVal, nil
+}
+
+// MipRelGap returns the relative gap between the scripted objective value and
+// best bound, computed as Cplex does.
+func (p *fakeProb) MipRelGap() (float64, error) {
+
+	if err := p.b.record(p.name, "MipRelGap"); err != nil {
+		return 0, err
+	}
+
+	if err := p.checkSoln(); err != nil {
+		return 0, err
+	}
+
+	return mipRelGap(p.soln.ObjVal, p.soln.BestObjVal), nil
+}
+
+// checkBasis returns an error if the problem has not been solved by LpOpt.
+func (p *fakeProb) checkBasis() error {
+
+	if err := p.checkSoln(); err != nil {
+		return err
+	}
+
+	if p.mip {
+		return errors.Wrap(ErrNotForMIP, "Sensitivity analysis is not available")
+	}
+
+	return nil
+}
+
+// ObjSA copies the scripted objective ranges into the slices.
+func (p *fakeProb) ObjSA(lower, upper []float64) error {
+
+	if err := p.b.record(p.name, "ObjSA"); err != nil {
+		return err
+	}
+
+	if err := p.checkBasis(); err != nil {
+		return err
+	}
+
+	copy(lower, p.soln.ObjLower)
+	copy(upper, p.soln.ObjUpper)
+
+	return nil
+}
+
+// RhsSA copies the scripted right hand side ranges into the slices.
+func (p *fakeProb) RhsSA(lower, upper []float64) error {
+
+	if err := p.b.record(p.name, "RhsSA"); err != nil {
+		return err
+	}
+
+	if err := p.checkBasis(); err != nil {
+		return err
+	}
+
+	copy(lower, p.soln.RhsLower)
+	copy(upper, p.soln.RhsUpper)
+
+	return nil
+}
+
+// BoundSA copies the scripted bound ranges into the slices.
+func (p *fakeProb) BoundSA(lbLo, lbUp, ubLo, ubUp []float64) error {
+
+	if err := p.b.record(p.name, "BoundSA"); err != nil {
+		return err
+	}
+
+	if err := p.checkBasis(); err != nil {
+		return err
+	}
+
+	copy(lbLo, p.soln.LbLower)
+	copy(lbUp, p.soln.LbUpper)
+	copy(ubLo, p.soln.UbLower)
+	copy(ubUp, p.soln.UbUpper)
+
+	return nil
+}
+
+// Base copies the current basis into the slices.
+func (p *fakeProb) Base(cStat, rStat []BasisStatus) error {
+
+	if err := p.b.record(p.name, "Base"); err != nil {
+		return err
+	}
+
+	if p.base == nil {
+		return ErrNoBasis
+	}
+
+	// Rows appended since the basis was set are basic.
+	for i := range rStat {
+		rStat[i] = BasisBasic
+	}
+	copy(cStat, p.base.cols)
+	copy(rStat, p.base.rows)
+
+	return nil
+}
+
+// CopyBase records the call and replaces the current basis.
+func (p *fakeProb) CopyBase(cStat, rStat []BasisStatus) error {
+
+	cols := append([]BasisStatus(nil), cStat...)
+	rows := append([]BasisStatus(nil), rStat...)
+
+	if err := p.b.record(p.name, "CopyBase", cols, rows); err != nil {
+		return err
+	}
+
+	p.base = &lpBasis{cols: cols, rows: rows}
+
+	return nil
+}
+
+// ReadBasis records the call.
+func (p *fakeProb) ReadBasis(fileName string) error {
+
+	return p.b.record(p.name, "ReadBasis", fileName)
+}
+
+// WriteBasis records the call, and fails if the problem has no basis.
+func (p *fakeProb) WriteBasis(fileName string) error {
+
+	if err := p.b.record(p.name, "WriteBasis", fileName); err != nil {
+		return err
+	}
+
+	if p.base == nil {
+		return ErrNoBasis
+	}
+
+	return nil
+}
+
+// ReadMIPStarts records the call.
+func (p *fakeProb) ReadMIPStarts(fileName string) error {
+
+	return p.b.record(p.name, "ReadMIPStarts", fileName)
+}
+
+// WriteMIPStarts records the call.
+func (p *fakeProb) WriteMIPStarts(fileName string) error {
+
+	return p.b.record(p.name, "WriteMIPStarts", fileName)
+}
+
+// ColNames returns the names of the columns.
+func (p *fakeProb) ColNames() ([]string, error) {
+
+	return p.colNames(), nil
+}
+
+// RowNames returns the names of the rows.
+func (p *fakeProb) RowNames() ([]string, error) {
+
+	return p.rowNames(), nil
+}
+
+// QConstrNames returns the names of the quadratic constraints.
+func (p *fakeProb) QConstrNames() ([]string, error) {
+
+	names := make([]string, len(p.qconstrs))
+	for i := range p.qconstrs {
+		names[i] = p.qconstrs[i].Name
+	}
+
+	return names, nil
+}
+
+// IndConstrNames returns the names of the indicator constraints.
+func (p *fakeProb) IndConstrNames() ([]string, error) {
+
+	names := make([]string, len(p.inds))
+	for i := range p.inds {
+		names[i] = p.inds[i].Name
+	}
+
+	return names, nil
+}
+
+// SOSNames returns the names of the special ordered sets.
+func (p *fakeProb) SOSNames() ([]string, error) {
+
+	names := make([]string, len(p.sos))
+	for i := range p.sos {
+		names[i] = p.sos[i].Name
+	}
+
+	return names, nil
+}
+
+// PwlNames returns the names of the piecewise linear constraints.
+func (p *fakeProb) PwlNames() ([]string, error) {
+
+	names := make([]string, len(p.pwl))
+	for i := range p.pwl {
+		names[i] = p.pwl[i].Name
+	}
+
+	return names, nil
+}
+
+// MIPStartNames returns the names of the MIP starts.
+func (p *fakeProb) MIPStartNames() ([]string, error) {
+
+	names := make([]string, len(p.starts))
+	for i := range p.starts {
+		names[i] = p.starts[i].Name
+	}
+
+	return names, nil
+}
+
+// ReadCopyProb records the call.
+func (p *fakeProb) ReadCopyProb(fileName string, fileType string) error {
+
+	return p.b.record(p.name, "ReadCopyProb", fileName, fileType)
+}
+
+// WriteProb records the call.
+func (p *fakeProb) WriteProb(fileName string, fileType string) error {
+
+	return p.b.record(p.name, "WriteProb", fileName, fileType)
+}
+
+// SolWrite records the call, and fails if the problem has not been solved.
+func (p *fakeProb) SolWrite(fileName string) error {
+
+	if err := p.b.record(p.name, "SolWrite", fileName); err != nil {
+		return err
+	}
+
+	return p.checkSoln()
+}
+
+//============================ END OF FILE =====================================
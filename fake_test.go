@@ -0,0 +1,297 @@
+package gpx
+
+import (
+	"github.com/pkg/errors"
+	"reflect"
+	"testing"
+)
+
+// testModel is the data of a small problem built by newTestProb.
+type testModel struct {
+	name   string           // Name of the problem
+	rows   []InputRow       // Rows, created first
+	obj    []InputObjCoef   // Objective coefficients of the columns
+	cols   []InputCol       // Columns
+	elems  []InputElem      // Non-zero coefficients
+	sense  int              // Objective sense, 0 to keep the default
+}
+
+// newTestProb opens an environment on the backend passed to the function and
+// creates the problem of the model in it. Empty parts of the model are
+// skipped.
+func newTestProb(t *testing.T, backend Backend, m testModel) (*Env, *Problem) {
+
+	t.Helper()
+
+	env, err := OpenEnv(backend)
+	if err != nil {
+		t.Fatalf("OpenEnv failed: %v", err)
+	}
+
+	prob, err := env.CreateProb(m.name)
+	if err != nil {
+		t.Fatalf("CreateProb failed: %v", err)
+	}
+
+	if len(m.rows) > 0 {
+		if err = prob.NewRows(m.rows); err != nil {
+			t.Fatalf("NewRows failed: %v", err)
+		}
+	}
+
+	if len(m.cols) > 0 {
+		if err = prob.NewCols(m.obj, m.cols); err != nil {
+			t.Fatalf("NewCols failed: %v", err)
+		}
+	}
+
+	if len(m.elems) > 0 {
+		if err = prob.ChgCoefList(m.elems); err != nil {
+			t.Fatalf("ChgCoefList failed: %v", err)
+		}
+	}
+
+	if m.sense != 0 {
+		if err = prob.ChgObjSen(m.sense); err != nil {
+			t.Fatalf("ChgObjSen failed: %v", err)
+		}
+	}
+
+	return env, prob
+}
+
+//==============================================================================
+
+// fakeModel is the problem
+//   max x + 2y  st  x + y <= 4,  0 <= x, y <= 10
+// with columns of the type passed to the function.
+func fakeModel(colType string) testModel {
+
+	return testModel{
+		name:  "fake",
+		rows:  []InputRow{{"r1", "L", 4, 0}},
+		obj:   []InputObjCoef{{0, 1}, {1, 2}},
+		cols:  []InputCol{{"x", colType, 0, 10}, {"y", colType, 0, 10}},
+		elems: []InputElem{{0, 0, 1}, {0, 1, 1}},
+		sense: -1,
+	}
+}
+
+//==============================================================================
+
+func TestFakeCalls(t *testing.T) {
+
+	fake := NewFakeBackend()
+	_, prob := newTestProb(t, fake, fakeModel("C"))
+
+	var objVal float64
+	var sRows []SolnRow
+	var sCols []SolnCol
+
+	if err := prob.LpOpt(); err != nil {
+		t.Fatalf("LpOpt failed: %v", err)
+	}
+	if err := prob.GetSolution(&objVal, &sRows, &sCols); err != nil {
+		t.Fatalf("GetSolution failed: %v", err)
+	}
+	if err := prob.FreeProb(); err != nil {
+		t.Fatalf("FreeProb failed: %v", err)
+	}
+
+	want := []FakeCall{
+		{"", "OpenEnv", nil},
+		{"fake", "CreateProb", []interface{}{"fake"}},
+		{"fake", "NewRows", []interface{}{[]InputRow{{"r1", "L", 4, 0}}}},
+		{"fake", "NewCols", []interface{}{[]InputObjCoef{{0, 1}, {1, 2}},
+			[]InputCol{{"x", "C", 0, 10}, {"y", "C", 0, 10}}}},
+		{"fake", "ChgCoefList", []interface{}{[]InputElem{{0, 0, 1}, {0, 1, 1}}}},
+		{"fake", "ChgObjSen", []interface{}{-1}},
+		{"fake", "LpOpt", nil},
+		{"fake", "SolnInfo", nil},
+		{"fake", "Solution", nil},
+		{"fake", "FreeProb", nil},
+	}
+
+	if got := fake.Calls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Calls() = %+v, want %+v", got, want)
+	}
+}
+
+//==============================================================================
+
+func TestFakeSolutions(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		colType  string
+		mip      bool
+		soln     FakeSolution
+		status   SolutionStatus
+		objVal   float64
+		x        []float64
+		pi       []float64
+	}{
+		{"lp default status", "C", false,
+			FakeSolution{ObjVal: 8, X: []float64{0, 4}, Pi: []float64{2}},
+			StatOptimal, 8, []float64{0, 4}, []float64{2}},
+		{"lp padded", "C", false,
+			FakeSolution{ObjVal: 1, X: []float64{1}},
+			StatOptimal, 1, []float64{1, 0}, []float64{0}},
+		{"lp scripted status", "C", false,
+			FakeSolution{Status: StatFeasible, ObjVal: 5, X: []float64{1, 2}},
+			StatFeasible, 5, []float64{1, 2}, []float64{0}},
+		{"mip default status", "I", true,
+			FakeSolution{ObjVal: 8, X: []float64{0, 4}},
+			MipOptimal, 8, []float64{0, 4}, nil},
+		{"mip scripted status", "I", true,
+			FakeSolution{Status: MipNodeLimFeas, ObjVal: 6, X: []float64{2, 2}},
+			MipNodeLimFeas, 6, []float64{2, 2}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			fake := NewFakeBackend()
+			fake.Solutions = []FakeSolution{tt.soln}
+			_, prob := newTestProb(t, fake, fakeModel(tt.colType))
+
+			var objVal float64
+			var sRows []SolnRow
+			var sCols []SolnCol
+			var err error
+
+			if tt.mip {
+				if err = prob.MipOpt(); err == nil {
+					err = prob.GetMipSolution(&objVal, &sRows, &sCols)
+				}
+			} else {
+				if err = prob.LpOpt(); err == nil {
+					err = prob.GetSolution(&objVal, &sRows, &sCols)
+				}
+			}
+			if err != nil {
+				t.Fatalf("solve failed: %v", err)
+			}
+
+			var status SolutionStatus
+			if err = prob.GetStatus(&status); err != nil || status != tt.status {
+				t.Errorf("GetStatus = %s, %v, want %s", status, err, tt.status)
+			}
+
+			if objVal != tt.objVal {
+				t.Errorf("objVal = %g, want %g", objVal, tt.objVal)
+			}
+
+			for j, c := range sCols {
+				if c.Value != tt.x[j] || c.Name != []string{"x", "y"}[j] {
+					t.Errorf("column %d = %+v, want %s = %g", j, c, []string{"x", "y"}[j], tt.x[j])
+				}
+			}
+
+			for i, r := range sRows {
+				if r.Name != "r1" || (tt.pi != nil && r.Pi != tt.pi[i]) {
+					t.Errorf("row %d = %+v, want r1 with pi %v", i, r, tt.pi)
+				}
+			}
+		})
+	}
+}
+
+//==============================================================================
+
+func TestFakeSolutionsInOrder(t *testing.T) {
+
+	fake := NewFakeBackend()
+	fake.Solutions = []FakeSolution{{ObjVal: 1}, {ObjVal: 2}}
+	_, prob := newTestProb(t, fake, fakeModel("C"))
+
+	for _, want := range []float64{1, 2, 0} {
+		var objVal float64
+		if err := prob.LpOpt(); err != nil {
+			t.Fatalf("LpOpt failed: %v", err)
+		}
+		if err := prob.GetObjVal(&objVal); err != nil || objVal != want {
+			t.Errorf("GetObjVal = %g, %v, want %g", objVal, err, want)
+		}
+	}
+}
+
+//==============================================================================
+
+func TestFakeErrors(t *testing.T) {
+
+	cplexErr := &CplexError{Op: "LpOpt", Code: 1001}
+
+	tests := []struct {
+		name     string
+		op       string               // Operation of the injected error, empty for FakeSolution.Err
+		solnErr  error                // Error of the scripted solution
+		status   SolutionStatus       // Status of the scripted solution
+		colType  string
+		call     func(p *Problem) error
+		want     error
+	}{
+		{"LpOpt", "LpOpt", nil, StatNone, "C", lpOptThenSolution, cplexErr},
+		{"MipOpt", "MipOpt", nil, StatNone, "I", mipOptThenSolution, cplexErr},
+		{"GetSolution", "Solution", nil, StatNone, "C", lpOptThenSolution, cplexErr},
+		{"GetMipSolution", "ObjVal", nil, StatNone, "I", mipOptThenSolution, cplexErr},
+		{"LpOpt scripted", "", cplexErr, StatNone, "C", lpOptThenSolution, cplexErr},
+		{"MipOpt scripted", "", cplexErr, StatNone, "I", mipOptThenSolution, cplexErr},
+		{"LpOpt on MIP", "", nil, StatNone, "I", lpOptThenSolution, ErrNotForMIP},
+		{"GetSolution infeasible", "", nil, StatAbortItLim, "C", lpOptThenSolution, ErrNoSolution},
+		{"GetMipSolution infeasible", "", nil, MipInfeasible, "I", mipOptThenSolution, ErrNoSolution},
+		{"GetSolution unsolved", "", nil, StatNone, "C",
+			func(p *Problem) error {
+				var objVal float64
+				var sRows []SolnRow
+				var sCols []SolnCol
+				return p.GetSolution(&objVal, &sRows, &sCols)
+			}, ErrNoSolution},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			fake := NewFakeBackend()
+			fake.Solutions = []FakeSolution{{ObjVal: 8, X: []float64{0, 4}, Err: tt.solnErr, Status: tt.status}}
+			if tt.op != "" {
+				fake.Errors[tt.op] = cplexErr
+			}
+			_, prob := newTestProb(t, fake, fakeModel(tt.colType))
+
+			if err := tt.call(prob); !errors.Is(err, tt.want) {
+				t.Errorf("error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+// lpOptThenSolution solves the problem with LpOpt and gets the solution.
+func lpOptThenSolution(p *Problem) error {
+
+	var objVal float64
+	var sRows []SolnRow
+	var sCols []SolnCol
+
+	if err := p.LpOpt(); err != nil {
+		return err
+	}
+
+	return p.GetSolution(&objVal, &sRows, &sCols)
+}
+
+// mipOptThenSolution solves the problem with MipOpt and gets the solution.
+func mipOptThenSolution(p *Problem) error {
+
+	var objVal float64
+	var sRows []SolnRow
+	var sCols []SolnCol
+
+	if err := p.MipOpt(); err != nil {
+		return err
+	}
+
+	return p.GetMipSolution(&objVal, &sRows, &sCols)
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,1264 @@
+// 01   Oct. 16, 2026   Initial version, backend using the Go simplex solver
+// 02   Oct. 16, 2026   Added MipOpt using the Go branch and bound solver
+// 03   Oct. 16, 2026   Added Status and SolnInfo
+// 04   Oct. 16, 2026   Return sentinel errors
+// 05   Oct. 16, 2026   Added parameter functions, time limit
+// 06   Oct. 16, 2026   LpOpt and MipOpt can be aborted through a context
+// 07   Oct. 16, 2026   Added SetLogFunc, solve summaries sent to the results channel
+// 08   Oct. 16, 2026   Added SetProgressFunc
+// 09   Oct. 16, 2026   Added ObjSA, RhsSA and BoundSA
+// 10   Oct. 16, 2026   LpOpt starts from the previous or copied basis
+// 11   Oct. 16, 2026   Added PrimOpt, DualOpt, BarOpt and HybNetOpt
+// 12   Oct. 16, 2026   Added ChgBds, ChgRhs, ChgSense, ChgRngVal, ChgObj, ChgCType and renaming
+// 13   Oct. 16, 2026   Added DelRows, DelCols, DelSetRows and DelSetCols
+// 14   Oct. 16, 2026   Added ObjSen, Rows, Cols, Obj and Coefs
+// 15   Oct. 16, 2026   Added AddRows and AddCols
+// 16   Oct. 16, 2026   Quadratic objectives are stored but cannot be solved
+// 17   Oct. 16, 2026   Quadratic constraints are stored but cannot be solved
+// 18   Oct. 16, 2026   Indicator constraints are stored but cannot be solved
+// 19   Oct. 16, 2026   Special ordered sets are stored but cannot be solved
+// 20   Oct. 16, 2026   Piecewise linear constraints are stored but cannot be solved
+// 21   Oct. 16, 2026   Added MIP starts, used by MipOpt, and MST files
+// 22   Oct. 16, 2026   Added Populate and the solution pool
+// 23   Oct. 16, 2026   MipOpt solves a problem without integer columns with LpOpt
+// 24   Oct. 16, 2026   Added DelIndConstrs and DelPwl
+
+package gpx
+
+import (
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"math"
+)
+
+// GoBackend is a Backend written entirely in Go, which needs neither Cplex nor a
+// C compiler. LPs are solved with the bounded-variable simplex method used by
+// SolveLP, and MIPs (columns of type "B" or "I") by LP-based branch and bound.
+// It is intended as a licence-free fallback for small models and as a
+// reference for cross-checking Cplex results, not as a replacement for Cplex on
+// large models. Reading and writing problem files is not supported, but basis
+// files are. A quadratic objective can be set and read back, and quadratic,
+// indicator and piecewise linear constraints and special ordered sets added,
+// but QPs, QCPs and their MIP versions, and MIPs with any of these constraints
+// or sets, cannot be solved.
+// Like Cplex, LpOpt starts from the basis of the previous LpOpt, or from the
+// basis given to CopyBase or ReadBasis, unless ParamAdvance is 0; a starting
+// basis which is not primal feasible for the modified problem is discarded.
+// All LP optimizers (PrimOpt, DualOpt, BarOpt, HybNetOpt, and LpOpt whatever
+// ParamLpMethod is set to) use the primal simplex method, which GetSolnInfo
+// reports as the method which produced the solution.
+// The fields may be changed at any time, and apply to the next LpOpt or MipOpt
+// call on any problem created through the backend, unless the corresponding
+// parameter has been set in the environment of the problem. The solver honours
+// ParamItLimit, ParamNodeLimit, ParamMipGap, ParamMipAbsGap, ParamAdvance,
+// ParamIntegralityTol, ParamTimeLimit (checked between nodes), and the solution
+// pool parameters other than ParamPoolIntensity, with PoolReplaceDiv handled as
+// PoolReplaceObj; other parameters are stored and reported back, but have no
+// effect.
+type GoBackend struct {
+	IterLimit  int            // Simplex iteration limit per LP, 0 selects a limit based on model size
+	NodeLimit  int            // Branch and bound node limit, 0 for no limit (CPX_PARAM_NODELIM)
+	RelGap     float64        // Relative MIP gap tolerance (CPX_PARAM_EPGAP)
+	AbsGap     float64        // Absolute MIP gap tolerance (CPX_PARAM_EPAGAP)
+}
+
+// goEnv implements BackendEnv for a GoBackend.
+type goEnv struct {
+	paramStore                // Parameters set in the environment
+	b          *GoBackend     // Backend which opened the environment
+	logFn      func(LogChannel, string) // Destination of the messages, nil if none
+	progressFn func(Progress) // Destination of the progress events, nil if none
+}
+
+// goProb implements Solver for a GoBackend.
+type goProb struct {
+	memModel                  // Model passed to the problem
+	env        *goEnv         // Environment in which the problem was created
+	solved     bool           // Flag set once the problem has been solved
+	status     SolutionStatus // Status of the last solve
+	soln       *lpResult      // Solution of the last LpOpt, nil if none exists
+	mip        *mipResult     // Outcome of the last MipOpt, nil if not solved as a MIP
+	start      *lpBasis       // Starting basis of the next LpOpt, nil for a cold start
+}
+
+//==============================================================================
+
+// NewGoBackend returns a GoBackend with default settings: no node limit, and
+// the same gap tolerances as Cplex (1.0e-4 relative, 1.0e-6 absolute).
+func NewGoBackend() *GoBackend {
+
+	return &GoBackend{RelGap: mipDefRelGap, AbsGap: mipDefAbsGap}
+}
+
+//==============================================================================
+// ENVIRONMENT FUNCTIONS
+//==============================================================================
+
+// OpenEnv opens a new environment.
+func (b *GoBackend) OpenEnv() (BackendEnv, error) {
+
+	return &goEnv{b: b}, nil
+}
+
+// CreateProb creates a new, empty problem.
+func (e *goEnv) CreateProb(name string) (Solver, error) {
+
+	return &goProb{memModel: memModel{name: name, sense: 1}, env: e}, nil
+}
+
+// OutputToScreen is accepted for compatibility. The Go solver does not print
+// any output.
+func (e *goEnv) OutputToScreen(echoOn bool) error {
+
+	return nil
+}
+
+// SetLogFunc sets the destination of the messages. The Go solvers send a
+// one-line summary of each solve to the results channel.
+func (e *goEnv) SetLogFunc(fn func(LogChannel, string)) error {
+
+	e.logFn = fn
+
+	return nil
+}
+
+// SetProgressFunc sets the destination of the progress events. The branch and
+// bound solver reports its state before each node; LPs do not report progress.
+func (e *goEnv) SetProgressFunc(fn func(Progress)) error {
+
+	e.progressFn = fn
+
+	return nil
+}
+
+// logf formats a message and sends it to the destination, if any.
+func (e *goEnv) logf(channel LogChannel, format string, args ...interface{}) {
+
+	if e.logFn != nil {
+		e.logFn(channel, fmt.Sprintf(format, args...))
+	}
+}
+
+// GetDblParam returns the value of a double parameter. The MIP gap tolerances
+// default to the fields of the backend.
+func (e *goEnv) GetDblParam(id int) (float64, error) {
+
+	if _, ok := e.values[id]; !ok {
+		switch DblParam(id) {
+		case ParamMipGap:
+			return e.b.RelGap, nil
+		case ParamMipAbsGap:
+			return e.b.AbsGap, nil
+		}
+	}
+
+	return e.paramStore.GetDblParam(id)
+}
+
+// GetLongParam returns the value of a long integer parameter. The iteration
+// and node limits default to the fields of the backend, if they are set.
+func (e *goEnv) GetLongParam(id int) (int64, error) {
+
+	if _, ok := e.values[id]; !ok {
+		switch {
+		case LongParam(id) == ParamItLimit && e.b.IterLimit > 0:
+			return int64(e.b.IterLimit), nil
+		case LongParam(id) == ParamNodeLimit && e.b.NodeLimit > 0:
+			return int64(e.b.NodeLimit), nil
+		}
+	}
+
+	return e.paramStore.GetLongParam(id)
+}
+
+// mipOptions returns the limits and tolerances used by the solvers. Iteration
+// and node limits too large for the solver, such as their default value of
+// 9223372036800000000, and time limits of 1.0e75 or more mean no limit.
+func (e *goEnv) mipOptions() mipOptions {
+	var opt     mipOptions   // Options collected from the parameters
+
+	itLim, _          := e.GetLongParam(int(ParamItLimit))
+	nodeLim, _        := e.GetLongParam(int(ParamNodeLimit))
+	opt.relGap, _     = e.GetDblParam(int(ParamMipGap))
+	opt.absGap, _     = e.GetDblParam(int(ParamMipAbsGap))
+	opt.intTol, _     = e.GetDblParam(int(ParamIntegralityTol))
+	opt.timeLimit, _  = e.GetDblParam(int(ParamTimeLimit))
+	opt.popLimit, _   = e.GetIntParam(int(ParamPopulateLimit))
+	opt.poolCap, _    = e.GetIntParam(int(ParamPoolCapacity))
+	opt.poolRepl, _   = e.GetIntParam(int(ParamPoolReplace))
+	opt.poolRelGap, _ = e.GetDblParam(int(ParamPoolRelGap))
+	opt.poolAbsGap, _ = e.GetDblParam(int(ParamPoolAbsGap))
+
+	if itLim < math.MaxInt32 {
+		opt.iterLimit = int(itLim)
+	}
+	if nodeLim < math.MaxInt32 {
+		opt.nodeLimit = int(nodeLim)
+	}
+	if opt.timeLimit >= 1.0e75 {
+		opt.timeLimit = 0
+	}
+
+	return opt
+}
+
+// CloseEnv closes the environment.
+func (e *goEnv) CloseEnv() error {
+
+	return nil
+}
+
+//==============================================================================
+// PROBLEM FUNCTIONS
+//==============================================================================
+
+// FreeProb releases the problem.
+func (p *goProb) FreeProb() error {
+
+	p.memModel = memModel{}
+	p.start    = nil
+	p.clearSoln()
+
+	return nil
+}
+
+// clearSoln discards the solution after the model has changed.
+func (p *goProb) clearSoln() {
+
+	p.solved = false
+	p.status = 0
+	p.soln   = nil
+	p.mip    = nil
+}
+
+// ChgProbName changes the name of the problem.
+func (p *goProb) ChgProbName(name string) error {
+
+	p.name = name
+
+	return nil
+}
+
+// ChgObjSen changes the objective sense.
+func (p *goProb) ChgObjSen(sense int) error {
+
+	p.sense = sense
+	p.clearSoln()
+
+	return nil
+}
+
+// NewRows appends the rows to the model.
+func (p *goProb) NewRows(rList []InputRow) error {
+
+	for i := range rList {
+		switch rList[i].Sense {
+		case "L", "E", "G", "R":
+		default:
+			return errors.Errorf("Row %d has unsupported sense '%s'", i, rList[i].Sense)
+		}
+	}
+
+	p.newRows(rList)
+	p.clearSoln()
+
+	return nil
+}
+
+// NewCols appends the columns and their objective coefficients to the model.
+func (p *goProb) NewCols(objList []InputObjCoef, cList []InputCol) error {
+
+	for i := range cList {
+		switch cList[i].Type {
+		case "C", "B", "I":
+		default:
+			return errors.Errorf("Column %d has unsupported type '%s'", i, cList[i].Type)
+		}
+	}
+
+	p.newCols(objList, cList)
+	p.clearSoln()
+
+	return nil
+}
+
+// AddRows appends the rows and their coefficients to the model.
+func (p *goProb) AddRows(rList []InputRow, rMat InputMatrix) error {
+
+	first := len(p.rows)
+	if err := p.NewRows(rList); err != nil {
+		return err
+	}
+
+	p.addRowElems(first, rMat)
+
+	return nil
+}
+
+// AddCols appends the columns, their objective coefficients and their
+// coefficients to the model.
+func (p *goProb) AddCols(objList []InputObjCoef, cList []InputCol, cMat InputMatrix) error {
+
+	first := len(p.cols)
+	if err := p.NewCols(objList, cList); err != nil {
+		return err
+	}
+
+	p.addColElems(first, cMat)
+
+	return nil
+}
+
+// ChgCoefList sets the non-zero coefficients.
+func (p *goProb) ChgCoefList(eList []InputElem) error {
+
+	if err := p.chgCoefList(eList); err != nil {
+		return err
+	}
+	p.clearSoln()
+
+	return nil
+}
+
+// ChgBds changes the bounds of the columns. The basis of the previous solve is
+// kept, as for all changes below.
+func (p *goProb) ChgBds(bList []InputBound) error {
+
+	p.chgBds(bList)
+	p.clearSoln()
+
+	return nil
+}
+
+// ChgRhs changes the right hand sides of the rows.
+func (p *goProb) ChgRhs(rList []InputRowValue) error {
+
+	p.chgRhs(rList)
+	p.clearSoln()
+
+	return nil
+}
+
+// ChgSense changes the senses of the rows.
+func (p *goProb) ChgSense(sList []InputSense) error {
+
+	for i := range sList {
+		switch sList[i].Sense {
+		case "L", "E", "G", "R":
+		default:
+			return errors.Errorf("Row %d has unsupported sense '%s'", sList[i].RowIndex, sList[i].Sense)
+		}
+	}
+
+	p.chgSense(sList)
+	p.clearSoln()
+
+	return nil
+}
+
+// ChgRngVal changes the range values of the rows.
+func (p *goProb) ChgRngVal(rList []InputRowValue) error {
+
+	p.chgRngVal(rList)
+	p.clearSoln()
+
+	return nil
+}
+
+// ChgObj changes the objective function coefficients.
+func (p *goProb) ChgObj(oList []InputColValue) error {
+
+	p.chgObj(oList)
+	p.clearSoln()
+
+	return nil
+}
+
+// ChgCType changes the types of the columns. As in NewCols, only "C", "B" and
+// "I" are supported.
+func (p *goProb) ChgCType(tList []InputCType) error {
+
+	for i := range tList {
+		switch tList[i].Type {
+		case "C", "B", "I":
+		default:
+			return errors.Errorf("Column %d has unsupported type '%s'", tList[i].ColIndex, tList[i].Type)
+		}
+	}
+
+	p.chgCType(tList)
+	p.clearSoln()
+
+	return nil
+}
+
+// ChgColName renames the columns. The solution is kept.
+func (p *goProb) ChgColName(nList []InputName) error {
+
+	p.chgColName(nList)
+
+	return nil
+}
+
+// ChgRowName renames the rows. The solution is kept.
+func (p *goProb) ChgRowName(nList []InputName) error {
+
+	p.chgRowName(nList)
+
+	return nil
+}
+
+// DelRows deletes a range of rows.
+func (p *goProb) DelRows(begin, end int) error {
+
+	return p.DelSetRows(rangeStat(len(p.rows), begin, end))
+}
+
+// DelCols deletes a range of columns.
+func (p *goProb) DelCols(begin, end int) error {
+
+	return p.DelSetCols(rangeStat(len(p.cols), begin, end))
+}
+
+// DelSetRows deletes the rows set to 1 in delStat. The statuses of the
+// remaining rows are kept in the starting basis; if a deleted row was not
+// basic, the next LpOpt starts from the slack basis.
+func (p *goProb) DelSetRows(delStat []int) error {
+
+	p.delSetRows(delStat)
+	p.start = p.start.without(nil, delStat)
+	p.clearSoln()
+
+	return nil
+}
+
+// DelSetCols deletes the columns set to 1 in delStat. The statuses of the
+// remaining columns are kept in the starting basis; if a deleted column was
+// basic, the next LpOpt starts from the slack basis.
+func (p *goProb) DelSetCols(delStat []int) error {
+
+	p.delSetCols(delStat)
+	p.start = p.start.without(delStat, nil)
+	p.clearSoln()
+
+	return nil
+}
+
+// CopyQuad sets the quadratic objective matrix.
+func (p *goProb) CopyQuad(qList []InputQuadCoef) error {
+
+	p.quad = append([]InputQuadCoef(nil), qList...)
+	p.clearSoln()
+
+	return nil
+}
+
+// ChgQPCoef changes coefficients of the quadratic objective matrix.
+func (p *goProb) ChgQPCoef(qList []InputQuadCoef) error {
+
+	p.chgQPCoef(qList)
+	p.clearSoln()
+
+	return nil
+}
+
+// Quad returns a copy of the coefficients of the quadratic objective matrix.
+func (p *goProb) Quad() ([]InputQuadCoef, error) {
+
+	return append([]InputQuadCoef(nil), p.quad...), nil
+}
+
+// AddQConstrs appends the quadratic constraints to the model.
+func (p *goProb) AddQConstrs(qList []InputQConstr) error {
+
+	p.qconstrs = append(p.qconstrs, qList...)
+	p.clearSoln()
+
+	return nil
+}
+
+// NumQConstrs returns the number of quadratic constraints in the model.
+func (p *goProb) NumQConstrs() int {
+
+	return len(p.qconstrs)
+}
+
+// QConstrNames returns the names of the quadratic constraints.
+func (p *goProb) QConstrNames() ([]string, error) {
+
+	names := make([]string, len(p.qconstrs))
+	for i := range p.qconstrs {
+		names[i] = p.qconstrs[i].Name
+	}
+
+	return names, nil
+}
+
+// QConstrSlack fails unless the problem was solved, in which case it has no
+// quadratic constraints, since the Go solvers cannot handle them.
+func (p *goProb) QConstrSlack(slack []float64) error {
+
+	return p.checkSoln()
+}
+
+// QConstrDSlack fails unless the problem was solved, in which case it has no
+// quadratic constraints, since the Go solvers cannot handle them.
+func (p *goProb) QConstrDSlack(index int) ([]InputLinCoef, error) {
+
+	return nil, p.checkSoln()
+}
+
+// AddIndConstrs appends the indicator constraints to the model.
+func (p *goProb) AddIndConstrs(iList []InputIndicator) error {
+
+	p.inds = append(p.inds, iList...)
+	p.clearSoln()
+
+	return nil
+}
+
+// NumIndConstrs returns the number of indicator constraints in the model.
+func (p *goProb) NumIndConstrs() int {
+
+	return len(p.inds)
+}
+
+// IndConstrs copies the indicator constraints of the model.
+func (p *goProb) IndConstrs(iList []InputIndicator) error {
+
+	copy(iList, copyIndicators(p.inds))
+
+	return nil
+}
+
+// IndConstrNames returns the names of the indicator constraints.
+func (p *goProb) IndConstrNames() ([]string, error) {
+
+	names := make([]string, len(p.inds))
+	for i := range p.inds {
+		names[i] = p.inds[i].Name
+	}
+
+	return names, nil
+}
+
+// DelIndConstrs deletes a range of indicator constraints.
+func (p *goProb) DelIndConstrs(begin, end int) error {
+
+	p.delIndConstrs(begin, end)
+	p.clearSoln()
+
+	return nil
+}
+
+// AddSOS appends the special ordered sets to the model.
+func (p *goProb) AddSOS(sList []InputSOS) error {
+
+	p.sos = append(p.sos, sList...)
+	p.clearSoln()
+
+	return nil
+}
+
+// NumSOS returns the number of special ordered sets in the model.
+func (p *goProb) NumSOS() int {
+
+	return len(p.sos)
+}
+
+// SOS copies the special ordered sets of the model.
+func (p *goProb) SOS(sList []InputSOS) error {
+
+	copy(sList, copySOS(p.sos))
+
+	return nil
+}
+
+// SOSNames returns the names of the special ordered sets.
+func (p *goProb) SOSNames() ([]string, error) {
+
+	names := make([]string, len(p.sos))
+	for i := range p.sos {
+		names[i] = p.sos[i].Name
+	}
+
+	return names, nil
+}
+
+// DelSOS deletes a range of special ordered sets.
+func (p *goProb) DelSOS(begin, end int) error {
+
+	p.delSOS(begin, end)
+	p.clearSoln()
+
+	return nil
+}
+
+// AddPwl appends the piecewise linear constraints to the model.
+func (p *goProb) AddPwl(pList []InputPwl) error {
+
+	p.pwl = append(p.pwl, pList...)
+	p.clearSoln()
+
+	return nil
+}
+
+// NumPwl returns the number of piecewise linear constraints in the model.
+func (p *goProb) NumPwl() int {
+
+	return len(p.pwl)
+}
+
+// Pwl copies the piecewise linear constraints of the model.
+func (p *goProb) Pwl(pList []InputPwl) error {
+
+	copy(pList, copyPwl(p.pwl))
+
+	return nil
+}
+
+// PwlNames returns the names of the piecewise linear constraints.
+func (p *goProb) PwlNames() ([]string, error) {
+
+	names := make([]string, len(p.pwl))
+	for i := range p.pwl {
+		names[i] = p.pwl[i].Name
+	}
+
+	return names, nil
+}
+
+// DelPwl deletes a range of piecewise linear constraints.
+func (p *goProb) DelPwl(begin, end int) error {
+
+	p.delPwl(begin, end)
+	p.clearSoln()
+
+	return nil
+}
+
+// AddMIPStarts appends the MIP starts to the model.
+func (p *goProb) AddMIPStarts(mList []InputMIPStart) error {
+
+	p.starts = append(p.starts, mList...)
+
+	return nil
+}
+
+// ChgMIPStarts changes the efforts and values of MIP starts.
+func (p *goProb) ChgMIPStarts(indices []int, mList []InputMIPStart) error {
+
+	p.chgMIPStarts(indices, mList)
+
+	return nil
+}
+
+// NumMIPStarts returns the number of MIP starts in the model.
+func (p *goProb) NumMIPStarts() int {
+
+	return len(p.starts)
+}
+
+// MIPStarts copies the MIP starts of the model.
+func (p *goProb) MIPStarts(mList []InputMIPStart) error {
+
+	copy(mList, copyMIPStarts(p.starts))
+
+	return nil
+}
+
+// MIPStartNames returns the names of the MIP starts.
+func (p *goProb) MIPStartNames() ([]string, error) {
+
+	names := make([]string, len(p.starts))
+	for i := range p.starts {
+		names[i] = p.starts[i].Name
+	}
+
+	return names, nil
+}
+
+// checkSupported returns an error if the problem has a quadratic objective,
+// quadratic, indicator or piecewise linear constraints, or special ordered
+// sets, which the Go solvers cannot handle.
+func (p *goProb) checkSupported(op string) error {
+
+	if len(p.quad) > 0 {
+		return errors.Errorf("%s of the Go backend cannot solve a problem with a quadratic objective", op)
+	}
+
+	if len(p.qconstrs) > 0 {
+		return errors.Errorf("%s of the Go backend cannot solve a problem with quadratic constraints", op)
+	}
+
+	if len(p.inds) > 0 {
+		return errors.Errorf("%s of the Go backend cannot solve a problem with indicator constraints", op)
+	}
+
+	if len(p.sos) > 0 {
+		return errors.Errorf("%s of the Go backend cannot solve a problem with special ordered sets", op)
+	}
+
+	if len(p.pwl) > 0 {
+		return errors.Errorf("%s of the Go backend cannot solve a problem with piecewise linear constraints", op)
+	}
+
+	return nil
+}
+
+// QPOpt is not supported by the Go backend.
+func (p *goProb) QPOpt(ctx context.Context) error {
+
+	return errors.New("QPOpt is not supported by the Go backend")
+}
+
+// LpOpt solves the problem with the simplex method. Like CPXlpopt, it succeeds
+// whenever the solver ran to completion, even if the problem turned out to be
+// infeasible or unbounded; in that case no solution is available afterwards.
+// The solve is aborted with StatAbortUser once ctx is done.
+func (p *goProb) LpOpt(ctx context.Context) error {
+
+	if err := p.checkSupported("LpOpt"); err != nil {
+		return err
+	}
+
+	if p.isMip() {
+		return errors.Wrap(ErrNotForMIP, "LpOpt cannot solve a problem with integer columns")
+	}
+
+	p.clearSoln()
+
+	var start *lpBasis
+	if advance, _ := p.env.GetIntParam(int(ParamAdvance)); advance != 0 {
+		start = p.startBasis()
+	}
+
+	res, err := solveLpModel(&p.memModel, nil, nil, p.env.mipOptions().iterLimit, ctx.Done(), start)
+	if err != nil {
+		return errors.Wrap(err, "Go simplex solver failed")
+	}
+
+	p.solved = true
+	p.status = res.status
+	if res.status == StatOptimal {
+		p.soln  = res
+		p.start = res.final.basisStatus(&p.memModel)
+	}
+	p.env.logf(LogResults, "Go simplex: %s, objective = %g, iterations = %d\n",
+		res.status, res.objVal, res.iter)
+
+	return nil
+}
+
+// PrimOpt solves the problem with the simplex method, as LpOpt does.
+func (p *goProb) PrimOpt(ctx context.Context) error {
+
+	return p.LpOpt(ctx)
+}
+
+// DualOpt solves the problem with the primal simplex method, as LpOpt does.
+func (p *goProb) DualOpt(ctx context.Context) error {
+
+	return p.LpOpt(ctx)
+}
+
+// BarOpt solves the problem with the primal simplex method, as LpOpt does, so
+// the solution is basic whatever the crossover.
+func (p *goProb) BarOpt(ctx context.Context, crossover Algorithm) error {
+
+	return p.LpOpt(ctx)
+}
+
+// HybNetOpt solves the problem with the primal simplex method, as LpOpt does.
+func (p *goProb) HybNetOpt(ctx context.Context, method Algorithm) error {
+
+	return p.LpOpt(ctx)
+}
+
+// MipOpt solves the problem by branch and bound. Like CPXmipopt, it succeeds
+// whenever the search ran to completion or stopped at a limit; the incumbent,
+// if any, is available afterwards. A problem without integer columns is solved
+// by LpOpt, as Cplex does, so that the status is that of an LP and the duals
+// and reduced costs are available. The search is aborted once ctx is done,
+// keeping the incumbent.
+func (p *goProb) MipOpt(ctx context.Context) error {
+
+	if err := p.checkSupported("MipOpt"); err != nil {
+		return err
+	}
+
+	if !p.isMip() {
+		return p.LpOpt(ctx)
+	}
+
+	p.clearSoln()
+
+	opt := p.env.mipOptions()
+	opt.done     = ctx.Done()
+	opt.progress = p.env.progressFn
+
+	res, err := solveMipModel(&p.memModel, opt)
+	if err != nil {
+		return errors.Wrap(err, "Go branch and bound solver failed")
+	}
+
+	p.solved = true
+	p.status = res.status
+	p.mip    = res
+	p.env.logf(LogResults, "Go branch and bound: %s, objective = %g, best bound = %g, nodes = %d\n",
+		res.status, res.objVal, res.bestBound, res.nodes)
+
+	return nil
+}
+
+// Populate solves the problem by branch and bound in populate mode, which
+// goes on searching after the optimal solution has been found, until
+// ParamPopulateLimit solutions have been added to the pool or the tree is
+// exhausted. The pool then only keeps the solutions within the pool gaps of
+// the incumbent. Unlike CPXpopulate, it always starts a new search and a new
+// pool, and ParamPoolIntensity has no effect.
+func (p *goProb) Populate(ctx context.Context) error {
+
+	if err := p.checkSupported("Populate"); err != nil {
+		return err
+	}
+
+	if !p.isMip() {
+		return errors.New("Populate cannot solve a problem without integer columns")
+	}
+
+	p.clearSoln()
+
+	opt := p.env.mipOptions()
+	opt.done     = ctx.Done()
+	opt.progress = p.env.progressFn
+	opt.populate = true
+
+	res, err := solveMipModel(&p.memModel, opt)
+	if err != nil {
+		return errors.Wrap(err, "Go branch and bound solver failed")
+	}
+	res.trimPool(p.sense, opt)
+
+	p.solved = true
+	p.status = res.status
+	p.mip    = res
+	p.env.logf(LogResults, "Go populate: %s, objective = %g, solutions in pool = %d, nodes = %d\n",
+		res.status, res.objVal, len(res.pool), res.nodes)
+
+	return nil
+}
+
+// PoolNumSolns returns the number of solutions in the pool of the last MipOpt
+// or Populate.
+func (p *goProb) PoolNumSolns() int {
+
+	if p.mip == nil {
+		return 0
+	}
+
+	return len(p.mip.pool)
+}
+
+// PoolSolution copies the column values and slacks of a pool solution into
+// the slices, and returns its objective value.
+func (p *goProb) PoolSolution(i int, x, slack []float64) (float64, error) {
+
+	if i < 0 || i >= p.PoolNumSolns() {
+		return 0, errors.Wrapf(ErrNoSolution, "Pool has no solution %d", i)
+	}
+
+	copy(x, p.mip.pool[i].x)
+	copy(slack, p.mip.pool[i].slack)
+
+	return p.mip.pool[i].objVal, nil
+}
+
+// AddPoolFilters appends the solution pool filters to the model.
+func (p *goProb) AddPoolFilters(fList []InputPoolFilter) error {
+
+	p.filters = append(p.filters, fList...)
+
+	return nil
+}
+
+// NumPoolFilters returns the number of solution pool filters in the model.
+func (p *goProb) NumPoolFilters() int {
+
+	return len(p.filters)
+}
+
+// DelPoolFilters deletes a range of solution pool filters.
+func (p *goProb) DelPoolFilters(begin, end int) error {
+
+	p.delPoolFilters(begin, end)
+
+	return nil
+}
+
+// NumRows returns the number of rows in the model.
+func (p *goProb) NumRows() int {
+
+	return len(p.rows)
+}
+
+// NumCols returns the number of columns in the model.
+func (p *goProb) NumCols() int {
+
+	return len(p.cols)
+}
+
+// ObjSen returns the objective sense.
+func (p *goProb) ObjSen() (int, error) {
+
+	return p.sense, nil
+}
+
+// Rows copies the rows of the model.
+func (p *goProb) Rows(rList []InputRow) error {
+
+	copy(rList, p.rows)
+
+	return nil
+}
+
+// Cols copies the columns of the model.
+func (p *goProb) Cols(cList []InputCol) error {
+
+	copy(cList, p.cols)
+
+	return nil
+}
+
+// Obj copies the objective function coefficients.
+func (p *goProb) Obj(obj []float64) error {
+
+	copy(obj, p.obj)
+
+	return nil
+}
+
+// Coefs returns a copy of the non-zero coefficients.
+func (p *goProb) Coefs() ([]InputElem, error) {
+
+	return append([]InputElem(nil), p.elems...), nil
+}
+
+// checkSoln returns an error if no solution is available.
+func (p *goProb) checkSoln() error {
+
+	if !p.solved {
+		return errors.Wrap(ErrNoSolution, "Problem has not been solved")
+	}
+
+	if p.soln == nil && (p.mip == nil || p.mip.x == nil) {
+		return errors.Wrapf(ErrNoSolution, "Problem is %s", p.status)
+	}
+
+	return nil
+}
+
+// ObjVal returns the objective function value of the solution.
+func (p *goProb) ObjVal() (float64, error) {
+
+	if err := p.checkSoln(); err != nil {
+		return 0, err
+	}
+
+	if p.mip != nil {
+		return p.mip.objVal, nil
+	}
+
+	return p.soln.objVal, nil
+}
+
+// Solution copies the solution into the slices. Like CPXsolution, it is not
+// available after MipOpt, since a MIP has no duals or reduced costs.
+func (p *goProb) Solution(x, dj, pi, slack []float64) (float64, error) {
+
+	if err := p.checkSoln(); err != nil {
+		return 0, err
+	}
+
+	if p.mip != nil {
+		return 0, errors.Wrap(ErrNotForMIP, "Duals and reduced costs are not available")
+	}
+
+	copy(x, p.soln.x)
+	copy(dj, p.soln.dj)
+	copy(pi, p.soln.pi)
+	copy(slack, p.soln.slack)
+
+	return p.soln.objVal, nil
+}
+
+// X copies the column values of the solution into the slice.
+func (p *goProb) X(x []float64) error {
+
+	if err := p.checkSoln(); err != nil {
+		return err
+	}
+
+	if p.mip != nil {
+		copy(x, p.mip.x)
+	} else {
+		copy(x, p.soln.x)
+	}
+
+	return nil
+}
+
+// Slack copies the row slacks of the solution into the slice.
+func (p *goProb) Slack(slack []float64) error {
+
+	if err := p.checkSoln(); err != nil {
+		return err
+	}
+
+	if p.mip != nil {
+		copy(slack, p.mip.slack)
+	} else {
+		copy(slack, p.soln.slack)
+	}
+
+	return nil
+}
+
+// checkMip returns an error if the problem was not solved by MipOpt, or if the
+// search ended without a bound.
+func (p *goProb) checkMip() error {
+
+	if p.mip == nil {
+		return errors.Wrap(ErrNoSolution, "MipOpt has not been called")
+	}
+
+	switch p.mip.status {
+	case MipInfeasible, MipInfOrUnbd:
+		return errors.Wrapf(ErrNoSolution, "No bound exists, problem is %s", p.status)
+	}
+
+	return nil
+}
+
+// Status returns the status of the last solve, StatNone if the problem has not
+// been solved since it was last changed.
+func (p *goProb) Status() (SolutionStatus, error) {
+
+	return p.status, nil
+}
+
+// SolnInfo describes the solution of the last solve. LPs are solved by the
+// primal simplex method, and give a basic solution which is both primal and
+// dual feasible.
+func (p *goProb) SolnInfo() (SolnInfo, error) {
+
+	if p.checkSoln() != nil {
+		return SolnInfo{Method: AlgNone, Type: SolnNone}, nil
+	}
+
+	if p.mip != nil {
+		return SolnInfo{Method: AlgMip, Type: SolnPrimal, PrimalFeasible: true}, nil
+	}
+
+	return SolnInfo{Method: AlgPrimal, Type: SolnBasic, PrimalFeasible: true, DualFeasible: true}, nil
+}
+
+// BestObjVal returns the best bound on the optimal objective value found by
+// the branch and bound search.
+func (p *goProb) BestObjVal() (float64, error) {
+
+	if err := p.checkMip(); err != nil {
+		return 0, err
+	}
+
+	return p.mip.bestBound, nil
+}
+
+// MipRelGap returns the relative gap between the incumbent and the best bound.
+func (p *goProb) MipRelGap() (float64, error) {
+
+	if err := p.checkMip(); err != nil {
+		return 0, err
+	}
+
+	if err := p.checkSoln(); err != nil {
+		return 0, err
+	}
+
+	return mipRelGap(p.mip.objVal, p.mip.bestBound), nil
+}
+
+// checkBasis returns an error if no optimal basis is available. Like Cplex,
+// sensitivity analysis is not available after MipOpt.
+func (p *goProb) checkBasis() error {
+
+	if p.mip != nil {
+		return errors.Wrap(ErrNotForMIP, "Sensitivity analysis is not available")
+	}
+
+	if err := p.checkSoln(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ObjSA computes the ranges of the objective coefficients over which the
+// optimal basis remains optimal.
+func (p *goProb) ObjSA(lower, upper []float64) error {
+
+	if err := p.checkBasis(); err != nil {
+		return err
+	}
+
+	p.soln.final.objRanges(&p.memModel, lower, upper)
+
+	return nil
+}
+
+// RhsSA computes the ranges of the right hand sides over which the optimal
+// basis remains feasible.
+func (p *goProb) RhsSA(lower, upper []float64) error {
+
+	if err := p.checkBasis(); err != nil {
+		return err
+	}
+
+	p.soln.final.rhsRanges(lower, upper)
+
+	return nil
+}
+
+// BoundSA computes the ranges of the column bounds over which the optimal basis
+// remains feasible.
+func (p *goProb) BoundSA(lbLo, lbUp, ubLo, ubUp []float64) error {
+
+	if err := p.checkBasis(); err != nil {
+		return err
+	}
+
+	p.soln.final.boundRanges(lbLo, lbUp, ubLo, ubUp)
+
+	return nil
+}
+
+// startBasis returns the starting basis sized to the model, nil if there is
+// none. Columns appended since the basis was obtained are at their lower
+// bound, and the slacks of rows appended since are basic.
+func (p *goProb) startBasis() *lpBasis {
+
+	if p.start == nil {
+		return nil
+	}
+
+	b := &lpBasis{cols: make([]BasisStatus, len(p.cols)), rows: make([]BasisStatus, len(p.rows))}
+	copy(b.cols, p.start.cols)
+	for i := range b.rows {
+		b.rows[i] = BasisBasic
+	}
+	copy(b.rows, p.start.rows)
+
+	return b
+}
+
+// Base copies the starting basis of the next LpOpt, which is the optimal basis
+// after LpOpt, into the slices.
+func (p *goProb) Base(cStat, rStat []BasisStatus) error {
+
+	b := p.startBasis()
+	if b == nil {
+		return errors.Wrap(ErrNoBasis, "Problem has not been solved by LpOpt")
+	}
+
+	copy(cStat, b.cols)
+	copy(rStat, b.rows)
+
+	return nil
+}
+
+// CopyBase sets the starting basis of the next LpOpt, and discards the
+// solution.
+func (p *goProb) CopyBase(cStat, rStat []BasisStatus) error {
+
+	p.start = &lpBasis{cols: append([]BasisStatus(nil), cStat...), rows: append([]BasisStatus(nil), rStat...)}
+	p.clearSoln()
+
+	return nil
+}
+
+// ReadBasis sets the starting basis of the next LpOpt from a basis file.
+func (p *goProb) ReadBasis(fileName string) error {
+
+	b, err := readBasisFile(fileName, p.colNames(), p.rowNames())
+	if err != nil {
+		return err
+	}
+
+	p.start = b
+	p.clearSoln()
+
+	return nil
+}
+
+// WriteBasis writes the starting basis of the next LpOpt to a basis file.
+func (p *goProb) WriteBasis(fileName string) error {
+
+	b := p.startBasis()
+	if b == nil {
+		return errors.Wrap(ErrNoBasis, "Problem has not been solved by LpOpt")
+	}
+
+	return writeBasisFile(fileName, p.name, p.colNames(), p.rowNames(), b)
+}
+
+// ReadMIPStarts appends the MIP starts of an MST file to the model.
+func (p *goProb) ReadMIPStarts(fileName string) error {
+
+	starts, err := readMIPStartFile(fileName, p.colNames())
+	if err != nil {
+		return err
+	}
+
+	p.starts = append(p.starts, starts...)
+
+	return nil
+}
+
+// WriteMIPStarts writes the MIP starts of the model to an MST file.
+func (p *goProb) WriteMIPStarts(fileName string) error {
+
+	return writeMIPStartFile(fileName, p.name, p.colNames(), p.starts)
+}
+
+// ColNames returns the names of the columns.
+func (p *goProb) ColNames() ([]string, error) {
+
+	return p.colNames(), nil
+}
+
+// RowNames returns the names of the rows.
+func (p *goProb) RowNames() ([]string, error) {
+
+	return p.rowNames(), nil
+}
+
+// ReadCopyProb is not supported.
+func (p *goProb) ReadCopyProb(fileName string, fileType string) error {
+
+	return errors.New("ReadCopyProb is not supported by the Go backend")
+}
+
+// WriteProb is not supported.
+func (p *goProb) WriteProb(fileName string, fileType string) error {
+
+	return errors.New("WriteProb is not supported by the Go backend")
+}
+
+// SolWrite is not supported.
+func (p *goProb) SolWrite(fileName string) error {
+
+	return errors.New("SolWrite is not supported by the Go backend")
+}
+
+//============================ END OF FILE =====================================
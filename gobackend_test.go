@@ -0,0 +1,127 @@
+package gpx
+
+import (
+	"math"
+	"testing"
+)
+
+// goModel is the problem
+//   max 3x + 2y  st  x + y <= 4, x + 3y <= 7, 0 <= x <= 3, y >= 0
+// with columns of the type passed to the function.
+func goModel(colType string) testModel {
+
+	return testModel{
+		name:  "go",
+		rows:  []InputRow{{"c1", "L", 4, 0}, {"c2", "L", 7, 0}},
+		obj:   []InputObjCoef{{0, 3}, {1, 2}},
+		cols:  []InputCol{{"x", colType, 0, 3}, {"y", colType, 0, 1e20}},
+		elems: []InputElem{{0, 0, 1}, {0, 1, 1}, {1, 0, 1}, {1, 1, 3}},
+		sense: -1,
+	}
+}
+
+//==============================================================================
+
+func TestGoMipOptWithoutIntegers(t *testing.T) {
+
+	_, prob := newTestProb(t, NewGoBackend(), goModel("C"))
+
+	if err := prob.MipOpt(); err != nil {
+		t.Fatalf("MipOpt failed: %v", err)
+	}
+
+	var status SolutionStatus
+	if err := prob.GetStatus(&status); err != nil || status != StatOptimal {
+		t.Errorf("GetStatus = %s, %v, want %s", status, err, StatOptimal)
+	}
+
+	var info SolnInfo
+	if err := prob.GetSolnInfo(&info); err != nil || info.Type != SolnBasic || !info.DualFeasible {
+		t.Errorf("GetSolnInfo = %+v, %v, want a dual feasible basic solution", info, err)
+	}
+
+	var objVal float64
+	var sRows []SolnRow
+	var sCols []SolnCol
+	if err := prob.GetSolution(&objVal, &sRows, &sCols); err != nil {
+		t.Fatalf("GetSolution failed: %v", err)
+	}
+
+	if math.Abs(objVal - 11) > 1e-9 || math.Abs(sRows[0].Pi - 2) > 1e-9 || math.Abs(sCols[0].RedCost - 1) > 1e-9 {
+		t.Errorf("GetSolution = %g, %+v, %+v, want 11 with pi 2 and dj 1", objVal, sRows, sCols)
+	}
+}
+
+//==============================================================================
+
+func TestGoMipOptWithIntegers(t *testing.T) {
+
+	_, prob := newTestProb(t, NewGoBackend(), goModel("I"))
+
+	if err := prob.MipOpt(); err != nil {
+		t.Fatalf("MipOpt failed: %v", err)
+	}
+
+	var status SolutionStatus
+	if err := prob.GetStatus(&status); err != nil || status != MipOptimal {
+		t.Errorf("GetStatus = %s, %v, want %s", status, err, MipOptimal)
+	}
+
+	var objVal float64
+	var sRows []SolnRow
+	var sCols []SolnCol
+	if err := prob.GetSolution(&objVal, &sRows, &sCols); err == nil {
+		t.Error("GetSolution succeeded after MipOpt, want error")
+	}
+
+	if err := prob.GetMipSolution(&objVal, &sRows, &sCols); err != nil || objVal != 11 {
+		t.Errorf("GetMipSolution = %g, %v, want 11", objVal, err)
+	}
+}
+
+//==============================================================================
+
+// TestGoMipOptIterLimit stops the search at the root, whose relaxation with
+// the objective 3x + 4y is not solved in one iteration, and checks that the
+// MIP start x = 3, y = 1 is kept as the incumbent.
+func TestGoMipOptIterLimit(t *testing.T) {
+
+	env, prob := newTestProb(t, NewGoBackend(), goModel("I"))
+	if err := env.SetLongParam(ParamItLimit, 1); err != nil {
+		t.Fatalf("SetLongParam failed: %v", err)
+	}
+	if err := prob.ChgObj([]InputColValue{{ColName: "y", Value: 4}}); err != nil {
+		t.Fatalf("ChgObj failed: %v", err)
+	}
+
+	var status SolutionStatus
+	var objVal float64
+	var sRows []SolnRow
+	var sCols []SolnCol
+
+	if err := prob.MipOpt(); err != nil {
+		t.Fatalf("MipOpt failed: %v", err)
+	}
+	if prob.GetStatus(&status); status != MipFailInfeas {
+		t.Errorf("GetStatus = %s without a start, want %s", status, MipFailInfeas)
+	}
+	if err := prob.GetMipSolution(&objVal, &sRows, &sCols); err == nil {
+		t.Error("GetMipSolution succeeded without an incumbent, want error")
+	}
+
+	if err := prob.AddMIPStarts([]InputMIPStart{{"start", EffortCheckFeas,
+		[]InputColValue{{ColName: "x", Value: 3}, {ColName: "y", Value: 1}}}}); err != nil {
+		t.Fatalf("AddMIPStarts failed: %v", err)
+	}
+	if err := prob.MipOpt(); err != nil {
+		t.Fatalf("MipOpt failed: %v", err)
+	}
+	if prob.GetStatus(&status); status != MipFailFeas {
+		t.Errorf("GetStatus = %s with a start, want %s", status, MipFailFeas)
+	}
+	if err := prob.GetMipSolution(&objVal, &sRows, &sCols); err != nil || objVal != 13 {
+		t.Errorf("GetMipSolution = %g, %v, want 13", objVal, err)
+	}
+}
+
+//============================ END OF FILE =====================================
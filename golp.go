@@ -0,0 +1,1054 @@
+// 01   Oct. 16, 2026   Initial version, pure Go simplex solver for LPs
+// 02   Oct. 16, 2026   Replaced LpStatus by SolutionStatus
+// 03   Oct. 16, 2026   Solve can be aborted through a channel
+// 04   Oct. 16, 2026   Added sensitivity analysis of the optimal basis
+// 05   Oct. 16, 2026   Solve can start from a given basis
+// 06   Oct. 16, 2026   A failed final refactorization is returned as an error
+
+package gpx
+
+import (
+	"github.com/pkg/errors"
+	"math"
+)
+
+// Tolerances and limits used by the Go simplex solver.
+const (
+	lpFeasTol       = 1e-9         // Primal feasibility tolerance
+	lpOptTol        = 1e-9         // Reduced cost (optimality) tolerance
+	lpPivotTol      = 1e-9         // Smallest pivot element accepted
+	lpRefactorFreq  = 50           // Iterations between refactorizations of the basis
+	lpDegenLimit    = 50           // Degenerate iterations before switching to Bland's rule
+)
+
+// lpResult holds the solution of an LP computed by the Go simplex solver. All
+// values refer to the objective in its original sense.
+type lpResult struct {
+	status    SolutionStatus // Outcome of the solve
+	objVal    float64        // Objective function value
+	x         []float64      // Column values
+	dj        []float64      // Column reduced costs
+	pi        []float64      // Row duals
+	slack     []float64      // Row slacks, rhs - a*x
+	iter      int            // Simplex iterations performed
+	final     *simplex       // Optimal basis, kept for sensitivity analysis
+}
+
+// lpBasis holds the status of each column and row in a basis, using the Cplex
+// conventions (see BasisStatus).
+type lpBasis struct {
+	cols      []BasisStatus  // Status of each column
+	rows      []BasisStatus  // Status of the slack of each row
+}
+
+// simplex holds the working data of the bounded-variable revised simplex method.
+// The variables are the structural columns, followed by one slack per row
+// (a*x + s = rhs), followed by one artificial per row used in phase 1.
+type simplex struct {
+	m         int           // Number of rows
+	n         int           // Number of structural columns
+	nv        int           // Total number of variables (n + 2m)
+	colIdx    [][]int       // Row indices of the non-zeros of each variable
+	colVal    [][]float64   // Values of the non-zeros of each variable
+	lb, ub    []float64     // Bounds of each variable, infinite bounds as +/-Inf
+	cost      []float64     // Cost of each variable in the current phase
+	rhs       []float64     // Right hand side of each row
+	x         []float64     // Current value of each variable
+	basis     []int         // Variable which is basic in each row position
+	pos       []int         // Position of each variable in the basis, -1 if nonbasic
+	binv      [][]float64   // Explicit inverse of the basis matrix
+	iter      int           // Iterations performed so far
+	iterLimit int           // Maximum number of iterations
+	done      <-chan struct{} // Channel closed to abort the solve, nil if it cannot be aborted
+}
+
+//==============================================================================
+
+// SolveLP solves the LP defined by the gpx input data structures with the Go
+// simplex solver, and populates the solution data structures in the same way
+// as GetSolution. It can be used without any backend, for example to
+// cross-check results obtained from Cplex. Supported values of objSense are
+// 1 (minimize) and -1 (maximize).
+// The returned status is StatOptimal, StatInfeasible, StatUnbounded or
+// StatAbortItLim, and the values of the solution are only populated if it is
+// StatOptimal. In case of invalid input, the function returns an error.
+//	Bounds and infinity follow the conventions of the lpo package and Cplex:
+//	a lower bound at or below -plInfySmall (1.0e10) is treated as minus infinity,
+//	and an upper bound at or above plInfySmall as plus infinity, so both the lpo
+//	default (1.0e10) and CPX_INFBOUND (1.0e20) are recognized.
+//	The slack of each row is reported as rhs - a*x, as Cplex does, including for
+//	ranged rows; duals and reduced costs follow the Cplex sign conventions.
+func SolveLP(objSense int, rows []InputRow, cols []InputCol, elems []InputElem,
+	obj []InputObjCoef, objVal *float64, sRows *[]SolnRow, sCols *[]SolnCol) (SolutionStatus, error) {
+
+	var model memModel     // Model assembled from the input data
+
+	*objVal = 0
+	*sRows  = nil
+	*sCols  = nil
+
+	if objSense != 1 && objSense != -1 {
+		return 0, errors.Errorf("Unexpected objective function sense %d", objSense)
+	}
+
+	for i := 0; i < len(obj); i++ {
+		if obj[i].ColIndex < 0 || obj[i].ColIndex >= len(cols) {
+			return 0, errors.Errorf("Objective coefficient for column %d is out of range", obj[i].ColIndex)
+		}
+	}
+
+	model.sense = objSense
+	model.newRows(rows)
+	model.newCols(obj, cols)
+	if err := model.chgCoefList(elems); err != nil {
+		return 0, err
+	}
+
+	res, err := solveLpModel(&model, nil, nil, 0, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if res.status != StatOptimal {
+		return res.status, nil
+	}
+
+	*objVal = res.objVal
+	*sRows  = make([]SolnRow, len(rows))
+	*sCols  = make([]SolnCol, len(cols))
+
+	for i := range rows {
+		(*sRows)[i] = SolnRow{Name: rows[i].Name, Slack: res.slack[i], Pi: res.pi[i]}
+	}
+
+	for j := range cols {
+		(*sCols)[j] = SolnCol{Name: cols[j].Name, Value: res.x[j], RedCost: res.dj[j]}
+	}
+
+	return res.status, nil
+}
+
+//==============================================================================
+
+// solveLpModel solves the LP relaxation of the model. If lb and ub are not nil,
+// they replace the bounds of the columns (used by branch and bound). An
+// iterLimit of zero selects a limit based on the size of the model. Once the
+// channel done is closed, the solve stops with StatAbortUser. If start is not
+// nil and is a primal feasible basis of the model, phase 2 starts from it;
+// otherwise the solve starts from the slack basis.
+// In case of failure (invalid data or numerical breakdown), it returns an error.
+func solveLpModel(model *memModel, lb, ub []float64, iterLimit int, done <-chan struct{},
+	start *lpBasis) (*lpResult, error) {
+
+	s, err := newSimplex(model, lb, ub)
+	if err != nil {
+		return nil, err
+	}
+
+	if iterLimit <= 0 {
+		iterLimit = 1000 + 50 * (s.m + s.n)
+	}
+	s.iterLimit = iterLimit
+	s.done      = done
+
+	res := &lpResult{}
+	res.status, err = s.solve(model, start)
+	res.iter = s.iter
+	if err != nil || res.status != StatOptimal {
+		return res, err
+	}
+
+	if err = s.fillResult(model, res); err != nil {
+		return res, err
+	}
+	res.final = s
+
+	return res, nil
+}
+
+//==============================================================================
+
+// lpBound converts a bound to the internal representation, in which infinite
+// bounds are stored as +/-Inf.
+func lpBound(value float64) float64 {
+
+	if value >= plInfySmall {
+		return math.Inf(1)
+	}
+
+	if value <= -plInfySmall {
+		return math.Inf(-1)
+	}
+
+	return value
+}
+
+//==============================================================================
+
+// newSimplex builds the working data for the model. Column bounds are taken
+// from lb and ub if given, otherwise from the model.
+func newSimplex(model *memModel, lb, ub []float64) (*simplex, error) {
+
+	m := len(model.rows)
+	n := len(model.cols)
+
+	s := &simplex{m: m, n: n, nv: n + 2 * m}
+	s.colIdx = make([][]int, s.nv)
+	s.colVal = make([][]float64, s.nv)
+	s.lb     = make([]float64, s.nv)
+	s.ub     = make([]float64, s.nv)
+	s.cost   = make([]float64, s.nv)
+	s.rhs    = make([]float64, m)
+	s.x      = make([]float64, s.nv)
+	s.basis  = make([]int, m)
+	s.pos    = make([]int, s.nv)
+
+	for _, e := range model.elems {
+		s.colIdx[e.ColIndex] = append(s.colIdx[e.ColIndex], e.RowIndex)
+		s.colVal[e.ColIndex] = append(s.colVal[e.ColIndex], e.Value)
+	}
+
+	for j := 0; j < n; j++ {
+		if lb != nil {
+			s.lb[j] = lpBound(lb[j])
+			s.ub[j] = lpBound(ub[j])
+		} else {
+			s.lb[j] = lpBound(model.cols[j].BndLo)
+			s.ub[j] = lpBound(model.cols[j].BndUp)
+		}
+		if s.lb[j] > s.ub[j] {
+			return nil, errors.Errorf("Column %d has lower bound %g above upper bound %g",
+				j, model.cols[j].BndLo, model.cols[j].BndUp)
+		}
+	}
+
+	// The slack of each row is a*x + s = rhs, so its bounds depend on the sense.
+	for i := 0; i < m; i++ {
+		row := model.rows[i]
+		j := n + i
+		s.rhs[i]    = row.Rhs
+		s.colIdx[j] = []int{i}
+		s.colVal[j] = []float64{1}
+
+		switch row.Sense {
+		case "L":
+			s.lb[j], s.ub[j] = 0, math.Inf(1)
+		case "G":
+			s.lb[j], s.ub[j] = math.Inf(-1), 0
+		case "E":
+			s.lb[j], s.ub[j] = 0, 0
+		case "R":
+			if row.RngVal >= 0 {
+				s.lb[j], s.ub[j] = -row.RngVal, 0
+			} else {
+				s.lb[j], s.ub[j] = 0, -row.RngVal
+			}
+		default:
+			return nil, errors.Errorf("Row %d has unsupported sense '%s'", i, row.Sense)
+		}
+	}
+
+	return s, nil
+}
+
+//==============================================================================
+
+// solve runs phase 2 from the starting basis if it can be used, and otherwise
+// runs phase 1 to find a feasible basis and phase 2 to optimize it.
+func (s *simplex) solve(model *memModel, start *lpBasis) (SolutionStatus, error) {
+
+	m, n := s.m, s.n
+
+	if start == nil || !s.warmStart(model, start) {
+		status, err := s.coldStart()
+		if err != nil || status != StatOptimal {
+			return status, err
+		}
+	}
+
+	// Artificials are fixed at zero for phase 2. Any still basic are degenerate
+	// and leave the basis as soon as a pivot involves their row.
+	for j := n + m; j < s.nv; j++ {
+		s.cost[j] = 0
+		s.ub[j]   = 0
+		if s.pos[j] < 0 {
+			s.x[j] = 0
+		}
+	}
+
+	for j := 0; j < n; j++ {
+		s.cost[j] = float64(model.sense) * model.obj[j]
+	}
+	for j := n; j < n + m; j++ {
+		s.cost[j] = 0
+	}
+
+	return s.iterate(true)
+}
+
+//==============================================================================
+
+// coldStart runs phase 1 from the slack basis, using artificials for the rows
+// whose slack would be out of bounds. It returns StatOptimal once a feasible
+// basis has been found, and StatInfeasible if none exists.
+func (s *simplex) coldStart() (SolutionStatus, error) {
+
+	m, n := s.m, s.n
+
+	// Nonbasic structural columns start at a finite bound, or at zero if free.
+	for j := 0; j < n; j++ {
+		switch {
+		case !math.IsInf(s.lb[j], 0):
+			s.x[j] = s.lb[j]
+		case !math.IsInf(s.ub[j], 0):
+			s.x[j] = s.ub[j]
+		default:
+			s.x[j] = 0
+		}
+		s.pos[j] = -1
+	}
+
+	// Each row starts with its slack basic if that is feasible, and with an
+	// artificial otherwise. Zero is a bound of every slack, so a slack which is
+	// not basic starts at zero.
+	resid := append([]float64(nil), s.rhs...)
+	for j := 0; j < n; j++ {
+		for k, i := range s.colIdx[j] {
+			resid[i] -= s.colVal[j][k] * s.x[j]
+		}
+	}
+
+	s.binv = make([][]float64, m)
+	needPhase1 := false
+	for i := 0; i < m; i++ {
+		r := resid[i]
+		slk, art := n + i, n + m + i
+		s.binv[i] = make([]float64, m)
+		s.lb[art] = 0
+		s.colIdx[art] = []int{i}
+
+		if r >= s.lb[slk] - lpFeasTol && r <= s.ub[slk] + lpFeasTol {
+			s.colVal[art] = []float64{1}
+			s.ub[art]     = 0
+			s.x[slk]      = r
+			s.x[art]      = 0
+			s.basis[i]    = slk
+			s.pos[slk]    = i
+			s.pos[art]    = -1
+			s.binv[i][i]  = 1
+		} else {
+			sigma := 1.0
+			if r < 0 {
+				sigma = -1
+			}
+			s.colVal[art] = []float64{sigma}
+			s.ub[art]     = math.Inf(1)
+			s.x[slk]      = 0
+			s.x[art]      = math.Abs(r)
+			s.basis[i]    = art
+			s.pos[art]    = i
+			s.pos[slk]    = -1
+			s.binv[i][i]  = sigma
+			needPhase1    = true
+		}
+	}
+
+	// Phase 1 minimizes the sum of the artificials.
+	if needPhase1 {
+		for j := n + m; j < s.nv; j++ {
+			s.cost[j] = 1
+		}
+
+		status, err := s.iterate(false)
+		if err != nil || status == StatAbortItLim || status == StatAbortUser {
+			return status, err
+		}
+
+		infeas := 0.0
+		for j := n + m; j < s.nv; j++ {
+			infeas += s.x[j]
+		}
+		if infeas > lpFeasTol * (1 + s.rhsNorm()) {
+			return StatInfeasible, nil
+		}
+	}
+
+	return StatOptimal, nil
+}
+
+//==============================================================================
+
+// warmStart installs the starting basis, which must have one status per column
+// and row of the model. Nonbasic variables are placed at the bound given by
+// their status, or at their other bound, or at zero, if that bound is
+// infinite. It returns false, leaving the working data to be reset by
+// coldStart, if the basis does not have one basic variable per row, is
+// singular, or is not primal feasible.
+func (s *simplex) warmStart(model *memModel, start *lpBasis) bool {
+
+	m, n := s.m, s.n
+	if len(start.cols) != n || len(start.rows) != m {
+		return false
+	}
+
+	nb := 0
+	place := func(j int, status BasisStatus) {
+		s.pos[j] = -1
+		if status == BasisBasic {
+			if nb < m {
+				s.basis[nb] = j
+				s.pos[j]    = nb
+			}
+			nb++
+			return
+		}
+
+		lo, up := s.lb[j], s.ub[j]
+		if status == BasisAtUpper {
+			lo, up = up, lo
+		}
+		switch {
+		case status != BasisFreeSuper && !math.IsInf(lo, 0):
+			s.x[j] = lo
+		case status != BasisFreeSuper && !math.IsInf(up, 0):
+			s.x[j] = up
+		default:
+			s.x[j] = math.Max(s.lb[j], math.Min(s.ub[j], 0))
+		}
+	}
+
+	for j := 0; j < n; j++ {
+		place(j, start.cols[j])
+	}
+
+	// The slack of a ranged row is at its upper bound when the row activity is
+	// at its lower end (see basisStatus), so the statuses of ranged rows are swapped.
+	for i := 0; i < m; i++ {
+		status := start.rows[i]
+		if model.rows[i].Sense == "R" {
+			switch status {
+			case BasisAtLower:
+				status = BasisAtUpper
+			case BasisAtUpper:
+				status = BasisAtLower
+			}
+		}
+		place(n + i, status)
+
+		art := n + m + i
+		s.colIdx[art] = []int{i}
+		s.colVal[art] = []float64{1}
+		s.lb[art], s.ub[art] = 0, 0
+		s.x[art]   = 0
+		s.pos[art] = -1
+	}
+
+	if nb != m {
+		return false
+	}
+
+	s.binv = make([][]float64, m)
+	for i := range s.binv {
+		s.binv[i] = make([]float64, m)
+	}
+	if err := s.refactor(); err != nil {
+		return false
+	}
+
+	for k := 0; k < m; k++ {
+		j := s.basis[k]
+		if s.x[j] < s.lb[j] - lpFeasTol * (1 + math.Abs(s.lb[j])) ||
+			s.x[j] > s.ub[j] + lpFeasTol * (1 + math.Abs(s.ub[j])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+//==============================================================================
+
+// rhsNorm returns the largest absolute value of the right hand side.
+func (s *simplex) rhsNorm() float64 {
+
+	norm := 0.0
+	for _, r := range s.rhs {
+		norm = math.Max(norm, math.Abs(r))
+	}
+
+	return norm
+}
+
+//==============================================================================
+
+// iterate performs simplex iterations with the current costs until the basis
+// is optimal, the problem is found to be unbounded, the iteration limit is
+// reached, or the solve is aborted. Unboundedness is only reported in phase 2.
+func (s *simplex) iterate(phase2 bool) (SolutionStatus, error) {
+
+	var degenerate int       // Consecutive iterations without progress
+	y := make([]float64, s.m)
+	alpha := make([]float64, s.m)
+
+	for {
+		if s.iter >= s.iterLimit {
+			return StatAbortItLim, nil
+		}
+
+		select {
+		case <-s.done:
+			return StatAbortUser, nil
+		default:
+		}
+
+		if s.iter > 0 && s.iter % lpRefactorFreq == 0 {
+			if err := s.refactor(); err != nil {
+				return 0, err
+			}
+		}
+
+		s.duals(y)
+		bland := degenerate > lpDegenLimit
+
+		// Pricing: choose the entering variable and its direction.
+		q, dir, best := -1, 0.0, 0.0
+		for j := 0; j < s.nv; j++ {
+			if s.pos[j] >= 0 || s.lb[j] == s.ub[j] {
+				continue
+			}
+			d := s.cost[j] - s.dot(y, j)
+			var score, jDir float64
+			if d < -lpOptTol && s.x[j] < s.ub[j] {
+				score, jDir = -d, 1
+			} else if d > lpOptTol && s.x[j] > s.lb[j] {
+				score, jDir = d, -1
+			} else {
+				continue
+			}
+			if q < 0 || (!bland && score > best) {
+				q, dir, best = j, jDir, score
+				if bland {
+					break
+				}
+			}
+		}
+
+		if q < 0 {
+			return StatOptimal, nil
+		}
+
+		// Ratio test along the direction in which the entering variable moves.
+		s.column(q, alpha)
+		leave, step, toUpper := -1, math.Inf(1), false
+		if !math.IsInf(s.lb[q], 0) && !math.IsInf(s.ub[q], 0) {
+			step = s.ub[q] - s.lb[q]
+		}
+
+		for k := 0; k < s.m; k++ {
+			g := -dir * alpha[k]
+			if math.Abs(g) < lpPivotTol {
+				continue
+			}
+			bv := s.basis[k]
+			var t float64
+			var up bool
+			if g < 0 {
+				if math.IsInf(s.lb[bv], 0) {
+					continue
+				}
+				t = math.Max(0, (s.x[bv] - s.lb[bv]) / -g)
+			} else {
+				if math.IsInf(s.ub[bv], 0) {
+					continue
+				}
+				t, up = math.Max(0, (s.ub[bv] - s.x[bv]) / g), true
+			}
+
+			switch {
+			case t < step - 1e-12:
+			case t <= step + 1e-12 && leave >= 0 && !bland && math.Abs(alpha[k]) > math.Abs(alpha[leave]):
+			case t <= step + 1e-12 && leave >= 0 && bland && bv < s.basis[leave]:
+			default:
+				continue
+			}
+			leave, step, toUpper = k, t, up
+		}
+
+		if math.IsInf(step, 1) {
+			if phase2 {
+				return StatUnbounded, nil
+			}
+			return 0, errors.New("Simplex phase 1 found an unbounded direction")
+		}
+
+		// Move along the direction.
+		for k := 0; k < s.m; k++ {
+			s.x[s.basis[k]] -= dir * alpha[k] * step
+		}
+		s.x[q] += dir * step
+		s.iter++
+
+		if step < 1e-12 {
+			degenerate++
+		} else {
+			degenerate = 0
+		}
+
+		if leave < 0 {
+			// The entering variable moved to its opposite bound.
+			if dir > 0 {
+				s.x[q] = s.ub[q]
+			} else {
+				s.x[q] = s.lb[q]
+			}
+			continue
+		}
+
+		out := s.basis[leave]
+		if toUpper {
+			s.x[out] = s.ub[out]
+		} else {
+			s.x[out] = s.lb[out]
+		}
+		s.pivot(leave, q, alpha)
+	}
+}
+
+//==============================================================================
+
+// duals computes y = c_B * inverse(B).
+func (s *simplex) duals(y []float64) {
+
+	for i := range y {
+		y[i] = 0
+	}
+
+	for k := 0; k < s.m; k++ {
+		c := s.cost[s.basis[k]]
+		if c == 0 {
+			continue
+		}
+		for i := 0; i < s.m; i++ {
+			y[i] += c * s.binv[k][i]
+		}
+	}
+}
+
+//==============================================================================
+
+// dot returns the product of the row vector y with column j.
+func (s *simplex) dot(y []float64, j int) float64 {
+
+	sum := 0.0
+	for k, i := range s.colIdx[j] {
+		sum += y[i] * s.colVal[j][k]
+	}
+
+	return sum
+}
+
+//==============================================================================
+
+// column computes alpha = inverse(B) * A_j.
+func (s *simplex) column(j int, alpha []float64) {
+
+	for k := 0; k < s.m; k++ {
+		sum := 0.0
+		for l, i := range s.colIdx[j] {
+			sum += s.binv[k][i] * s.colVal[j][l]
+		}
+		alpha[k] = sum
+	}
+}
+
+//==============================================================================
+
+// pivot replaces the basic variable in position r by variable q, whose
+// column in terms of the current basis is alpha.
+func (s *simplex) pivot(r int, q int, alpha []float64) {
+
+	out := s.basis[r]
+	s.pos[out] = -1
+	s.basis[r] = q
+	s.pos[q]   = r
+
+	piv := alpha[r]
+	rowR := s.binv[r]
+	for i := range rowR {
+		rowR[i] /= piv
+	}
+
+	for k := 0; k < s.m; k++ {
+		if k == r || alpha[k] == 0 {
+			continue
+		}
+		f := alpha[k]
+		rowK := s.binv[k]
+		for i := range rowK {
+			rowK[i] -= f * rowR[i]
+		}
+	}
+}
+
+//==============================================================================
+
+// refactor recomputes the inverse of the basis from scratch, and the values of
+// the basic variables from the nonbasic ones, to limit the build-up of
+// rounding errors. In case the basis is singular, it returns an error.
+func (s *simplex) refactor() error {
+
+	m := s.m
+
+	// Gauss-Jordan elimination with partial pivoting on [B | I].
+	a := make([][]float64, m)
+	for i := 0; i < m; i++ {
+		a[i] = make([]float64, 2 * m)
+		a[i][m + i] = 1
+	}
+	for k := 0; k < m; k++ {
+		j := s.basis[k]
+		for l, i := range s.colIdx[j] {
+			a[i][k] = s.colVal[j][l]
+		}
+	}
+
+	for c := 0; c < m; c++ {
+		p := c
+		for i := c + 1; i < m; i++ {
+			if math.Abs(a[i][c]) > math.Abs(a[p][c]) {
+				p = i
+			}
+		}
+		if math.Abs(a[p][c]) < 1e-12 {
+			return errors.New("Simplex basis is singular")
+		}
+		a[c], a[p] = a[p], a[c]
+
+		piv := a[c][c]
+		for i := range a[c] {
+			a[c][i] /= piv
+		}
+		for i := 0; i < m; i++ {
+			if i == c || a[i][c] == 0 {
+				continue
+			}
+			f := a[i][c]
+			for l := range a[i] {
+				a[i][l] -= f * a[c][l]
+			}
+		}
+	}
+
+	for k := 0; k < m; k++ {
+		copy(s.binv[k], a[k][m:])
+	}
+
+	// Basic values are inverse(B) * (rhs - N * x_N).
+	r := append([]float64(nil), s.rhs...)
+	for j := 0; j < s.nv; j++ {
+		if s.pos[j] >= 0 || s.x[j] == 0 {
+			continue
+		}
+		for l, i := range s.colIdx[j] {
+			r[i] -= s.colVal[j][l] * s.x[j]
+		}
+	}
+	for k := 0; k < m; k++ {
+		sum := 0.0
+		for i := 0; i < m; i++ {
+			sum += s.binv[k][i] * r[i]
+		}
+		s.x[s.basis[k]] = sum
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// basisStatus returns the status of each column and row in the current basis, using
+// the Cplex conventions. The slack of a row is reported as basic if either the
+// slack or the artificial of the row is basic, since both have the same column.
+// The slack of a ranged row is at its upper bound (0) when the row activity is
+// at its lower end, which Cplex reports as BasisAtLower, and conversely.
+func (s *simplex) basisStatus(model *memModel) *lpBasis {
+
+	b := &lpBasis{cols: make([]BasisStatus, s.n), rows: make([]BasisStatus, s.m)}
+
+	status := func(j int) BasisStatus {
+		if s.pos[j] >= 0 {
+			return BasisBasic
+		}
+		switch s.atBound(j) {
+		case 1:
+			return BasisAtLower
+		case -1:
+			return BasisAtUpper
+		}
+		return BasisFreeSuper
+	}
+
+	for j := 0; j < s.n; j++ {
+		b.cols[j] = status(j)
+	}
+
+	for i := 0; i < s.m; i++ {
+		slk, art := s.n + i, s.n + s.m + i
+		switch {
+		case s.pos[slk] >= 0 || s.pos[art] >= 0:
+			b.rows[i] = BasisBasic
+		case model.rows[i].Sense != "R" || s.lb[slk] == s.ub[slk]:
+			b.rows[i] = BasisAtLower
+		case s.atBound(slk) == -1:
+			b.rows[i] = BasisAtLower
+		default:
+			b.rows[i] = BasisAtUpper
+		}
+	}
+
+	return b
+}
+
+//==============================================================================
+
+// fillResult computes the solution in terms of the original objective from
+// the optimal basis.
+// In case the final refactorization finds the basis singular, it returns an
+// error rather than values computed from the updated inverse.
+func (s *simplex) fillResult(model *memModel, res *lpResult) error {
+
+	m, n := s.m, s.n
+
+	// A final refactorization gives the most accurate values available.
+	if s.iter > 0 {
+		if err := s.refactor(); err != nil {
+			return errors.Wrap(err, "Simplex failed to refactor the optimal basis")
+		}
+	}
+
+	y := make([]float64, m)
+	s.duals(y)
+
+	sense := float64(model.sense)
+	res.x     = make([]float64, n)
+	res.dj    = make([]float64, n)
+	res.pi    = make([]float64, m)
+	res.slack = make([]float64, m)
+
+	for i := 0; i < m; i++ {
+		res.pi[i] = sense * y[i]
+	}
+
+	res.objVal = 0
+	for j := 0; j < n; j++ {
+		res.x[j] = s.x[j]
+		res.dj[j] = model.obj[j] - s.dot(res.pi, j)
+		res.objVal += model.obj[j] * s.x[j]
+	}
+
+	// Slacks are computed from the column values, as rhs - a*x.
+	for i := 0; i < m; i++ {
+		res.slack[i] = s.rhs[i]
+	}
+	for j := 0; j < n; j++ {
+		for l, i := range s.colIdx[j] {
+			res.slack[i] -= s.colVal[j][l] * s.x[j]
+		}
+	}
+
+	return nil
+}
+
+//==============================================================================
+// SENSITIVITY ANALYSIS OF THE OPTIMAL BASIS
+//==============================================================================
+
+// objRanges computes, for each structural column, the range of its objective
+// coefficient over which the basis remains optimal, as CPXobjsa does. The
+// reduced costs of the nonbasic variables must keep their sign when the cost
+// of a basic column changes; the cost of a nonbasic column may change until
+// its own reduced cost changes sign. Infinite ends are reported as
+// -/+plInfyLarge (CPX_INFBOUND).
+func (s *simplex) objRanges(model *memModel, lower, upper []float64) {
+
+	y := make([]float64, s.m)
+	s.duals(y)
+	sense := float64(model.sense)
+
+	for j := 0; j < s.n; j++ {
+		lo, hi := math.Inf(-1), math.Inf(1)
+
+		if r := s.pos[j]; r < 0 {
+			// Nonbasic: only its own reduced cost is affected, and the cost of
+			// a fixed column does not matter.
+			if s.lb[j] == s.ub[j] {
+				lower[j], upper[j] = -plInfyLarge, plInfyLarge
+				continue
+			}
+			d := s.cost[j] - s.dot(y, j)
+			switch s.atBound(j) {
+			case 1:
+				lo = -math.Max(d, 0)
+			case -1:
+				hi = -math.Min(d, 0)
+			case 0:
+				lo, hi = -d, -d
+			}
+		} else {
+			// Basic: the reduced cost of each nonbasic k changes by -delta * a_k,
+			// where a_k is the entry of row r of inverse(B) * A_k.
+			for k := 0; k < s.nv; k++ {
+				if s.pos[k] >= 0 || s.lb[k] == s.ub[k] {
+					continue
+				}
+				a := s.dot(s.binv[r], k)
+				if math.Abs(a) < lpPivotTol {
+					continue
+				}
+				d := s.cost[k] - s.dot(y, k)
+				switch s.atBound(k) {
+				case 1:
+					d = math.Max(d, 0)
+				case -1:
+					d = math.Min(d, 0)
+				}
+				t := d / a
+				atLower, atUpper := s.atBound(k) >= 0, s.atBound(k) <= 0
+				if (atLower && a > 0) || (atUpper && a < 0) {
+					hi = math.Min(hi, t)
+				}
+				if (atLower && a < 0) || (atUpper && a > 0) {
+					lo = math.Max(lo, t)
+				}
+			}
+		}
+
+		// The ranges computed on the internal costs are converted to the
+		// original sense of the objective.
+		if sense < 0 {
+			lo, hi = -hi, -lo
+		}
+		lower[j] = lpRangeEnd(model.obj[j] + lo)
+		upper[j] = lpRangeEnd(model.obj[j] + hi)
+	}
+}
+
+//==============================================================================
+
+// rhsRanges computes, for each row, the range of its right hand side over which
+// the basis remains primal feasible, as CPXrhssa does. Infinite ends are
+// reported as -/+plInfyLarge (CPX_INFBOUND).
+func (s *simplex) rhsRanges(lower, upper []float64) {
+
+	b := make([]float64, s.m)
+	for i := 0; i < s.m; i++ {
+		// A change of the rhs moves the basic variables along column i of
+		// inverse(B).
+		for k := 0; k < s.m; k++ {
+			b[k] = s.binv[k][i]
+		}
+		lo, hi := s.feasRange(b)
+		lower[i] = lpRangeEnd(s.rhs[i] + lo)
+		upper[i] = lpRangeEnd(s.rhs[i] + hi)
+	}
+}
+
+//==============================================================================
+
+// boundRanges computes, for each structural column, the ranges of its lower and
+// upper bounds over which the basis remains primal feasible, as CPXboundsa
+// does. The bound at which a nonbasic column sits moves the column and hence
+// the basic variables, and may not cross the other bound; any other bound may
+// move freely as long as it does not cut off the value of the column.
+// Infinite ends are reported as -/+plInfyLarge (CPX_INFBOUND).
+func (s *simplex) boundRanges(lbLower, lbUpper, ubLower, ubUpper []float64) {
+
+	alpha := make([]float64, s.m)
+	for j := 0; j < s.n; j++ {
+		lbLower[j], lbUpper[j] = lpRangeEnd(math.Inf(-1)), s.x[j]
+		ubLower[j], ubUpper[j] = s.x[j], lpRangeEnd(math.Inf(1))
+
+		at := s.atBound(j)
+		if s.pos[j] >= 0 || at == 0 {
+			continue
+		}
+
+		// Moving the column by delta moves the basic variables by
+		// -delta * inverse(B) * A_j.
+		s.column(j, alpha)
+		for k := range alpha {
+			alpha[k] = -alpha[k]
+		}
+		lo, hi := s.feasRange(alpha)
+
+		if at > 0 {
+			hi = math.Min(hi, s.ub[j] - s.lb[j])
+			lbLower[j] = lpRangeEnd(s.lb[j] + lo)
+			lbUpper[j] = lpRangeEnd(s.lb[j] + hi)
+		} else {
+			lo = math.Max(lo, s.lb[j] - s.ub[j])
+			ubLower[j] = lpRangeEnd(s.ub[j] + lo)
+			ubUpper[j] = lpRangeEnd(s.ub[j] + hi)
+		}
+	}
+}
+
+//==============================================================================
+
+// atBound returns 1 if the nonbasic variable j is at its lower bound, -1 if it
+// is at its upper bound, and 0 if it is free. A fixed variable is reported as
+// being at its lower bound.
+func (s *simplex) atBound(j int) int {
+
+	switch {
+	case !math.IsInf(s.lb[j], 0) && s.x[j] == s.lb[j]:
+		return 1
+	case !math.IsInf(s.ub[j], 0) && s.x[j] == s.ub[j]:
+		return -1
+	}
+
+	return 0
+}
+
+//==============================================================================
+
+// feasRange returns the range [lo, hi] of delta for which the basic variables,
+// moved by delta * b, stay within their bounds. The range always contains zero.
+func (s *simplex) feasRange(b []float64) (float64, float64) {
+
+	lo, hi := math.Inf(-1), math.Inf(1)
+	for k := 0; k < s.m; k++ {
+		if math.Abs(b[k]) < lpPivotTol {
+			continue
+		}
+		bv := s.basis[k]
+		tLb := (s.lb[bv] - s.x[bv]) / b[k]
+		tUb := (s.ub[bv] - s.x[bv]) / b[k]
+		if b[k] < 0 {
+			tLb, tUb = tUb, tLb
+		}
+		lo = math.Max(lo, tLb)
+		hi = math.Min(hi, tUb)
+	}
+
+	return math.Min(lo, 0), math.Max(hi, 0)
+}
+
+//==============================================================================
+
+// lpRangeEnd converts an end of a range to the external representation, in
+// which infinite values are reported as -/+plInfyLarge.
+func lpRangeEnd(value float64) float64 {
+
+	if value >= plInfyLarge {
+		return plInfyLarge
+	}
+
+	if value <= -plInfyLarge {
+		return -plInfyLarge
+	}
+
+	return value
+}
+
+//============================ END OF FILE =====================================
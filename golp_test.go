@@ -0,0 +1,510 @@
+package gpx
+
+import (
+	"math"
+	"testing"
+)
+
+// lpTestTol is the tolerance used to compare the values computed by the Go
+// simplex solver with the expected ones.
+const lpTestTol = 1e-9
+
+// lpCase defines an LP with its known solution. The expected values are only
+// checked if the status is StatOptimal.
+type lpCase struct {
+	name    string
+	sense   int
+	rows    []InputRow
+	cols    []InputCol
+	elems   []InputElem
+	obj     []InputObjCoef
+	status  SolutionStatus
+	objVal  float64
+	x       []float64
+	dj      []float64
+	pi      []float64
+	slack   []float64
+}
+
+// lpCases holds small LPs covering each row sense, free and infinite bounds,
+// and the infeasible and unbounded outcomes. Duals and reduced costs follow the
+// Cplex conventions: dj = c - A'pi, in the original sense of the objective.
+var lpCases = []lpCase{
+	{
+		// max 3x + 2y  st  x + y <= 4, x + 3y <= 7, 0 <= x <= 3, y >= 0
+		name:  "L rows",
+		sense: -1,
+		rows:  []InputRow{{"c1", "L", 4, 0}, {"c2", "L", 7, 0}},
+		cols:  []InputCol{{"x", "C", 0, 3}, {"y", "C", 0, 1e20}},
+		elems: []InputElem{{0, 0, 1}, {0, 1, 1}, {1, 0, 1}, {1, 1, 3}},
+		obj:   []InputObjCoef{{0, 3}, {1, 2}},
+		status: StatOptimal, objVal: 11,
+		x: []float64{3, 1}, dj: []float64{1, 0}, pi: []float64{2, 0}, slack: []float64{0, 1},
+	},
+	{
+		// min 2x + 3y  st  x + y >= 2, x + 2y >= 3, x, y >= 0
+		name:  "G rows",
+		sense: 1,
+		rows:  []InputRow{{"c1", "G", 2, 0}, {"c2", "G", 3, 0}},
+		cols:  []InputCol{{"x", "C", 0, 1e20}, {"y", "C", 0, 1e20}},
+		elems: []InputElem{{0, 0, 1}, {0, 1, 1}, {1, 0, 1}, {1, 1, 2}},
+		obj:   []InputObjCoef{{0, 2}, {1, 3}},
+		status: StatOptimal, objVal: 5,
+		x: []float64{1, 1}, dj: []float64{0, 0}, pi: []float64{1, 1}, slack: []float64{0, 0},
+	},
+	{
+		// min x + y  st  x - y = 1, x + y >= 3, x free, 0 <= y <= 10
+		name:  "E row and free column",
+		sense: 1,
+		rows:  []InputRow{{"e", "E", 1, 0}, {"g", "G", 3, 0}},
+		cols:  []InputCol{{"x", "C", -1e20, 1e20}, {"y", "C", 0, 10}},
+		elems: []InputElem{{0, 0, 1}, {0, 1, -1}, {1, 0, 1}, {1, 1, 1}},
+		obj:   []InputObjCoef{{0, 1}, {1, 1}},
+		status: StatOptimal, objVal: 3,
+		x: []float64{2, 1}, dj: []float64{0, 0}, pi: []float64{0, 1}, slack: []float64{0, 0},
+	},
+	{
+		// max x + y  st  1 <= x + 2y <= 4, 0 <= x <= 2, y >= 0
+		name:  "R row at upper end",
+		sense: -1,
+		rows:  []InputRow{{"r", "R", 1, 3}},
+		cols:  []InputCol{{"x", "C", 0, 2}, {"y", "C", 0, 1e20}},
+		elems: []InputElem{{0, 0, 1}, {0, 1, 2}},
+		obj:   []InputObjCoef{{0, 1}, {1, 1}},
+		status: StatOptimal, objVal: 3,
+		x: []float64{2, 1}, dj: []float64{0.5, 0}, pi: []float64{0.5}, slack: []float64{-3},
+	},
+	{
+		// min x + y  st  1 <= x + 2y <= 4, 0 <= x, y <= 2
+		name:  "R row at lower end",
+		sense: 1,
+		rows:  []InputRow{{"r", "R", 1, 3}},
+		cols:  []InputCol{{"x", "C", 0, 2}, {"y", "C", 0, 2}},
+		elems: []InputElem{{0, 0, 1}, {0, 1, 2}},
+		obj:   []InputObjCoef{{0, 1}, {1, 1}},
+		status: StatOptimal, objVal: 0.5,
+		x: []float64{0, 0.5}, dj: []float64{0.5, 0}, pi: []float64{0.5}, slack: []float64{0},
+	},
+	{
+		// min -x - 2y  st  x + y <= 5, x - y <= 1, x free (lpo infinity), y <= 3
+		name:  "infinite bounds",
+		sense: 1,
+		rows:  []InputRow{{"c1", "L", 5, 0}, {"c2", "L", 1, 0}},
+		cols:  []InputCol{{"x", "C", -1e10, 1e10}, {"y", "C", -1e20, 3}},
+		elems: []InputElem{{0, 0, 1}, {0, 1, 1}, {1, 0, 1}, {1, 1, -1}},
+		obj:   []InputObjCoef{{0, -1}, {1, -2}},
+		status: StatOptimal, objVal: -8,
+		x: []float64{2, 3}, dj: []float64{0, -1}, pi: []float64{-1, 0}, slack: []float64{0, 2},
+	},
+	{
+		// min 0  st  x >= 5, 0 <= x <= 3
+		name:  "infeasible bound",
+		sense: 1,
+		rows:  []InputRow{{"c1", "G", 5, 0}},
+		cols:  []InputCol{{"x", "C", 0, 3}},
+		elems: []InputElem{{0, 0, 1}},
+		status: StatInfeasible,
+	},
+	{
+		// min x  st  x + y = 1, x + y = 2
+		name:  "infeasible rows",
+		sense: 1,
+		rows:  []InputRow{{"e1", "E", 1, 0}, {"e2", "E", 2, 0}},
+		cols:  []InputCol{{"x", "C", 0, 1e20}, {"y", "C", 0, 1e20}},
+		elems: []InputElem{{0, 0, 1}, {0, 1, 1}, {1, 0, 1}, {1, 1, 1}},
+		obj:   []InputObjCoef{{0, 1}},
+		status: StatInfeasible,
+	},
+	{
+		// min -x  st  x - y <= 1, x, y >= 0
+		name:  "unbounded",
+		sense: 1,
+		rows:  []InputRow{{"c1", "L", 1, 0}},
+		cols:  []InputCol{{"x", "C", 0, 1e20}, {"y", "C", 0, 1e10}},
+		elems: []InputElem{{0, 0, 1}, {0, 1, -1}},
+		obj:   []InputObjCoef{{0, -1}},
+		status: StatUnbounded,
+	},
+	{
+		// Beale's example, which cycles under the textbook pivoting rules:
+		// min -3/4 x4 + 150 x5 - 1/50 x6 + 6 x7
+		// st   1/4 x4 - 60 x5 - 1/25 x6 + 9 x7 <= 0
+		//      1/2 x4 - 90 x5 - 1/50 x6 + 3 x7 <= 0
+		//                             x6       <= 1
+		name:  "degenerate",
+		sense: 1,
+		rows:  []InputRow{{"c1", "L", 0, 0}, {"c2", "L", 0, 0}, {"c3", "L", 1, 0}},
+		cols:  []InputCol{{"x4", "C", 0, 1e20}, {"x5", "C", 0, 1e20}, {"x6", "C", 0, 1e20}, {"x7", "C", 0, 1e20}},
+		elems: []InputElem{
+			{0, 0, 0.25}, {0, 1, -60}, {0, 2, -0.04}, {0, 3, 9},
+			{1, 0, 0.5}, {1, 1, -90}, {1, 2, -0.02}, {1, 3, 3},
+			{2, 2, 1},
+		},
+		obj:   []InputObjCoef{{0, -0.75}, {1, 150}, {2, -0.02}, {3, 6}},
+		status: StatOptimal, objVal: -0.05,
+		x: []float64{0.04, 0, 1, 0}, dj: []float64{0, 15, 0, 10.5},
+		pi: []float64{0, -1.5, -0.05}, slack: []float64{0.03, 0, 0},
+	},
+}
+
+//==============================================================================
+
+func TestSolveLP(t *testing.T) {
+
+	for _, tc := range lpCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			var objVal float64
+			var sRows []SolnRow
+			var sCols []SolnCol
+
+			status, err := SolveLP(tc.sense, tc.rows, tc.cols, tc.elems, tc.obj, &objVal, &sRows, &sCols)
+			if err != nil {
+				t.Fatalf("SolveLP failed: %v", err)
+			}
+			if status != tc.status {
+				t.Fatalf("status = %s, want %s", status, tc.status)
+			}
+			if status != StatOptimal {
+				if sRows != nil || sCols != nil {
+					t.Errorf("solution populated for status %s", status)
+				}
+				return
+			}
+
+			checkLpSolution(t, tc, objVal, sRows, sCols)
+			checkKKT(t, tc, objVal, sRows, sCols)
+		})
+	}
+}
+
+//==============================================================================
+
+func TestSolveLPInvalid(t *testing.T) {
+
+	rows  := []InputRow{{"c1", "L", 4, 0}}
+	cols  := []InputCol{{"x", "C", 0, 3}}
+	elems := []InputElem{{0, 0, 1}}
+
+	tests := []struct {
+		name   string
+		sense  int
+		rows   []InputRow
+		cols   []InputCol
+		elems  []InputElem
+		obj    []InputObjCoef
+	}{
+		{"sense", 0, rows, cols, elems, nil},
+		{"objective column", 1, rows, cols, elems, []InputObjCoef{{1, 1}}},
+		{"coefficient column", 1, rows, cols, []InputElem{{0, 1, 1}}, nil},
+		{"coefficient row", 1, rows, cols, []InputElem{{1, 0, 1}}, nil},
+		{"row sense", 1, []InputRow{{"c1", "X", 4, 0}}, cols, elems, nil},
+		{"bounds", 1, rows, []InputCol{{"x", "C", 3, 0}}, elems, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			var objVal float64
+			var sRows []SolnRow
+			var sCols []SolnCol
+
+			if _, err := SolveLP(tt.sense, tt.rows, tt.cols, tt.elems, tt.obj, &objVal, &sRows, &sCols); err == nil {
+				t.Error("SolveLP succeeded, want error")
+			}
+		})
+	}
+}
+
+//==============================================================================
+
+func TestSolveLPRefactor(t *testing.T) {
+
+	tc := transportCase(12)
+
+	model := lpTestModel(tc)
+	res, err := solveLpModel(model, nil, nil, 0, nil, nil)
+	if err != nil {
+		t.Fatalf("solveLpModel failed: %v", err)
+	}
+	if res.status != StatOptimal {
+		t.Fatalf("status = %s, want %s", res.status, StatOptimal)
+	}
+	if res.iter <= lpRefactorFreq {
+		t.Fatalf("solve took %d iterations, want more than %d to refactor the basis", res.iter, lpRefactorFreq)
+	}
+
+	sRows, sCols := lpTestSolution(res)
+	checkKKT(t, tc, res.objVal, sRows, sCols)
+}
+
+//==============================================================================
+
+// TestFillResultSingular checks that a basis which the final refactorization
+// finds singular gives an error rather than a solution.
+func TestFillResultSingular(t *testing.T) {
+
+	model := &memModel{sense: 1}
+	model.newRows([]InputRow{{"c1", "L", 4, 0}, {"c2", "L", 6, 0}})
+	model.newCols(nil, []InputCol{{"x", "C", 0, 10}, {"y", "C", 0, 10}})
+	if err := model.chgCoefList([]InputElem{{0, 0, 1}, {1, 0, 2}, {0, 1, 2}, {1, 1, 4}}); err != nil {
+		t.Fatalf("chgCoefList failed: %v", err)
+	}
+
+	s, err := newSimplex(model, nil, nil)
+	if err != nil {
+		t.Fatalf("newSimplex failed: %v", err)
+	}
+
+	// The columns of x and y are parallel.
+	s.basis = []int{0, 1}
+	s.iter  = 1
+
+	if err = s.fillResult(model, &lpResult{}); err == nil {
+		t.Error("fillResult succeeded with a singular basis, want error")
+	}
+}
+
+//==============================================================================
+
+func TestSolveLPIterLimit(t *testing.T) {
+
+	model := lpTestModel(transportCase(12))
+
+	res, err := solveLpModel(model, nil, nil, 5, nil, nil)
+	if err != nil {
+		t.Fatalf("solveLpModel failed: %v", err)
+	}
+	if res.status != StatAbortItLim || res.iter != 5 {
+		t.Errorf("status = %s after %d iterations, want %s after 5", res.status, res.iter, StatAbortItLim)
+	}
+}
+
+//==============================================================================
+
+func TestSolveLPWarmStart(t *testing.T) {
+
+	tc := transportCase(6)
+	model := lpTestModel(tc)
+
+	cold, err := solveLpModel(model, nil, nil, 0, nil, nil)
+	if err != nil || cold.status != StatOptimal {
+		t.Fatalf("cold solve returned %v, %v", cold, err)
+	}
+	start := cold.final.basisStatus(model)
+
+	// Restarting from the optimal basis needs no iteration.
+	warm, err := solveLpModel(model, nil, nil, 0, nil, start)
+	if err != nil || warm.status != StatOptimal {
+		t.Fatalf("warm solve returned %v, %v", warm, err)
+	}
+	if warm.iter != 0 || math.Abs(warm.objVal - cold.objVal) > lpTestTol {
+		t.Errorf("warm solve took %d iterations to reach %g, want 0 and %g", warm.iter, warm.objVal, cold.objVal)
+	}
+
+	// After a change of the costs, the old basis is still primal feasible and
+	// phase 2 starts from it.
+	tc.obj[0].Value += 5
+	tc.obj[7].Value -= 3
+	model = lpTestModel(tc)
+
+	again, err := solveLpModel(model, nil, nil, 0, nil, nil)
+	if err != nil || again.status != StatOptimal {
+		t.Fatalf("cold solve returned %v, %v", again, err)
+	}
+
+	warm, err = solveLpModel(model, nil, nil, 0, nil, start)
+	if err != nil || warm.status != StatOptimal {
+		t.Fatalf("warm solve returned %v, %v", warm, err)
+	}
+	if warm.iter >= again.iter || math.Abs(warm.objVal - again.objVal) > lpTestTol {
+		t.Errorf("warm solve took %d iterations to reach %g, want less than %d and %g",
+			warm.iter, warm.objVal, again.iter, again.objVal)
+	}
+	sRows, sCols := lpTestSolution(warm)
+	checkKKT(t, tc, warm.objVal, sRows, sCols)
+
+	// A basis of the wrong size or with too many basic variables is ignored.
+	bad := []*lpBasis{
+		{cols: start.cols[1:], rows: start.rows},
+		{cols: make([]BasisStatus, len(start.cols)), rows: make([]BasisStatus, len(start.rows))},
+	}
+	for i := range bad[1].cols {
+		bad[1].cols[i] = BasisBasic
+	}
+	for i, b := range bad {
+		res, err := solveLpModel(model, nil, nil, 0, nil, b)
+		if err != nil || res.status != StatOptimal || math.Abs(res.objVal - again.objVal) > lpTestTol {
+			t.Errorf("solve from bad basis %d returned %v, %v, want objective %g", i, res, err, again.objVal)
+		}
+	}
+}
+
+//==============================================================================
+
+// transportCase returns a transportation problem with n sources and n
+// destinations, whose optimal solution requires many degenerate pivots. Each
+// source supplies n+1 units and each destination demands n units.
+func transportCase(n int) lpCase {
+
+	tc := lpCase{name: "transport", sense: 1, status: StatOptimal}
+
+	for i := 0; i < n; i++ {
+		tc.rows = append(tc.rows, InputRow{"supply", "L", float64(n + 1), 0})
+	}
+	for j := 0; j < n; j++ {
+		tc.rows = append(tc.rows, InputRow{"demand", "G", float64(n), 0})
+	}
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			k := i * n + j
+			tc.cols  = append(tc.cols, InputCol{"ship", "C", 0, 1e20})
+			tc.obj   = append(tc.obj, InputObjCoef{k, float64((i * 7 + j * 3) % 10 + 1)})
+			tc.elems = append(tc.elems, InputElem{i, k, 1}, InputElem{n + j, k, 1})
+		}
+	}
+
+	return tc
+}
+
+//==============================================================================
+
+// lpTestModel assembles the model of an LP case as SolveLP does.
+func lpTestModel(tc lpCase) *memModel {
+
+	model := &memModel{sense: tc.sense}
+	model.newRows(tc.rows)
+	model.newCols(tc.obj, tc.cols)
+	model.chgCoefList(tc.elems)
+
+	return model
+}
+
+//==============================================================================
+
+// lpTestSolution returns the solution of a result in the form of SolveLP.
+func lpTestSolution(res *lpResult) ([]SolnRow, []SolnCol) {
+
+	sRows := make([]SolnRow, len(res.pi))
+	for i := range sRows {
+		sRows[i] = SolnRow{Pi: res.pi[i], Slack: res.slack[i]}
+	}
+
+	sCols := make([]SolnCol, len(res.x))
+	for j := range sCols {
+		sCols[j] = SolnCol{Value: res.x[j], RedCost: res.dj[j]}
+	}
+
+	return sRows, sCols
+}
+
+//==============================================================================
+
+// checkLpSolution compares the solution with the values expected by the case.
+func checkLpSolution(t *testing.T, tc lpCase, objVal float64, sRows []SolnRow, sCols []SolnCol) {
+
+	t.Helper()
+
+	if math.Abs(objVal - tc.objVal) > lpTestTol {
+		t.Errorf("objVal = %g, want %g", objVal, tc.objVal)
+	}
+
+	for j, c := range sCols {
+		if c.Name != tc.cols[j].Name {
+			t.Errorf("column %d name = %q, want %q", j, c.Name, tc.cols[j].Name)
+		}
+		if math.Abs(c.Value - tc.x[j]) > lpTestTol || math.Abs(c.RedCost - tc.dj[j]) > lpTestTol {
+			t.Errorf("column %s = %g, dj %g, want %g, dj %g", c.Name, c.Value, c.RedCost, tc.x[j], tc.dj[j])
+		}
+	}
+
+	for i, r := range sRows {
+		if r.Name != tc.rows[i].Name {
+			t.Errorf("row %d name = %q, want %q", i, r.Name, tc.rows[i].Name)
+		}
+		if math.Abs(r.Pi - tc.pi[i]) > lpTestTol || math.Abs(r.Slack - tc.slack[i]) > lpTestTol {
+			t.Errorf("row %s = pi %g, slack %g, want pi %g, slack %g", r.Name, r.Pi, r.Slack, tc.pi[i], tc.slack[i])
+		}
+	}
+}
+
+//==============================================================================
+
+// checkKKT verifies that the solution is optimal: it must be primal feasible,
+// its reduced costs and slacks must be consistent with the duals and the
+// objective value, and the reduced costs and duals must have the sign which
+// the bound reached by each column and row allows.
+func checkKKT(t *testing.T, tc lpCase, objVal float64, sRows []SolnRow, sCols []SolnCol) {
+
+	t.Helper()
+
+	const tol = 1e-6
+	sense := float64(tc.sense)
+
+	obj := make([]float64, len(tc.cols))
+	for _, o := range tc.obj {
+		obj[o.ColIndex] = o.Value
+	}
+
+	ax  := make([]float64, len(tc.rows))
+	aPi := make([]float64, len(tc.cols))
+	for _, e := range tc.elems {
+		ax[e.RowIndex]  += e.Value * sCols[e.ColIndex].Value
+		aPi[e.ColIndex] += e.Value * sRows[e.RowIndex].Pi
+	}
+
+	// check verifies that the value lies within its bounds, and that its
+	// reduced cost d (in the sense of minimization) allows it to stay there.
+	check := func(what string, value, lo, up, d float64) {
+		switch {
+		case value < lo - tol || value > up + tol:
+			t.Errorf("%s = %g lies outside [%g, %g]", what, value, lo, up)
+		case value <= lo + tol && value >= up - tol:
+		case value <= lo + tol && d < -tol:
+			t.Errorf("%s at its lower bound has reduced cost %g", what, d)
+		case value >= up - tol && d > tol:
+			t.Errorf("%s at its upper bound has reduced cost %g", what, d)
+		case value > lo + tol && value < up - tol && math.Abs(d) > tol:
+			t.Errorf("%s between its bounds has reduced cost %g", what, d)
+		}
+	}
+
+	total := 0.0
+	for j, c := range sCols {
+		total += obj[j] * c.Value
+		if math.Abs(c.RedCost - (obj[j] - aPi[j])) > tol {
+			t.Errorf("column %d reduced cost = %g, want %g", j, c.RedCost, obj[j] - aPi[j])
+		}
+		check("column", c.Value, lpBound(tc.cols[j].BndLo), lpBound(tc.cols[j].BndUp), sense * c.RedCost)
+	}
+
+	if math.Abs(total - objVal) > tol * (1 + math.Abs(total)) {
+		t.Errorf("objVal = %g, want c'x = %g", objVal, total)
+	}
+
+	for i, r := range sRows {
+		slack := tc.rows[i].Rhs - ax[i]
+		if math.Abs(slack - r.Slack) > tol {
+			t.Errorf("row %d slack = %g, want %g", i, r.Slack, slack)
+		}
+
+		// The slack s = rhs - a*x has the reduced cost -pi.
+		lo, up := 0.0, 0.0
+		switch tc.rows[i].Sense {
+		case "L":
+			up = math.Inf(1)
+		case "G":
+			lo = math.Inf(-1)
+		case "R":
+			if tc.rows[i].RngVal >= 0 {
+				lo = -tc.rows[i].RngVal
+			} else {
+				up = -tc.rows[i].RngVal
+			}
+		}
+		check("slack", slack, lo, up, -sense * r.Pi)
+	}
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,570 @@
+// 01   Oct. 16, 2026   Initial version, pure Go branch and bound solver for MIPs
+// 02   Oct. 16, 2026   Status reported as SolutionStatus
+// 03   Oct. 16, 2026   Added time limit and integrality tolerance options
+// 04   Oct. 16, 2026   Search can be aborted through a channel
+// 05   Oct. 16, 2026   Progress reported after each node
+// 06   Oct. 16, 2026   Complete and feasible MIP starts give the first incumbent
+// 07   Oct. 16, 2026   Integer solutions kept in a solution pool, which populate mode fills
+// 08   Oct. 16, 2026   Node selection moved to mipSelect
+// 09   Oct. 16, 2026   A node LP at the iteration limit stops the search with MipFailFeas or MipFailInfeas
+
+package gpx
+
+import (
+	"github.com/pkg/errors"
+	"math"
+	"time"
+)
+
+// Tolerances used by the Go branch and bound solver.
+const (
+	mipDefIntTol     = 1e-5   // Default integrality tolerance (default of CPX_PARAM_EPINT)
+	mipDefRelGap     = 1e-4   // Default relative gap (default of CPX_PARAM_EPGAP)
+	mipDefAbsGap     = 1e-6   // Default absolute gap (default of CPX_PARAM_EPAGAP)
+	mipStartTol      = 1e-6   // Feasibility tolerance of MIP starts (default of CPX_PARAM_EPRHS)
+)
+
+// mipOptions holds the limits and tolerances of a branch and bound search.
+type mipOptions struct {
+	nodeLimit  int            // Maximum number of nodes, 0 for no limit
+	relGap     float64        // Relative gap at which the search stops
+	absGap     float64        // Absolute gap at which the search stops
+	iterLimit  int            // Simplex iteration limit per node LP
+	intTol     float64        // Integrality tolerance
+	timeLimit  float64        // Time limit in seconds, 0 for no limit
+	done       <-chan struct{} // Channel closed to abort the search, nil if it cannot be aborted
+	progress   func(Progress) // Receives the state of the search before each node, nil if none
+	populate   bool           // Flag set to search for further solutions, as CPXpopulate does
+	popLimit   int            // Solutions added to the pool at which populate stops, 0 for no limit
+	poolCap    int            // Maximum number of solutions in the pool
+	poolRepl   int            // Replacement strategy of a full pool, see PoolReplace*
+	poolRelGap float64        // Relative gap of the pool solutions to the incumbent
+	poolAbsGap float64        // Absolute gap of the pool solutions to the incumbent
+}
+
+// mipNode is a node of the branch and bound tree, defined by the column bounds
+// which apply in it.
+type mipNode struct {
+	lb, ub     []float64      // Column bounds of the node
+	bound      float64        // Objective of the parent LP, in minimization form
+}
+
+// mipResult holds the outcome of a branch and bound search. All objective
+// values refer to the objective in its original sense.
+type mipResult struct {
+	status     SolutionStatus // Status of the outcome
+	objVal     float64        // Objective function value of the incumbent
+	x          []float64      // Column values of the incumbent, nil if none exists
+	slack      []float64      // Row slacks of the incumbent, rhs - a*x
+	bestBound  float64        // Best bound on the optimal objective value
+	nodes      int            // Nodes processed
+	iter       int            // Simplex iterations performed
+	pool       []mipPoolSoln  // Solutions in the pool, oldest first
+}
+
+// mipPoolSoln is an integer solution kept in the solution pool.
+type mipPoolSoln struct {
+	objVal     float64        // Objective function value, in its original sense
+	x          []float64      // Column values
+	slack      []float64      // Row slacks, rhs - a*x
+}
+
+//==============================================================================
+
+// solveMipModel solves the model by LP-based branch and bound. Binary columns
+// are bounded by 0 and 1, and integer columns are branched on whenever their
+// value in the LP relaxation is fractional. Nodes are explored depth first
+// until a first integer solution is found, and in order of best bound after
+// that. The search stops when the tree is exhausted, when the gap between the
+// incumbent and the best bound is within either tolerance, at the node or
+// time limit, or once the channel done is closed. The best MIP start which
+// gives a value to every column and is feasible becomes the first incumbent,
+// whatever its effort level; other starts are ignored.
+// Each new incumbent is added to the solution pool. In populate mode the search
+// goes on below integer nodes, and does not stop at the gap tolerances, so as
+// to add further solutions whose objective is within the pool gaps of the
+// incumbent; it stops once popLimit solutions have been added. The pool is
+// not trimmed to the gaps of the final incumbent, which trimPool does.
+// A node whose LP relaxation reaches the iteration limit stops the search with
+// MipFailFeas or MipFailInfeas, keeping the incumbent and the best bound.
+// In case the LP relaxation of a node cannot be solved, it returns an error.
+func solveMipModel(model *memModel, opt mipOptions) (*mipResult, error) {
+
+	var open     []*mipNode     // Nodes not yet processed
+	var incumb   float64        // Objective of the incumbent, in minimization form
+	var added    int            // Solutions added to the pool
+
+	start := time.Now()
+	n := len(model.cols)
+	sense := float64(model.sense)
+	res := &mipResult{}
+	incumb = math.Inf(1)
+
+	// In populate mode, nodes are only pruned when they cannot hold a solution
+	// within the pool gaps of the incumbent.
+	pruned := func(z float64) bool {
+		if opt.populate {
+			return z > incumb + mipPoolGap(incumb, opt) + mipAbsTol(incumb)
+		}
+		return z >= incumb - mipAbsTol(incumb)
+	}
+
+	root := &mipNode{lb: make([]float64, n), ub: make([]float64, n), bound: math.Inf(-1)}
+	for j := 0; j < n; j++ {
+		root.lb[j] = model.cols[j].BndLo
+		root.ub[j] = model.cols[j].BndUp
+		if model.cols[j].Type == "B" {
+			root.lb[j] = math.Max(root.lb[j], 0)
+			root.ub[j] = math.Min(root.ub[j], 1)
+		}
+		if model.cols[j].Type != "C" {
+			root.lb[j] = math.Ceil(root.lb[j] - opt.intTol)
+			root.ub[j] = math.Floor(root.ub[j] + opt.intTol)
+		}
+		if root.lb[j] > root.ub[j] {
+			res.status = MipInfeasible
+			return res, nil
+		}
+	}
+
+	for _, ms := range model.starts {
+		x, slack, ok := mipStartSolution(model, ms, root, opt.intTol)
+		if !ok {
+			continue
+		}
+		objVal := 0.0
+		for j := 0; j < n; j++ {
+			objVal += model.obj[j] * x[j]
+		}
+		if sense * objVal < incumb {
+			incumb     = sense * objVal
+			res.objVal = objVal
+			res.x      = x
+			res.slack  = slack
+		}
+		if res.addToPool(model, mipPoolSoln{objVal: objVal, x: x, slack: slack}, opt) {
+			added++
+		}
+	}
+	open = append(open, root)
+
+	for len(open) > 0 {
+		// Stop once the incumbent is close enough to the best bound.
+		bound := mipBestBound(open)
+		if opt.progress != nil && res.nodes > 0 {
+			opt.progress(Progress{MIP: true, Iterations: int64(res.iter), Nodes: int64(res.nodes),
+				HasIncumbent: res.x != nil, BestInteger: res.objVal, BestBound: sense * math.Min(bound, incumb)})
+		}
+
+		if res.x != nil && pruned(bound) {
+			break
+		}
+		if !opt.populate && res.x != nil && mipGapClosed(incumb, bound, opt) {
+			res.status    = MipOptimalTol
+			res.bestBound = sense * bound
+			return res, nil
+		}
+
+		if opt.populate && opt.popLimit > 0 && added >= opt.popLimit {
+			res.status    = MipPopulateSolLim
+			res.bestBound = sense * math.Min(bound, incumb)
+			return res, nil
+		}
+
+		if opt.nodeLimit > 0 && res.nodes >= opt.nodeLimit {
+			res.status = MipNodeLimInfeas
+			if res.x != nil {
+				res.status = MipNodeLimFeas
+			}
+			res.bestBound = sense * math.Min(bound, incumb)
+			return res, nil
+		}
+
+		if opt.timeLimit > 0 && time.Since(start).Seconds() >= opt.timeLimit {
+			res.status = MipTimeLimInfeas
+			if res.x != nil {
+				res.status = MipTimeLimFeas
+			}
+			res.bestBound = sense * math.Min(bound, incumb)
+			return res, nil
+		}
+
+		if mipAborted(opt.done) {
+			res.status = MipAbortInfeas
+			if res.x != nil {
+				res.status = MipAbortFeas
+			}
+			res.bestBound = sense * math.Min(bound, incumb)
+			return res, nil
+		}
+
+		k  := mipSelect(open, res.x == nil)
+		nd := open[k]
+		open = append(open[:k], open[k+1:]...)
+
+		if pruned(nd.bound) {
+			continue
+		}
+
+		res.nodes++
+		lp, err := solveLpModel(model, nd.lb, nd.ub, opt.iterLimit, opt.done, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "LP relaxation failed at node %d", res.nodes)
+		}
+		res.iter += lp.iter
+
+		switch lp.status {
+		case StatInfeasible:
+			continue
+		case StatUnbounded:
+			res.status = MipInfOrUnbd
+			res.x      = nil
+			res.slack  = nil
+			res.pool   = nil
+			return res, nil
+		case StatAbortItLim:
+			// The bound of the node still holds, since its LP was not solved.
+			open = append(open, nd)
+			res.status = MipFailInfeas
+			if res.x != nil {
+				res.status = MipFailFeas
+			}
+			res.bestBound = sense * math.Min(mipBestBound(open), incumb)
+			return res, nil
+		case StatAbortUser:
+			// The node is put back, so that its bound counts towards the best bound.
+			open = append(open, nd)
+			continue
+		}
+
+		z := sense * lp.objVal
+		if pruned(z) {
+			continue
+		}
+
+		// Branch on the integer column with the most fractional value.
+		q, frac := -1, 0.0
+		for j := 0; j < n; j++ {
+			if model.cols[j].Type == "C" {
+				continue
+			}
+			f := math.Abs(lp.x[j] - math.Floor(lp.x[j] + 0.5))
+			if f > opt.intTol && f > frac {
+				q, frac = j, f
+			}
+		}
+
+		if q < 0 {
+			if z < incumb {
+				incumb     = z
+				res.objVal = lp.objVal
+				res.x      = lp.x
+				res.slack  = lp.slack
+			}
+			if res.addToPool(model, mipPoolSoln{objVal: lp.objVal, x: lp.x, slack: lp.slack}, opt) {
+				added++
+			}
+			if opt.populate {
+				open = append(open, mipEnumerate(model, nd, lp.x, z)...)
+			}
+			continue
+		}
+
+		down := &mipNode{lb: nd.lb, ub: append([]float64(nil), nd.ub...), bound: z}
+		up   := &mipNode{lb: append([]float64(nil), nd.lb...), ub: nd.ub, bound: z}
+		down.ub[q] = math.Floor(lp.x[q])
+		up.lb[q]   = math.Ceil(lp.x[q])
+
+		// The child on the side to which the value is closer is explored first.
+		if lp.x[q] - down.ub[q] < 0.5 {
+			open = append(open, up, down)
+		} else {
+			open = append(open, down, up)
+		}
+	}
+
+	if res.x == nil {
+		res.status = MipInfeasible
+		return res, nil
+	}
+
+	res.status    = MipOptimal
+	if opt.populate {
+		res.status = MipOptimalPopulated
+	}
+	res.bestBound = res.objVal
+
+	return res, nil
+}
+
+//==============================================================================
+
+// mipBestBound returns the smallest bound of the open nodes, in minimization
+// form, or +Inf if there are none.
+func mipBestBound(open []*mipNode) float64 {
+
+	bound := math.Inf(1)
+	for _, nd := range open {
+		bound = math.Min(bound, nd.bound)
+	}
+
+	return bound
+}
+
+//==============================================================================
+
+// mipSelect returns the index of the next node to process. While dive is set,
+// i.e. no incumbent exists yet, it is the node added last, so that the search
+// goes depth first towards an integer solution; otherwise it is a node with
+// the best bound.
+func mipSelect(open []*mipNode, dive bool) int {
+
+	k := len(open) - 1
+	if dive {
+		return k
+	}
+
+	for i := range open {
+		if open[i].bound < open[k].bound {
+			k = i
+		}
+	}
+
+	return k
+}
+
+//==============================================================================
+
+// mipEnumerate returns the nodes which cover the integer solutions of node nd
+// other than x, an integer solution of its LP relaxation with objective z in
+// minimization form. The first integer column which the node does not fix is
+// set below, above and at its value in x; the last node is solved again, and
+// usually gives x, after which the next column is fixed. It returns nil once
+// the node fixes all integer columns.
+func mipEnumerate(model *memModel, nd *mipNode, x []float64, z float64) []*mipNode {
+
+	for j := range model.cols {
+		if model.cols[j].Type == "C" || nd.lb[j] >= nd.ub[j] {
+			continue
+		}
+
+		var nodes []*mipNode
+		v := math.Floor(x[j] + 0.5)
+		if v - 1 >= nd.lb[j] {
+			down := &mipNode{lb: nd.lb, ub: append([]float64(nil), nd.ub...), bound: z}
+			down.ub[j] = v - 1
+			nodes = append(nodes, down)
+		}
+		if v + 1 <= nd.ub[j] {
+			up := &mipNode{lb: append([]float64(nil), nd.lb...), ub: nd.ub, bound: z}
+			up.lb[j] = v + 1
+			nodes = append(nodes, up)
+		}
+		fixed := &mipNode{lb: append([]float64(nil), nd.lb...), ub: append([]float64(nil), nd.ub...), bound: z}
+		fixed.lb[j], fixed.ub[j] = v, v
+
+		return append(nodes, fixed)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// addToPool adds the solution to the pool, unless the pool already holds it or
+// a filter of the model rejects it, and returns true if it was added. A full
+// pool drops its oldest solution for PoolReplaceFIFO, and otherwise the
+// solution with the worst objective, which may be the new one.
+func (res *mipResult) addToPool(model *memModel, s mipPoolSoln, opt mipOptions) bool {
+
+	if opt.poolCap <= 0 || !mipFiltersAccept(model.filters, s.x) {
+		return false
+	}
+
+	for _, ps := range res.pool {
+		same := true
+		for j := range s.x {
+			if math.Abs(ps.x[j] - s.x[j]) > mipStartTol {
+				same = false
+				break
+			}
+		}
+		if same {
+			return false
+		}
+	}
+
+	res.pool = append(res.pool, s)
+	if len(res.pool) <= opt.poolCap {
+		return true
+	}
+
+	drop := 0
+	if opt.poolRepl != PoolReplaceFIFO {
+		sense := float64(model.sense)
+		for k := range res.pool {
+			if sense * res.pool[k].objVal > sense * res.pool[drop].objVal {
+				drop = k
+			}
+		}
+	}
+	res.pool = append(res.pool[:drop], res.pool[drop + 1:]...)
+
+	return drop != len(res.pool)
+}
+
+//==============================================================================
+
+// trimPool removes the solutions whose objective is not within the pool gaps
+// of the incumbent.
+func (res *mipResult) trimPool(sense int, opt mipOptions) {
+
+	if res.x == nil {
+		return
+	}
+
+	incumb := float64(sense) * res.objVal
+	limit  := incumb + mipPoolGap(incumb, opt) + mipAbsTol(incumb)
+
+	pool := res.pool[:0]
+	for _, s := range res.pool {
+		if float64(sense) * s.objVal <= limit {
+			pool = append(pool, s)
+		}
+	}
+	res.pool = pool
+}
+
+//==============================================================================
+
+// mipPoolGap returns the amount by which the objective of a pool solution, in
+// minimization form, may exceed the incumbent: the smaller of the absolute gap
+// and the relative gap, computed as Cplex does.
+func mipPoolGap(incumb float64, opt mipOptions) float64 {
+
+	return math.Min(opt.poolAbsGap, opt.poolRelGap * (1e-10 + math.Abs(incumb)))
+}
+
+//==============================================================================
+
+// mipFiltersAccept returns true if the column values satisfy all solution pool
+// filters, within the feasibility tolerance of MIP starts.
+func mipFiltersAccept(filters []InputPoolFilter, x []float64) bool {
+
+	for _, f := range filters {
+		value := 0.0
+		for _, c := range f.Cols {
+			if f.Type == "D" {
+				value += c.Value * math.Abs(x[c.ColIndex] - c.RefValue)
+			} else {
+				value += c.Value * x[c.ColIndex]
+			}
+		}
+		if value < f.LowerBound - mipStartTol || value > f.UpperBound + mipStartTol {
+			return false
+		}
+	}
+
+	return true
+}
+
+//==============================================================================
+
+// mipStartSolution returns the column values and row slacks of the MIP start,
+// and true if it gives a value to every column and these values satisfy the
+// bounds of the root node, the integrality of the columns and the rows, within
+// the tolerances.
+func mipStartSolution(model *memModel, ms InputMIPStart, root *mipNode, intTol float64) ([]float64, []float64, bool) {
+
+	n := len(model.cols)
+	x := make([]float64, n)
+	given := make([]bool, n)
+	for _, v := range ms.Values {
+		x[v.ColIndex]     = v.Value
+		given[v.ColIndex] = true
+	}
+
+	for j := 0; j < n; j++ {
+		if !given[j] || x[j] < root.lb[j] - mipStartTol || x[j] > root.ub[j] + mipStartTol {
+			return nil, nil, false
+		}
+		if model.cols[j].Type != "C" && math.Abs(x[j] - math.Floor(x[j] + 0.5)) > intTol {
+			return nil, nil, false
+		}
+	}
+
+	// The slack is rhs - a*x, bounded as in the simplex solver.
+	slack := make([]float64, len(model.rows))
+	for i, row := range model.rows {
+		slack[i] = row.Rhs
+	}
+	for _, e := range model.elems {
+		slack[e.RowIndex] -= e.Value * x[e.ColIndex]
+	}
+
+	for i, row := range model.rows {
+		lo, up := math.Inf(-1), math.Inf(1)
+		switch row.Sense {
+		case "L":
+			lo = 0
+		case "G":
+			up = 0
+		case "E":
+			lo, up = 0, 0
+		case "R":
+			lo, up = math.Min(0, -row.RngVal), math.Max(0, -row.RngVal)
+		}
+		if slack[i] < lo - mipStartTol || slack[i] > up + mipStartTol {
+			return nil, nil, false
+		}
+	}
+
+	return x, slack, true
+}
+
+//==============================================================================
+
+// mipAborted returns true if the channel passed into this function is closed.
+// A nil channel is never closed.
+func mipAborted(done <-chan struct{}) bool {
+
+	select {
+	case <-done:
+		return true
+	default:
+	}
+
+	return false
+}
+
+//==============================================================================
+
+// mipGapClosed returns true if the incumbent is within the absolute or the
+// relative gap of the bound, both given in minimization form. The relative gap
+// is computed as Cplex does, |incumbent - bound| / (1e-10 + |incumbent|).
+func mipGapClosed(incumb float64, bound float64, opt mipOptions) bool {
+
+	gap := incumb - bound
+
+	return gap <= opt.absGap || gap <= opt.relGap * (1e-10 + math.Abs(incumb))
+}
+
+//==============================================================================
+
+// mipAbsTol returns the amount by which a node must improve on the incumbent
+// so that it is not pruned.
+func mipAbsTol(incumb float64) float64 {
+
+	return 1e-9 * (1 + math.Abs(incumb))
+}
+
+//==============================================================================
+
+// mipRelGap returns the relative gap between the objective value of a solution
+// and a bound, computed as Cplex does.
+func mipRelGap(objVal float64, bound float64) float64 {
+
+	return math.Abs(objVal - bound) / (1e-10 + math.Abs(objVal))
+}
+
+//============================ END OF FILE =====================================
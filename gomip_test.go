@@ -0,0 +1,535 @@
+package gpx
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// mipTestOptions returns the options of an exhaustive search, which only stops
+// once the optimum has been proven.
+func mipTestOptions() mipOptions {
+
+	return mipOptions{intTol: mipDefIntTol, poolCap: math.MaxInt32, poolRelGap: math.Inf(1),
+		poolAbsGap: math.Inf(1)}
+}
+
+//==============================================================================
+
+// knapsackCase returns a knapsack problem with n items of random weights and
+// values, generated from the seed, whose capacity is half the total weight.
+// Items are binary columns if maxCopies is 1, and integer columns bounded by
+// maxCopies otherwise.
+func knapsackCase(seed int64, n int, maxCopies int) lpCase {
+
+	rng := rand.New(rand.NewSource(seed))
+	tc  := lpCase{name: "knapsack", sense: -1}
+
+	colType := "B"
+	if maxCopies > 1 {
+		colType = "I"
+	}
+
+	total := 0.0
+	for j := 0; j < n; j++ {
+		weight := float64(5 + rng.Intn(20))
+		total += weight * float64(maxCopies)
+		tc.cols  = append(tc.cols, InputCol{"item", colType, 0, float64(maxCopies)})
+		tc.obj   = append(tc.obj, InputObjCoef{j, weight + float64(rng.Intn(10))})
+		tc.elems = append(tc.elems, InputElem{0, j, weight})
+	}
+	tc.rows = []InputRow{{"capacity", "L", math.Floor(total / 2), 0}}
+
+	return tc
+}
+
+//==============================================================================
+
+// assignmentCase returns the assignment of n workers to n tasks at the lowest
+// cost, with a budget on the time spent, which is longer for cheaper tasks.
+// The budget is the time of assigning worker i to task i, so that the cheapest
+// assignments are usually excluded, and the LP relaxation is fractional.
+func assignmentCase(seed int64, n int) lpCase {
+
+	rng := rand.New(rand.NewSource(seed))
+	tc  := lpCase{name: "assignment", sense: 1}
+
+	for i := 0; i < n; i++ {
+		tc.rows = append(tc.rows, InputRow{"worker", "E", 1, 0})
+	}
+	for j := 0; j < n; j++ {
+		tc.rows = append(tc.rows, InputRow{"task", "E", 1, 0})
+	}
+	tc.rows = append(tc.rows, InputRow{"budget", "L", 0, 0})
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			k    := i * n + j
+			cost := float64(1 + rng.Intn(9))
+			tc.cols  = append(tc.cols, InputCol{"assign", "B", 0, 1})
+			tc.obj   = append(tc.obj, InputObjCoef{k, cost})
+			tc.elems = append(tc.elems, InputElem{i, k, 1}, InputElem{n + j, k, 1},
+				InputElem{2 * n, k, 10 - cost})
+			if i == j {
+				tc.rows[2 * n].Rhs += 10 - cost
+			}
+		}
+	}
+
+	return tc
+}
+
+//==============================================================================
+
+// bruteForce enumerates the integer points within the bounds of the columns,
+// and returns the best objective value of those which satisfy the rows, and
+// false if there are none. Binary columns must be bounded by 0 and 1.
+func bruteForce(tc lpCase) (float64, bool) {
+
+	model := lpTestModel(tc)
+	n     := len(tc.cols)
+	x     := make([]float64, n)
+	best  := math.Inf(1)
+	found := false
+
+	var enumerate func(j int)
+	enumerate = func(j int) {
+		if j < n {
+			for v := math.Ceil(tc.cols[j].BndLo); v <= tc.cols[j].BndUp; v++ {
+				x[j] = v
+				enumerate(j + 1)
+			}
+			return
+		}
+
+		if !mipTestFeasible(tc, x) {
+			return
+		}
+
+		objVal := 0.0
+		for k := range x {
+			objVal += model.obj[k] * x[k]
+		}
+		best  = math.Min(best, float64(tc.sense) * objVal)
+		found = true
+	}
+	enumerate(0)
+
+	return float64(tc.sense) * best, found
+}
+
+//==============================================================================
+
+// mipTestFeasible returns true if x is integral where required and satisfies
+// the bounds and rows of the problem.
+func mipTestFeasible(tc lpCase, x []float64) bool {
+
+	const tol = 1e-6
+
+	for j, c := range tc.cols {
+		if x[j] < c.BndLo - tol || x[j] > c.BndUp + tol {
+			return false
+		}
+		if c.Type != "C" && math.Abs(x[j] - math.Floor(x[j] + 0.5)) > tol {
+			return false
+		}
+	}
+
+	ax := make([]float64, len(tc.rows))
+	for _, e := range tc.elems {
+		ax[e.RowIndex] += e.Value * x[e.ColIndex]
+	}
+
+	for i, r := range tc.rows {
+		switch {
+		case r.Sense == "L" && ax[i] > r.Rhs + tol:
+			return false
+		case r.Sense == "G" && ax[i] < r.Rhs - tol:
+			return false
+		case r.Sense == "E" && math.Abs(ax[i] - r.Rhs) > tol:
+			return false
+		}
+	}
+
+	return true
+}
+
+//==============================================================================
+
+// mipTestCases returns the knapsack and assignment problems solved by the
+// tests.
+func mipTestCases() []lpCase {
+
+	var cases []lpCase
+
+	for seed := int64(1); seed <= 4; seed++ {
+		cases = append(cases, knapsackCase(seed, 12, 1), knapsackCase(seed, 6, 3),
+			assignmentCase(seed, 4))
+	}
+
+	return cases
+}
+
+//==============================================================================
+
+func TestSolveMipModel(t *testing.T) {
+
+	for i, tc := range mipTestCases() {
+		want, ok := bruteForce(tc)
+		if !ok {
+			t.Fatalf("%s %d has no integer solution", tc.name, i)
+		}
+
+		res, err := solveMipModel(lpTestModel(tc), mipTestOptions())
+		if err != nil {
+			t.Fatalf("%s %d: solveMipModel failed: %v", tc.name, i, err)
+		}
+
+		if res.status != MipOptimal || math.Abs(res.objVal - want) > 1e-6 || res.bestBound != res.objVal {
+			t.Errorf("%s %d = %s, objective %g, bound %g, want %s, %g",
+				tc.name, i, res.status, res.objVal, res.bestBound, MipOptimal, want)
+		}
+
+		if !mipTestFeasible(tc, res.x) {
+			t.Errorf("%s %d: incumbent %v is not feasible", tc.name, i, res.x)
+		}
+	}
+}
+
+//==============================================================================
+
+func TestSolveMipModelNodeLimit(t *testing.T) {
+
+	tc := knapsackCase(2, 12, 1)
+	want, _ := bruteForce(tc)
+
+	full, err := solveMipModel(lpTestModel(tc), mipTestOptions())
+	if err != nil || full.status != MipOptimal {
+		t.Fatalf("solveMipModel returned %v, %v", full, err)
+	}
+
+	// The search stops at the limit, first without and then with an
+	// incumbent, whose value never exceeds the optimum while the bound
+	// never falls below it.
+	var feasible bool
+	for limit := 1; limit < full.nodes; limit++ {
+		opt := mipTestOptions()
+		opt.nodeLimit = limit
+
+		res, err := solveMipModel(lpTestModel(tc), opt)
+		if err != nil {
+			t.Fatalf("node limit %d: solveMipModel failed: %v", limit, err)
+		}
+
+		switch {
+		case res.nodes != limit:
+			t.Errorf("node limit %d: search processed %d nodes", limit, res.nodes)
+		case res.status == MipNodeLimInfeas && !feasible && res.x == nil:
+		case res.status == MipNodeLimFeas && res.x != nil:
+			feasible = true
+			if res.objVal > want + 1e-6 || res.bestBound < want - 1e-6 || !mipTestFeasible(tc, res.x) {
+				t.Errorf("node limit %d: objective %g, bound %g, want at most %g, at least %g",
+					limit, res.objVal, res.bestBound, want, want)
+			}
+		default:
+			t.Errorf("node limit %d: status %s with incumbent %v", limit, res.status, res.x)
+		}
+	}
+
+	if !feasible {
+		t.Errorf("no node limit below %d gave an incumbent", full.nodes)
+	}
+}
+
+//==============================================================================
+
+func TestSolveMipModelIterLimit(t *testing.T) {
+
+	tc := knapsackCase(2, 12, 1)
+
+	// An empty knapsack is feasible, and becomes the incumbent when given as a
+	// MIP start.
+	empty := InputMIPStart{Name: "empty", Effort: EffortCheckFeas}
+	for j := range tc.cols {
+		empty.Values = append(empty.Values, InputColValue{ColIndex: j})
+	}
+
+	tests := []struct {
+		name    string
+		starts  []InputMIPStart
+		status  SolutionStatus
+	}{
+		{"no incumbent", nil, MipFailInfeas},
+		{"incumbent", []InputMIPStart{empty}, MipFailFeas},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			model := lpTestModel(tc)
+			model.starts = tt.starts
+
+			opt := mipTestOptions()
+			opt.iterLimit = 1
+
+			res, err := solveMipModel(model, opt)
+			if err != nil {
+				t.Fatalf("solveMipModel failed: %v", err)
+			}
+			if res.status != tt.status || res.nodes != 1 {
+				t.Errorf("status = %s after %d nodes, want %s after 1", res.status, res.nodes, tt.status)
+			}
+			if (res.x != nil) != (tt.starts != nil) || (res.x != nil && res.objVal != 0) {
+				t.Errorf("incumbent = %v with objective %g, want the MIP start", res.x, res.objVal)
+			}
+			if !math.IsInf(res.bestBound, -int(tc.sense)) {
+				t.Errorf("best bound = %g, want the bound of the unsolved root", res.bestBound)
+			}
+		})
+	}
+}
+
+//==============================================================================
+
+func TestSolveMipModelTimeLimit(t *testing.T) {
+
+	tc := knapsackCase(2, 12, 1)
+
+	full, err := solveMipModel(lpTestModel(tc), mipTestOptions())
+	if err != nil || full.nodes < 2 {
+		t.Fatalf("solveMipModel returned %v, %v", full, err)
+	}
+
+	opt := mipTestOptions()
+	opt.timeLimit = 1e-9
+
+	res, err := solveMipModel(lpTestModel(tc), opt)
+	if err != nil {
+		t.Fatalf("solveMipModel failed: %v", err)
+	}
+
+	if (res.status != MipTimeLimInfeas && res.status != MipTimeLimFeas) || res.nodes >= full.nodes {
+		t.Errorf("status = %s after %d nodes, want a time limit status before %d nodes",
+			res.status, res.nodes, full.nodes)
+	}
+}
+
+//==============================================================================
+
+func TestSolveMipModelGap(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		relGap  float64
+		absGap  float64
+	}{
+		{"relative", 0.05, 0},
+		{"absolute", 0, 15},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			tc := knapsackCase(3, 12, 1)
+			want, _ := bruteForce(tc)
+
+			opt := mipTestOptions()
+			opt.relGap = tt.relGap
+			opt.absGap = tt.absGap
+
+			res, err := solveMipModel(lpTestModel(tc), opt)
+			if err != nil {
+				t.Fatalf("solveMipModel failed: %v", err)
+			}
+
+			if res.status != MipOptimalTol {
+				t.Fatalf("status = %s, want %s", res.status, MipOptimalTol)
+			}
+
+			gap := res.bestBound - res.objVal
+			if gap > tt.absGap + tt.relGap * math.Abs(res.objVal) + 1e-9 {
+				t.Errorf("gap = %g between %g and %g, want within the tolerance", gap, res.objVal, res.bestBound)
+			}
+
+			if res.objVal > want + 1e-6 || res.bestBound < want - 1e-6 || !mipTestFeasible(tc, res.x) {
+				t.Errorf("objective %g, bound %g, want at most %g, at least %g",
+					res.objVal, res.bestBound, want, want)
+			}
+		})
+	}
+}
+
+//==============================================================================
+
+func TestSolveMipModelInfeasible(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		cols  []InputCol
+		rows  []InputRow
+	}{
+		{"integer bounds", []InputCol{{"x", "I", 0.2, 0.8}, {"y", "I", 0, 5}}, nil},
+		{"binary bounds", []InputCol{{"x", "B", 0.5, 0.7}, {"y", "I", 0, 5}}, nil},
+		{"integer rows", []InputCol{{"x", "I", 0, 5}, {"y", "I", 0, 5}}, []InputRow{{"e", "E", 1.5, 0}}},
+		{"lp relaxation", []InputCol{{"x", "I", 0, 5}, {"y", "I", 0, 5}}, []InputRow{{"e", "G", 11, 0}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			tc := lpCase{sense: 1, cols: tt.cols, rows: tt.rows, obj: []InputObjCoef{{0, 1}, {1, 1}}}
+			if tt.rows != nil {
+				tc.elems = []InputElem{{0, 0, 1}, {0, 1, 1}}
+			}
+
+			res, err := solveMipModel(lpTestModel(tc), mipTestOptions())
+			if err != nil {
+				t.Fatalf("solveMipModel failed: %v", err)
+			}
+
+			if res.status != MipInfeasible || res.x != nil {
+				t.Errorf("status = %s with incumbent %v, want %s", res.status, res.x, MipInfeasible)
+			}
+		})
+	}
+}
+
+//==============================================================================
+
+func TestSolveMipModelStarts(t *testing.T) {
+
+	tc := knapsackCase(1, 12, 1)
+	want, _ := bruteForce(tc)
+
+	full, err := solveMipModel(lpTestModel(tc), mipTestOptions())
+	if err != nil || full.status != MipOptimal {
+		t.Fatalf("solveMipModel returned %v, %v", full, err)
+	}
+
+	startOf := func(x []float64) InputMIPStart {
+		ms := InputMIPStart{Name: "start", Effort: EffortCheckFeas}
+		for j, v := range x {
+			ms.Values = append(ms.Values, InputColValue{ColIndex: j, Value: v})
+		}
+		return ms
+	}
+	optimal := startOf(full.x)
+	empty   := startOf(make([]float64, len(tc.cols)))
+	partial := startOf(full.x[1:])
+	all     := make([]float64, len(tc.cols))
+	for j := range all {
+		all[j] = 1
+	}
+	overweight := startOf(all)
+
+	tests := []struct {
+		name    string
+		starts  []InputMIPStart
+		status  SolutionStatus    // Status after a single node
+		objVal  float64           // Incumbent after a single node
+	}{
+		{"optimal", []InputMIPStart{optimal}, MipNodeLimFeas, want},
+		{"empty", []InputMIPStart{empty}, MipNodeLimFeas, 0},
+		{"best of two", []InputMIPStart{empty, optimal}, MipNodeLimFeas, want},
+		{"incomplete", []InputMIPStart{partial}, MipNodeLimInfeas, 0},
+		{"infeasible", []InputMIPStart{overweight}, MipNodeLimInfeas, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			model := lpTestModel(tc)
+			model.starts = tt.starts
+
+			opt := mipTestOptions()
+			opt.nodeLimit = 1
+
+			res, err := solveMipModel(model, opt)
+			if err != nil {
+				t.Fatalf("solveMipModel failed: %v", err)
+			}
+			if res.status != tt.status || math.Abs(res.objVal - tt.objVal) > 1e-6 {
+				t.Errorf("after one node: %s, objective %g, want %s, %g", res.status, res.objVal, tt.status, tt.objVal)
+			}
+
+			res, err = solveMipModel(model, mipTestOptions())
+			if err != nil {
+				t.Fatalf("solveMipModel failed: %v", err)
+			}
+			if res.status != MipOptimal || math.Abs(res.objVal - want) > 1e-6 {
+				t.Errorf("status = %s, objective %g, want %s, %g", res.status, res.objVal, MipOptimal, want)
+			}
+		})
+	}
+}
+
+//==============================================================================
+
+func TestMipSelect(t *testing.T) {
+
+	nodes := func(bounds ...float64) []*mipNode {
+		var open []*mipNode
+		for _, b := range bounds {
+			open = append(open, &mipNode{bound: b})
+		}
+		return open
+	}
+
+	tests := []struct {
+		name   string
+		open   []*mipNode
+		dive   bool
+		want   int
+	}{
+		{"dive takes the last node", nodes(1, 0, 2), true, 2},
+		{"best bound", nodes(1, 0, 2), false, 1},
+		{"best bound first", nodes(0, 3, 2), false, 0},
+		{"best bound last", nodes(3, 2, 1), false, 2},
+		{"single node", nodes(5), false, 0},
+	}
+
+	for _, tt := range tests {
+		if got := mipSelect(tt.open, tt.dive); got != tt.want {
+			t.Errorf("%s: mipSelect = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+//==============================================================================
+
+// TestSolveMipModelBestBound checks the progress of the search once the dive
+// has found an incumbent: it must remain, only improve, and the best bound
+// must only tighten until both meet at the optimum.
+func TestSolveMipModelBestBound(t *testing.T) {
+
+	tc := assignmentCase(2, 4)
+	want, _ := bruteForce(tc)
+
+	var events []Progress
+	opt := mipTestOptions()
+	opt.progress = func(p Progress) {
+		events = append(events, p)
+	}
+
+	res, err := solveMipModel(lpTestModel(tc), opt)
+	if err != nil || res.status != MipOptimal || math.Abs(res.objVal - want) > 1e-6 {
+		t.Fatalf("solveMipModel returned %v, %v, want objective %g", res, err, want)
+	}
+
+	var prev *Progress
+	for i := range events {
+		e := &events[i]
+		if prev != nil && prev.HasIncumbent {
+			if !e.HasIncumbent || e.BestInteger > prev.BestInteger + 1e-9 || e.BestBound < prev.BestBound - 1e-9 {
+				t.Errorf("event %d = %+v after %+v", i, *e, *prev)
+			}
+		}
+		prev = e
+	}
+
+	if prev == nil || !prev.HasIncumbent {
+		t.Errorf("no incumbent was reported in %d events", len(events))
+	}
+}
+
+//============================ END OF FILE =====================================
@@ -1,1335 +1,1445 @@
 // 01   July  5, 2018   Initial version uploaded to github
 // 02   July 29, 2018   Moved comments to separate doc file
+// 03   Oct. 16, 2026   Added Env and Problem handles to support multiple problems
+// 04   Oct. 16, 2026   Moved Cplex calls to cplex.go behind the Backend interface
+// 05   Oct. 16, 2026   Added GetBestObjVal and GetMipRelGap
+// 06   Oct. 16, 2026   Added GetStatus and GetSolnInfo, solutions must be primal feasible
+// 07   Oct. 16, 2026   Return sentinel errors for missing problems and solutions
+// 08   Oct. 16, 2026   Added LpOptContext and MipOptContext
+// 09   Oct. 16, 2026   Solver messages tagged with the problem name
+// 10   Oct. 16, 2026   Progress functions installed while solving
+// 11   Oct. 16, 2026   All optimizers run through optimize
 
 package gpx
 
-/*
-// Everything in comments above the import "C" is C code and will be compiled with the GCC. 
-// Make sure you have a GCC installed.
+import (
+	"context"
+	"github.com/pkg/errors"
+)
 
-#cgo LDFLAGS: -LD:/pk_cplex/include -lcplex1271
 
-#include <string.h>
-#include <stdio.h>
-#include <D:/pk_cplex/include/ilcplex/cplex.h>
+var plInfySmall = 1.0e10          // Default infinity in lpo package
+var plInfyLarge = 1.0e20          // Default CPX_INFBOUND in Cplex
 
-// Global variables local to this C section.
+// defaultProb is the problem used by the package-level functions. It is created
+// by CreateProb and released by CloseCplex.
+var defaultProb *Problem
 
-CPXENVptr env = NULL;
-CPXLPptr lp = NULL;
+// Input data structures passed to gpx to define a problem.
 
-#define BUFSIZE 80;
+// InputRow defines a data structure passed as an
+// input argument to functions when creating the rows of the problem in Cplex.
+type InputRow struct {
+	Name   string      // Name of the row (constraint)
+	Sense  string      // Sense (L, E, G, R) of the row as supported by Cplex
+	Rhs    float64     // Value of the RHS, or lower boundary of the range
+	RngVal float64     // For ranges, the range is defined as (Rhs to [Rhs + RngVal])	
+}
 
-//==============================================================================
-// C HELPER FUNCTIONS
-//==============================================================================
+// InputCol defines a data structure passed as an input
+// argument to functions when creating the columns of the problem in Cplex.
+type InputCol struct {
+	Name   string      // Name of the column (variable)
+	Type   string      // Type of the column as supported by Cplex
+	BndLo  float64     // Lower bound of the column
+	BndUp  float64 	   // Upper bound of the column
+}
 
-//------------------------------------------------------------------------------
-char**makeCharArray(int size) {
-        return calloc(sizeof(char*), size);
+// InputElem defines a data structure passed as an
+// input argument to functions when changing non-zero coefficients of the problem
+// in Cplex.
+type InputElem struct {
+	RowIndex  int      // Row index for this element (coefficient)
+	ColIndex  int      // Column index for this element
+	Value     float64  // Value of this element
 }
 
-char *makeNameStore(int size) {
-	return malloc (size);	
+// InputObjCoef defines a data structure of all coefficients
+// present in the objective function. It is passed as an input argument to functions
+// which create the columns of the problem in Cplex.
+type InputObjCoef struct {
+	ColIndex  int      // Column index of this coefficient in the objective function
+	Value     float64  // Value of the coefficient in the objective function
 }
 
-static void setArrayString(char **a, char *s, int n) {
-	a[n] = s;
+// Output data structures which contain the solution provided by Cplex.
+
+// SolnRow defines a data structure of the solved rows returned from Cplex.
+type SolnRow struct {
+	Name    string     // Name of the row in the solution data structure
+	Slack   float64    // Slack for this row as calculated by Cplex
+	Pi      float64    // Pi for this row as calculated by Cplex
 }
 
-static void freeCharArray(char **a, int size) {
-	int i;
-	for (i = 0; i < size; i++) {
-		free(a[i]);			
-	}
-	free(a);
+// SolnCol defines a data structure of the solved columns returned from Cplex.
+type SolnCol struct {
+	Name    string     // Name of the column in the solution data structure
+	Value   float64    // Value for this column as calculated by Cplex
+	RedCost float64    // Reduced cost for this column as calculated by Cplex
 }
 
-char *getArrayString(char **a, int n) {
-	char *aItem;
-				
-	aItem = strdup(a[n]);
-	return aItem;	
+// Handles to the solver objects used by gpx.
+
+// Env is a handle to a solver environment. An environment holds the parameter
+// settings and may contain any number of problems, each of which can be built,
+// solved, and freed independently of the others. An Env is obtained from
+// OpenCplex or OpenEnv and must be released with CloseCplex. 
+// Problems belonging to the same environment must not be used concurrently.
+type Env struct {
+	drv     BackendEnv    // Environment provided by the backend
+	probs   []*Problem    // Problems created in this environment and not yet freed
+	log     envLog        // Destination of the solver messages
 }
 
-char *cGetArrayItem(char **a, int n) {
-	int i;
-	char *arrayItem;
-	
-	arrayItem = strdup(a[n]);
-	return arrayItem;
+// Problem is a handle to a single problem (model and solution) in a solver
+// environment. A Problem is obtained from Env.CreateProb and is released with
+// FreeProb, or implicitly when its environment is closed.
+type Problem struct {
+	env       *Env             // Environment in which the problem was created
+	slv       Solver           // Problem provided by the backend
+	name      string           // Name of the problem, used to tag solver messages
+	progress  problemProgress  // Destination of the progress events
 }
 
 //==============================================================================
-// CPX FUNCTIONS
+// FUNCTIONS FOR MANAGING ENVIRONMENTS AND PROBLEMS
 //==============================================================================
 
-//------------------------------------------------------------------------------
-int cOpenCplex() {
-	
-	int status = 0;	
-	env = NULL;
-	
-    env = CPXopenCPLEX(&status);
+// OpenEnv initializes a new environment using the backend passed into this
+// function. With the Cplex backend, data checking is turned on.
+// In case of failure, it returns an error including the error code it received
+// from the backend.
+func OpenEnv(b Backend) (*Env, error) {
 
-	if (env == NULL) {
-		char errmsg[CPXMESSAGEBUFSIZE];
-		fprintf (stderr, "Could not open CPLEX environment.\n");
-		CPXgeterrorstring (env, status, errmsg);
-		fprintf (stderr, "%s", errmsg);
+	if b == nil {
+		return nil, errors.New("No solver backend, build with the cplex tag or set DefaultBackend")
 	}
-		
-	return status;	
-}
 
-//------------------------------------------------------------------------------
-// Turn on output to the screen.
-int cOutputToScreen(int state) {
-	int status = 0;
-	int echoState = 0;
-	
-	if (state) {
-		echoState = CPX_ON;	
-	} else {
-		echoState = CPX_OFF;	
-	}
-	
-	status = CPXsetintparam(env, CPXPARAM_ScreenOutput, echoState);
-	if (status) {
-		fprintf(stderr, "Failed to set screen output, error %d.\n", status);
+	drv, err := b.OpenEnv()
+	if err != nil {
+		return nil, err
 	}
-	return status;
-}
 
-//------------------------------------------------------------------------------
-// Turn on data checking.
-int cCheckData() {
-
-	int status = 0;
-	status = CPXsetintparam(env, CPXPARAM_Read_DataCheck, CPX_DATACHECK_WARN);
-	if (status) {
-		fprintf(stderr, "Failed to turn on data checking, error %d.\n", status);
-	}
-	return status;	
+	return &Env{drv: drv}, nil
 }
 
+//==============================================================================
 
-//------------------------------------------------------------------------------
-// Create the LP.
-int cCreateProb(char *probName) {
+// OpenCplex initializes a new environment using DefaultBackend, which is Cplex
+// when gpx is built with the "cplex" build tag.
+// In case of failure, it returns an error including the error code it received from Cplex. 
+// This function uses CPXopenCPLEX and CPXsetintparam.
+func OpenCplex() (*Env, error) {
 
-	char buffer[256];
-	int status = 0;
-		
-	strcpy(buffer, probName);
-	
-	lp = CPXcreateprob(env, &status, buffer);
-	
-	if (lp == NULL) {
-		fprintf(stderr, "Unable to create problem.\n");	
-	}
-	
-	return status;
+	return OpenEnv(DefaultBackend)
 }
 
-//------------------------------------------------------------------------------
-// Create the LP.
-int cChgProbName(char *probName) {
+//==============================================================================
 
-	char buffer[256];
-	int status = 0;
-		
-	strcpy(buffer, probName);
-	
-	status = CPXchgprobname(env, lp, buffer);
-	
-	if (status) {
-		fprintf(stderr, "Unable to change problem name, error %d.\n", status);	
-	}
-	
-	return status;
-}
+// CreateProb creates a new, empty problem in the environment with the name
+// passed into this function. 
+// In case of failure, it returns an error including the error code it received from Cplex. 
+// This function uses CPXcreateprob.
+func (e *Env) CreateProb(Name string) (*Problem, error) {
 
-//------------------------------------------------------------------------------
-// Change objective function sense (default is minimize).
-int cChgObjSen(int state) {
-	int status = 0;
-	int sense;
-	
-	if (state == 1) {
-		sense = CPX_MIN;	
-	} else {
-		sense = CPX_MAX;	
+	if e.drv == nil {
+		return nil, errors.New("Environment is not open")
 	}
-	
-	status = CPXchgobjsen(env, lp, sense);
-	if (status) {
-		fprintf(stderr, "Failed to set objective function sense, error %d.\n", status);
+
+	if Name == "" {
+		Name = "DefaultName"
 	}
-	return status;
-}
 
-//------------------------------------------------------------------------------
-// Create new rows
-int cCreateRows(int numRows, char *senseArray, char **rowName, double *rhs, double *rngVal) {
+	slv, err := e.drv.CreateProb(Name)
+	if err != nil {
+		return nil, err
+	}	
 
-	int status = 0;
+	p := &Problem{env: e, slv: slv, name: Name}
+	e.probs = append(e.probs, p)
 			
-	status = CPXnewrows(env, lp, numRows, rhs, senseArray, rngVal, rowName);
-	if (status) {
-		fprintf(stderr, "Failed to create rows, error %d.\n", status);
-	}
-
-	return status;	
+	return p, nil
 }
 
+//==============================================================================
 
-//------------------------------------------------------------------------------
-// Create new columns
-int cCreateCols(int isMip, int numCols, double *obj, char **colName,  char *type, double *lb, double *ub) {
-
-	int status = 0;
+// OutputToScreen turns Cplex output to screen on or off for all problems in the
+// environment. 
+// In case of failure, it returns an error including the error code it received from Cplex. 
+// This function uses CPXsetintparam with CPXPARAM_ScreenOutput set to CPX_ON if the value
+// passed to this function is "true", or CPX_OFF if false. By default, output is not
+// printed to the screen.
+func (e *Env) OutputToScreen(echoOn bool) error {
 
-	// If this is a MIP, we must pass tye variable type array to Cplex.
-	// Otherwise, we must pass a NULL even if all variables are of the C type so CPXlpopt
-	// does not complain when we solve the problem.
-	
-	if (isMip) {
-		status = CPXnewcols(env, lp, numCols, obj, lb, ub, type, colName);	
-	} else {	
-		status = CPXnewcols(env, lp, numCols, obj, lb, ub, NULL, colName);	
-    }
-	
-	if (status) {
-		fprintf(stderr, "Failed to create columns, error %d.\n", status);
+	if e.drv == nil {
+		return errors.New("Environment is not open")
 	}
-	
-	return status;	
-}
 
-//------------------------------------------------------------------------------
-// Create new columns
-int cChgCoefList(int numNZ, int *rowlist, int *collist, double *vallist) {
+	return e.drv.OutputToScreen(echoOn)
+}
 
-	int status = 0;
-	
-	status = CPXchgcoeflist(env, lp, numNZ, rowlist, collist, vallist); 
-	if (status) {
-		fprintf(stderr, "Failed to change coefficients, error %d.\n", status);
-	}
-	
-	return status;	
+//==============================================================================
 
-}
+// CloseCplex frees all problems remaining in the environment, then closes and
+// cleans up the environment itself. 
+// In case of failure, it returns an error including the error code it received from Cplex. 
+// This function uses CPXfreeprob and CPXcloseCPLEX.
+func (e *Env) CloseCplex() error {
+	var err     error   // First error encountered while freeing the problems
 
-//------------------------------------------------------------------------------
-// Optimize a linear problem (continuous variables only).
-int cLpOpt() {
+	if e.drv == nil {
+		return nil
+	}
 
-	int status = 0;
-	
-	status = CPXlpopt(env, lp);	
-	if (status) {
-		fprintf(stderr, "CPXlpopt failed with error %d.\n", status);
+	for len(e.probs) > 0 {
+		if freeErr := e.probs[0].FreeProb(); freeErr != nil && err == nil {
+			err = freeErr
+		}
 	}
 
-	return status;	
+	if closeErr := e.drv.CloseEnv(); closeErr != nil {
+		return closeErr
+	}
+	e.drv = nil
+	
+	return err
 }
 
-//------------------------------------------------------------------------------
-// Optimize a mixed integer problem.
-int cMipOpt() {
+//==============================================================================
 
-	int status = 0;
-	
-	status = CPXmipopt(env, lp);	
-	if (status) {
-		fprintf(stderr, "CPXmipopt failed with error %d.\n", status);
+// FreeProb releases the problem and all memory the solver associated with it. The
+// environment and any other problems in it are not affected.
+// In case of failure, it returns an error including the error code it received from Cplex. 
+// This function uses CPXfreeprob.
+func (p *Problem) FreeProb() error {
+
+	if p.slv == nil {
+		return nil
 	}
 
-	return status;	
-}
+	// Remove the problem from its environment even if the solver fails to free
+	// it, since the handle cannot be used reliably afterwards.
+	for i, q := range p.env.probs {
+		if q == p {
+			p.env.probs = append(p.env.probs[:i], p.env.probs[i+1:]...)
+			break
+		}
+	}
 
-//------------------------------------------------------------------------------
-// Get number of rows
-int cGetNumRows(int *numRows) {
+	slv := p.slv
+	p.slv = nil
 
-	int cur_numrows;
-	
-	*numRows = 0;
-	cur_numrows = CPXgetnumrows(env, lp);
-	
-	*numRows = cur_numrows;
-	
-	return 0;
+	return slv.FreeProb()
 }
 
-//------------------------------------------------------------------------------
-// Get number of columns
-int cGetNumCols(int *numCols) {
-
-	int cur_numcols;
+//==============================================================================
 
-	*numCols = 0;	
-	cur_numcols = CPXgetnumcols(env, lp);
-	
-	*numCols = cur_numcols;
-	
-	return 0;
-}
+// checkProb verifies that the problem has been created and not yet freed.
+// In case of failure, it returns an error.
+func (p *Problem) checkProb() error {
 
-//------------------------------------------------------------------------------
-int cGetColNameSurplus(int numCols, int *surplus) {
-	int status;
-	
-	status = CPXgetcolname(env, lp, NULL, NULL, 0, surplus, 0, numCols - 1);
-    if (( status != CPXERR_NEGATIVE_SURPLUS ) && ( status != 0 ))  {
-    	fprintf (stderr, "Could not determine amount of space for column names.\n");
-    	return status;
+	if p == nil || p.slv == nil {
+		return ErrNoProblem
 	}
 
-	// Switch "negative surplus 'error'" to "this is not an error".
-	return 0;	
+	return nil
 }
 
-//------------------------------------------------------------------------------
-int cGetRowNameSurplus(int numRows, int *surplus) {
-	int status;
-	
-	status = CPXgetrowname(env, lp, NULL, NULL, 0, surplus, 0, numRows - 1);
-    if (( status != CPXERR_NEGATIVE_SURPLUS ) && ( status != 0 ))  {
-    	fprintf (stderr, "Could not determine amount of space for row names.\n");
-    	return status;
-	}
+//==============================================================================
+// FUNCTIONS FOR CREATING THE PROBLEM
+//==============================================================================
 
-	// Switch "negative surplus 'error'" to "this is not an error".
-	return 0;	
-}
+// ChgProbName changes the name of the problem to the name passed into this
+// function.
+// In case of failure, it returns an error including the error code it received from Cplex. 
+// This function uses CPXchgprobname.
+func (p *Problem) ChgProbName(Name string) error {
 
-//------------------------------------------------------------------------------
-// Get objective function value
-int cGetObjVal(double *objval) {
+	if err := p.checkProb(); err != nil {
+		return err
+	}
 
-	int status;
+	if Name == "" {
+		Name = "ChangedDefaultName"
+	}
+			
+	if err := p.slv.ChgProbName(Name); err != nil {
+		return err
+	}
+	p.name = Name
 
-	status = CPXgetobjval(env, lp, objval);
-	if (status) {
-		fprintf(stderr, "Failed to obtain objective value, error %d.\n", status);
-		return status;
-	}	
-		
-	return status;
+	return nil
 }
 
-//------------------------------------------------------------------------------
-// Get Solution
-int cGetSolution(double *objval, double *x, double *dj, double *pi, double *slack) {
+//==============================================================================
+
+// ChgObjSen changes the sense of the objective function depending on the value
+// specified by the user.
+// In case of failure, it returns an error including the error code it received from Cplex. 
+// This function uses CPXchgobjsen. By default, the sense is set to "minimize".
+// 	Supported values of sense are:
+//		 1 - minimize
+//		-1 - maximize
+func (p *Problem) ChgObjSen(sense int) error {
 
-	int status, solstat;
+	if err := p.checkProb(); err != nil {
+		return err
+	}
 
-	status = CPXsolution(env, lp, &solstat, objval, x, pi, slack, dj);
-	if (status) {
-		fprintf(stderr, "Failed to obtain solution, error %d.\n", status);
-		return status;
-	}	
+	switch sense {
+		case -1, 1:
+			return p.slv.ChgObjSen(sense)
 		
-	return status;
+		default:
+			return errors.Errorf("Unexpected objective function sense %d", sense)
+	}
 }
 
-//------------------------------------------------------------------------------
-
-int cGetColNames(int numCols, char **cur_colname, char *cur_colnamestore, int storeSize) {
-	
-	int status;
-	int surplus, cur_colspace;
-	int j;	
-
-	if (storeSize > 0) {
+//==============================================================================
 
-	    status = CPXgetcolname (env, lp, cur_colname, cur_colnamestore, 
-					storeSize, &surplus, 0,numCols-1);
+// NewRows creates the new rows specified for this problem. In case of failure,
+// it returns an error including the error code it received from Cplex. 
+// This function uses CPXnewrows.
+//	The values supported in the Sense field of the rList structure are:
+//		L - less than or equal
+//		E - equal
+//		G - greater than or equal
+//		R - range
+//	If a constraint has rList[i].Sense = "R", the value of constraint i can be
+//	between rList[i].Rhs and (rList[i].Rhs + rList[i].RngVal). For all other cases,
+//	RngVal is set to zero.
+func (p *Problem) NewRows(rList []InputRow) error {
 
-		if (status) {
-	    	fprintf (stderr, "CPXgetcolname failed.\n");
-			return status;	
-    	}
+	if err := p.checkProb(); err != nil {
+		return err
 	}
-	else {
-    	fprintf (stderr, "No names associated with problem\n");
-		return 1;
-	}
-
-	return status;	
-}
 
-//------------------------------------------------------------------------------
+	if len(rList) < 1 {
+		return errors.Errorf("NewRows expected more than %d rows", len(rList))	
+	}
 
-int cGetRowNames(int numRows, char **cur_rowname, char *cur_rownamestore, int storeSize) {
+	for i := 0; i < len(rList); i++ {
+		if rList[i].Sense == "" {
+			return errors.Errorf("NewRows found no sense for row %d", i)
+		}
+	}
+		
+	return p.slv.NewRows(rList)
 	
-	int status = 0;
-	int surplus;
+} // End NewRows
+
+//==============================================================================
 
-	if (storeSize > 0) {
+// NewCols creates the new columns specified for this problem. In case of failure,
+// it returns an error including the error code it received from Cplex. 
+// This function uses CPXnewcols.
+//	The Type field in the cList data structure supports the following values:
+//		C - continuous variable (CPX_CONTINUOUS); only value supported by CPXlpopt
+//		B - binary variable (CPX_BINARY)
+//		I - general integer variable (CPX_INTEGER)
+//		S - semi-continuous variable (CPX_SEMICONT)
+//		N - semi-integer variable (CPX_SEMIINT)
+//	Any value other than 'C' (continuous) is interpreted by Cplex as a MIP. If
+//	function CPXlpopt is called for a problem containing anything other than
+//	continuous variables, it will fail with a CPXERR_NOT_FOR_MIP error. 
+func (p *Problem) NewCols(objList []InputObjCoef, cList []InputCol) error {
 
-	    status = CPXgetrowname (env, lp, cur_rowname, cur_rownamestore, 
-					storeSize, &surplus, 0,numRows-1);
+	if err := p.checkProb(); err != nil {
+		return err
+	}
 
-		if (status) {
-	    	fprintf (stderr, "CPXgetrowname failed.\n");
-			return status;	
-    	}
+	// The column list must be provided. 
+	if len(cList) < 1 {
+		return errors.Errorf("NewCols expected more than %d columns", len(cList))			
 	}
-	else {
-    	fprintf (stderr, "No names associated with problem\n");
-		return 1;
+
+	for i := 0; i < len(cList); i++ {
+		if cList[i].Type == "" {
+			return errors.Errorf("NewCols found no type for column %d", i)
+		}
 	}
 
-	return status;	
+	// Objective function coefficients refer to the columns being created.
+	for i := 0; i < len(objList); i++ {
+		if objList[i].ColIndex < 0 || objList[i].ColIndex >= len(cList) {
+			return errors.Errorf("NewCols found objective coefficient for column %d, expected 0 to %d",
+				objList[i].ColIndex, len(cList) - 1)
+		}
+	}	
+			
+	return p.slv.NewCols(objList, cList)
 }
 
+//==============================================================================
 
-//------------------------------------------------------------------------------
-// Get the value of the variables
-int cGetX(int numCols, double *cColVal) {
+// ChgCoefList modifies the non-zero coefficients specified for the problem. 
+// The rows and columns, created by other functions, are assumed to exist. 
+// In case of failure, it returns an error including the error code it received from Cplex. 
+// This function uses CPXchgcoeflist.
+func (p *Problem) ChgCoefList(eList []InputElem) error {
 
-	int status = 0;
-		
-	status = CPXgetx(env, lp, cColVal, 0, numCols - 1);
-	if ( status ) {
-		fprintf (stderr, "Failed to get column values, error %d.\n", status);
-		return status;
+	if err := p.checkProb(); err != nil {
+		return err
 	}
 
-	return status;	
+	if len(eList) < 1 {
+		return errors.Errorf("ChgCoefList expected more than %d elements", len(eList))			
+	}
+	
+	return p.slv.ChgCoefList(eList)
 }
 
-//------------------------------------------------------------------------------
-// Get the slack of the rows
-int cGetSlack(int numRows, double *cSlack) {
+//==============================================================================
+// FUNCTIONS FOR SOLVING THE PROBLEM AND GETTING SOLUTION (Get series functions)
+//==============================================================================
 
-	int status = 0;
-		
-	status = CPXgetslack(env, lp, cSlack, 0, numRows - 1);
-	if ( status ) {
-		fprintf (stderr, "Failed to get slack values, error %d.\n", status);
-		return status;
-	}
+// LpOpt solves the LP, which must is assumed to have been defined by
+// other functions. 
+// In case of failure, it returns an error including the error code it received from Cplex. 
+// This function uses CPXlpopt.
+//
+// The model can contain only continuous ('C') variables. The presence
+// of any other variable type will cause this function to fail with a CPXERR_NOT_FOR_MIP
+// error.
+func (p *Problem) LpOpt() error {
 
-	return status;	
+	return p.LpOptContext(context.Background())
 }
 
+//==============================================================================
 
-//------------------------------------------------------------------------------
-
-int cReadCopyProb(char *cFileName, char *cFileType) {
-
-	int status = 0;
-		
-	status = CPXreadcopyprob(env, lp, cFileName, cFileType);
-	if ( status ) {
-		fprintf (stderr, "Failed to read and copy the problem data, error %d.\n", status);
-		return status;
-	}
+// LpOptContext solves the LP in the same way as LpOpt, but aborts the solve
+// once the context passed into this function is cancelled or its deadline
+// passes. The problem is then left with the status StatAbortUser, and the
+// function returns an error wrapping ctx.Err(), which can be tested with
+// errors.Is(err, context.Canceled) or errors.Is(err, context.DeadlineExceeded).
+// If the context is already done, the solve is not started.
+// In case of failure, it returns an error including the error code it received from Cplex. 
+// This function uses CPXsetterminate and CPXlpopt.
+func (p *Problem) LpOptContext(ctx context.Context) error {
 
-	return status;	
+	return p.optimize(ctx, "LpOpt", func(ctx context.Context) error {
+		return p.slv.LpOpt(ctx)
+	})
 }
 
-//------------------------------------------------------------------------------
-
-int cWriteProb(char *cFileName, char *cFileType) {
+//==============================================================================
 
-	int status = 0;
-		
-	status = CPXwriteprob(env, lp, cFileName, cFileType);
-	if ( status ) {
-		fprintf(stderr, "Failed to write problem, error %d.\n", status);
-		return status;
-	}
+// MipOpt solves the mixed integer problem, which is assumed to have been defined by
+// other functions. 
+// In case of failure, it returns an error including the error code it received from Cplex. 
+// This function uses CPXmipopt.
+func (p *Problem) MipOpt() error {
 
-	return status;	
+	return p.MipOptContext(context.Background())
 }
 
-//------------------------------------------------------------------------------
+//==============================================================================
 
-int cSolWrite(char *cFileName) {
-	
-	int status = 0;
-	
-	status = CPXsolwrite(env, lp, cFileName);
-	if ( status ) {
-		fprintf (stderr, "Failed to write solution file.\n");
-		return status;
-	}
-	
-	return status;
+// MipOptContext solves the mixed integer problem in the same way as MipOpt, but
+// aborts the solve once the context passed into this function is cancelled or
+// its deadline passes. The problem is then left with the status MipAbortFeas,
+// in which case the best integer solution found so far can still be retrieved
+// with GetMipSolution, or MipAbortInfeas. The function returns an error
+// wrapping ctx.Err(), as LpOptContext does.
+// If the context is already done, the solve is not started.
+// In case of failure, it returns an error including the error code it received from Cplex. 
+// This function uses CPXsetterminate and CPXmipopt.
+func (p *Problem) MipOptContext(ctx context.Context) error {
 
+	return p.optimize(ctx, "MipOpt", func(ctx context.Context) error {
+		return p.slv.MipOpt(ctx)
+	})
 }
 
-//------------------------------------------------------------------------------
-// Clean up and terminate CPLEX. This should be called from the Go functions
-// and not from the C functions if an error condition occurs.
-int cCloseCplex() {
+//==============================================================================
 
-	int status = 0;
-	
-// Free up the solution (more stuff goes here later).
+// optimize runs one of the optimizers of the backend, such as LpOpt, MipOpt,
+// QPOpt or Populate, with the solver messages and progress events of the
+// problem installed. If ctx can be cancelled, the optimizer is not started if
+// ctx is already done, and an aborted solve is reported as LpOptContext does.
+func (p *Problem) optimize(ctx context.Context, op string, run func(context.Context) error) error {
 
-	if (lp != NULL) {
-		status = CPXfreeprob (env, &lp);
-		if (status) {
-			fprintf(stderr, "CPXfreeprob failed with error %d.\n", status);	
-		}
+	if err := p.checkProb(); err != nil {
+		return err
 	}
 
-	if (env != NULL) {
-		status = CPXcloseCPLEX(&env);
-		if (status) {
-			fprintf(stderr, "CPXcloseCPLEX failed with error %d.\n", status);
-		}	
+	if err := ctx.Err(); err != nil {
+		return errors.Wrapf(err, "%s not started", op)
 	}
 
-	return status;
+	end, err := p.beginSolve()
+	if err != nil {
+		return err
+	}
+	defer end()
+
+	if err = run(ctx); err != nil {
+		return err
+	}
+
+	if ctx.Done() == nil {
+		return nil
+	}
+
+	return p.checkAborted(ctx, op)
+}
+
+//==============================================================================
+
+// checkAborted returns an error wrapping ctx.Err() if the solve was aborted
+// because the context is done, and nil otherwise.
+func (p *Problem) checkAborted(ctx context.Context, op string) error {
+
+	status, err := p.slv.Status()
+	if err != nil {
+		return err
+	}
+
+	if status.IsAborted() && ctx.Err() != nil {
+		return errors.Wrapf(ctx.Err(), "%s aborted, status is %s", op, status)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// GetSolution obtains the solution for a linear problem (LP) from Cplex. 
+// If no solution exists, or the solution is not primal feasible (e.g. the LP is
+// infeasible or unbounded), it returns an error including the solution status;
+// use GetStatus to find out why.
+// In case of failure, it returns an error including the error code it received from Cplex. 
+// This function uses CPXgetsolution as well as all other auxiliary
+// functions, such as CPXgetrowname, CPXgetcolname, and others that may be needed
+// in order to populate the solution data structures passed back to the user.
+func (p *Problem) GetSolution(objVal *float64, sRows *[]SolnRow, sCols *[]SolnCol) error {
+
+	var numRows  int        // Number of rows in the model
+	var numCols  int        // Number of columns in the model
 	
-} // End cTerminate
+	var curCol SolnCol
+	var curRow SolnRow
+	var err error
 
+	if err = p.checkProb(); err != nil {
+		return err
+	}
 
- */
-import "C"
+	if err = p.checkFeasible(); err != nil {
+		return errors.Wrap(err, "GetSolution failed")
+	}
 
-import (
-	"github.com/pkg/errors"
-	"unsafe"
-)
+	// Get actual number of rows and columns and allocate memory for solution.
+	numRows = p.slv.NumRows()
+	numCols = p.slv.NumCols()
 
+	xVal  := make([]float64, numCols)
+	rCost := make([]float64, numCols)
 
-var plInfySmall = 1.0e10          // Default infinity in lpo package
-var plInfyLarge = 1.0e20          // Default CPX_INFBOUND in Cplex
+	pi    := make([]float64, numRows)
+	slack := make([]float64, numRows)
 
-// Input data structures passed to gpx to define a problem.
+	if *objVal, err = p.slv.Solution(xVal, rCost, pi, slack); err != nil {
+		return err
+	}
+
+	for i := 0; i < numCols; i++ {
+		curCol.Value   = xVal[i]
+		curCol.RedCost = rCost[i]
+		*sCols = append(*sCols, curCol)	
+	}
+	
+	if err = p.GetColName(*sCols); err != nil {
+		return errors.Wrap(err, "GetSolution failed to get column names")
+	}
+
+	for i := 0; i < numRows; i++ {
+		curRow.Pi    = pi[i]
+		curRow.Slack = slack[i]
+		*sRows = append(*sRows, curRow)
+	}	
+
+	if err = p.GetRowName(*sRows); err != nil {
+		return errors.Wrap(err, "GetSolution failed to get row names")
+	}
+				
+	return nil
 
-// InputRow defines a data structure passed as an
-// input argument to functions when creating the rows of the problem in Cplex.
-type InputRow struct {
-	Name   string      // Name of the row (constraint)
-	Sense  string      // Sense (L, E, G, R) of the row as supported by Cplex
-	Rhs    float64     // Value of the RHS, or lower boundary of the range
-	RngVal float64     // For ranges, the range is defined as (Rhs to [Rhs + RngVal])	
 }
 
-// InputCol defines a data structure passed as an input
-// argument to functions when creating the columns of the problem in Cplex.
-type InputCol struct {
-	Name   string      // Name of the column (variable)
-	Type   string      // Type of the column as supported by Cplex
-	BndLo  float64     // Lower bound of the column
-	BndUp  float64 	   // Upper bound of the column
+//==============================================================================
+
+// GetMipSolution obtains the solution for a mixed integer problem (MIP) from Cplex. 
+// If no integer feasible solution exists, it returns an error including the
+// solution status; use GetStatus to find out why.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function creates the slices needed for the result and populates all
+// applicable fields with values provided by Cplex.
+// This function uses other gpx functions and indirectly call CPXgetnumrows, 
+// CPXgetnumcols, CPXgetrowname, CPXgetcolname, CPXgetobjval, CPXgetslack, and CPXgetx.
+func (p *Problem) GetMipSolution(objVal *float64, sRows *[]SolnRow, sCols *[]SolnCol) error {
+
+	var numRows, numCols  int  // Number of rows and columns in the model
+	var err             error  // Error returned by the functions called
+
+	if err = p.checkProb(); err != nil {
+		return err
+	}
+
+	// Initialize the return values
+	*sRows  = nil
+	*sCols  = nil
+	*objVal = 0.0
+
+	if err = p.checkFeasible(); err != nil {
+		return errors.Wrap(err, "GetMipSolution failed")
+	}
+	
+	// Get the number of rows and columns, and allocate the memory for them.
+	if err = p.GetNumRows(&numRows); err != nil {
+		return errors.Wrap(err, "GetMipSolution failed to get number of rows") 
+	}
+
+	if err = p.GetNumCols(&numCols); err != nil {
+		return errors.Wrap(err, "GetMipSolution failed to get number of columns") 
+	}
+	
+	*sRows = make([]SolnRow, numRows)
+	*sCols = make([]SolnCol, numCols)
+	
+	if err = p.GetObjVal(objVal); err != nil {
+		return errors.Wrap(err, "GetMipSolution failed to get objective function value") 		
+	}	
+
+
+	if err = p.GetRowName(*sRows); err != nil {
+		return errors.Wrap(err, "GetMipSolution failed to get row names") 		
+	}	
+
+	if err = p.GetSlack(*sRows); err != nil {
+		return errors.Wrap(err, "GetMipSolution failed to get row slack") 		
+	}	
+
+	
+	if err = p.GetColName(*sCols); err != nil {
+		return errors.Wrap(err, "GetMipSolution failed to get column names") 		
+	}	
+
+	if err = p.GetX(*sCols); err != nil {
+		return errors.Wrap(err, "GetMipSolution failed to get column values") 		
+	}	
+	
+	return nil
+
 }
 
-// InputElem defines a data structure passed as an
-// input argument to functions when changing non-zero coefficients of the problem
-// in Cplex.
-type InputElem struct {
-	RowIndex  int      // Row index for this element (coefficient)
-	ColIndex  int      // Column index for this element
-	Value     float64  // Value of this element
+//==============================================================================
+
+// checkFeasible returns an error if the problem does not have a primal feasible
+// solution. The error includes the solution status.
+func (p *Problem) checkFeasible() error {
+
+	info, err := p.slv.SolnInfo()
+	if err != nil {
+		return err
+	}
+
+	if info.Type != SolnNone && info.PrimalFeasible {
+		return nil
+	}
+
+	status, err := p.slv.Status()
+	if err != nil {
+		return err
+	}
+
+	if info.Type == SolnNone {
+		return errors.Wrapf(ErrNoSolution, "Status is '%s'", status)
+	}
+
+	return errors.Wrapf(ErrNoSolution, "Solution is not primal feasible, status is '%s'", status)
 }
 
-// InputObjCoef defines a data structure of all coefficients
-// present in the objective function. It is passed as an input argument to functions
-// which create the columns of the problem in Cplex.
-type InputObjCoef struct {
-	ColIndex  int      // Column index of this coefficient in the objective function
-	Value     float64  // Value of the coefficient in the objective function
+//==============================================================================
+
+// GetStatus obtains the status of the solution of the problem, for example
+// StatOptimal, StatInfeasible or MipNodeLimFeas, or StatNone if no solution
+// exists. LpOpt and MipOpt only return an error if the optimizer could not be
+// run; this function reports the outcome of the optimization.
+// In case of failure, it returns an error including the error code it receives
+// from Cplex.
+// This function uses CPXgetstat.
+func (p *Problem) GetStatus(status *SolutionStatus) error {
+	var err error      // Error returned by the backend
+
+	*status = StatNone
+
+	if err = p.checkProb(); err != nil {
+		return err
+	}
+
+	*status, err = p.slv.Status()
+
+	return err
 }
 
-// Output data structures which contain the solution provided by Cplex.
+//==============================================================================
 
-// SolnRow defines a data structure of the solved rows returned from Cplex.
-type SolnRow struct {
-	Name    string     // Name of the row in the solution data structure
-	Slack   float64    // Slack for this row as calculated by Cplex
-	Pi      float64    // Pi for this row as calculated by Cplex
+// GetSolnInfo obtains the method used to compute the solution of the problem,
+// the type of solution, and whether Cplex considers it primal and dual
+// feasible. If no solution exists, the method is AlgNone and the type SolnNone.
+// In case of failure, it returns an error including the error code it receives
+// from Cplex.
+// This function uses CPXsolninfo.
+func (p *Problem) GetSolnInfo(info *SolnInfo) error {
+	var err error      // Error returned by the backend
+
+	*info = SolnInfo{Method: AlgNone, Type: SolnNone}
+
+	if err = p.checkProb(); err != nil {
+		return err
+	}
+
+	*info, err = p.slv.SolnInfo()
+
+	return err
 }
 
-// SolnCol defines a data structure of the solved columns returned from Cplex.
-type SolnCol struct {
-	Name    string     // Name of the column in the solution data structure
-	Value   float64    // Value for this column as calculated by Cplex
-	RedCost float64    // Reduced cost for this column as calculated by Cplex
+//==============================================================================
+
+// GetNumRows obtains the number of rows in the current problem, or 0 if none exist
+// or the problem has not yet been defined. At this time the function always returns
+// nil (success).
+// This function uses CPXgetnumrows. 
+func (p *Problem) GetNumRows(numRows *int) error {
+
+	*numRows = 0
+	if p.checkProb() == nil {
+		*numRows = p.slv.NumRows()
+	}
+
+	return nil	
 }
 
 //==============================================================================
-// FUNCTIONS FOR CREATING THE PROBLEM
+
+// GetNumCols obtains the number of columns in the current problem, or 0 if none exist
+// or the problem has not yet been defined. At this time the function always returns
+// nil (success).
+// This function uses CPXgetnumcols. 
+func (p *Problem) GetNumCols(numCols *int) error {
+
+	*numCols = 0
+	if p.checkProb() == nil {
+		*numCols = p.slv.NumCols()
+	}
+
+	return nil	
+}
+
 //==============================================================================
 
-// CreateProb initializes the Cplex environment and creates a problem with the
-// name passed into this function. 
-// In case of failure, it returns an error including the error code it received from Cplex. 
-// This function uses CPXopenCPLEX, CPXsetintparam, and CPXcreateprob.
-func CreateProb(Name string) error {
-	var status C.int      // Status returned from Cplex
+// GetObjVal obtains the value of the objective function. 
+// In case of failure, it returns an error including the error code it receives
+// from Cplex.
+// This function uses CPXgetobjval.
+func (p *Problem) GetObjVal(objVal *float64) error {
+	var err error      // Error returned by the backend
 
-	if Name == "" {
-		Name = "DefaultName"
+	*objVal = 0
+
+	if err = p.checkProb(); err != nil {
+		return err
 	}
-			
-	status = C.cOpenCplex()
-	if status != 0 {
-		return errors.Errorf("Cplex open failed with error %d", status)	
+
+	*objVal, err = p.slv.ObjVal()
+
+	return err	
+}
+
+//==============================================================================
+
+// GetBestObjVal obtains the best bound on the optimal objective function value
+// found by MipOpt. Together with the objective value of the incumbent, it
+// bounds how far the solution may be from optimal if the search stopped at a
+// limit or within the gap tolerance.
+// In case of failure, it returns an error including the error code it receives
+// from Cplex.
+// This function uses CPXgetbestobjval.
+func (p *Problem) GetBestObjVal(bestObjVal *float64) error {
+	var err error      // Error returned by the backend
+
+	*bestObjVal = 0
+
+	if err = p.checkProb(); err != nil {
+		return err
 	}
 
-	status = C.cCheckData()
-	if status != 0 {
-		return errors.Errorf("Enabling data checking failed with error %d", status)
-	}	
+	*bestObjVal, err = p.slv.BestObjVal()
 
-	cString := C.CString(Name)
-	defer C.free(unsafe.Pointer(cString))
-	status = C.cCreateProb(cString)
-	if status != 0 {
-		return errors.Errorf("Creating problem failed with error %d", status)
-	}	
-			
-	return nil
+	return err
 }
 
 //==============================================================================
 
-// OutputToScreen turns Cplex output to screen on or off. 
-// In case of failure, it returns an error including the error code it received from Cplex. 
-// This function uses CPXsetintparam with CPXPARAM_ScreenOutput set to CPX_ON if the value
-// passed to this function is "true", or CPX_OFF if false. By default, output is not
-// printed to the screen.
-func OutputToScreen(echoOn bool) error {
-	var status, cEchoState C.int  // Status returned from Cplex
+// GetMipRelGap obtains the relative gap between the objective function value
+// of the solution found by MipOpt and the best bound, computed as
+// |bestbound - objval| / (1.0e-10 + |objval|).
+// In case of failure, it returns an error including the error code it receives
+// from Cplex.
+// This function uses CPXgetmiprelgap.
+func (p *Problem) GetMipRelGap(gap *float64) error {
+	var err error      // Error returned by the backend
+
+	*gap = 0
 
-	// CPX_ON = 1, CPX_OFF = 0, but unfortunately we can't call these constants
-	// here, and to be on the safe side, we will re-map on the C side in case Cplex changes.		
-	if echoOn {
-		cEchoState = 1	
-	} else {
-		cEchoState = 0
+	if err = p.checkProb(); err != nil {
+		return err
 	}
-	
-	status = C.cOutputToScreen(cEchoState)
-	if status != 0 {
-		return errors.Errorf("Cplex failed to turn on screen output with error %d", status)
-	}	
 
-	return nil	
+	*gap, err = p.slv.MipRelGap()
+
+	return err
 }
 
 //==============================================================================
 
-// ChgProbName initializes the Cplex environment and creates a problem with the
-// name passed into this function.
-// In case of failure, it returns an error including the error code it received from Cplex. 
-// This function uses CPXchgprobname.
-func ChgProbName(Name string) error {
-	var status C.int    // Status returned from Cplex
+// GetColName populates the solution column slice passed to the function with
+// the column names being used by Cplex. It does not populate any other fields in
+// the data structures, and assumes that the slice is large enough to contain all
+// of the column names (i.e. it does not modify the size in case of mismatch).
+// In case of failure, it returns an error including the error code it receives
+// from Cplex.
+// This function uses CPXgetnumcols and CPXgetcolname.
+func (p *Problem) GetColName(sCols []SolnCol) error {
+	var numCols    int  // Number of columns in the model
 
-	if Name == "" {
-		Name = "ChangedDefaultName"
+	if err := p.checkProb(); err != nil {
+		return err
 	}
-			
-	cString := C.CString(Name)
-	defer C.free(unsafe.Pointer(cString))
-	status = C.cChgProbName(cString)
-	if status != 0 {
-		return errors.Errorf("Changing problem name failed with error %d", status)
-	}	
-			
+
+	numCols = p.slv.NumCols()
+
+	if len(sCols) != numCols {
+		return errors.Errorf("Number of cols in target is %d, expected %d", 
+			len(sCols), numCols)		
+	}
+
+	names, err := p.slv.ColNames()
+	if err != nil {
+		return err
+	}
+	
+	for i := 0; i < numCols; i++ {
+		sCols[i].Name = names[i]
+	}
+
 	return nil
 }
 
+
 //==============================================================================
 
-// ChgObjSen changes the sense of the objective function depending on the value
-// specified by the user.
-// In case of failure, it returns an error including the error code it received from Cplex. 
-// This function uses CPXchgobjsen. By default, the sense is set to "minimize".
-// 	Supported values of sense are:
-//		 1 - minimize
-//		-1 - maximize
-func ChgObjSen(sense int) error {
-	var status C.int   // Status returned from Cplex
+// GetRowName populates the solution row slice passed to the function with
+// the row names being used by Cplex. It does not populate any other fields in
+// the data structures, and assumes that the slice is large enough to contain all
+// of the row names (i.e. it does not modify the size in case of mismatch).
+// In case of failure, it returns an error including the error code it receives
+// from Cplex.
+// This function uses CPXgetnumrows and CPXgetrowname.
+func (p *Problem) GetRowName(sRows []SolnRow) error {
+	var numRows    int  // Number of rows in the model
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	numRows = p.slv.NumRows()
+
+	if len(sRows) != numRows {
+		return errors.Errorf("Number of rows in target is %d, expected %d", 
+			len(sRows), numRows)		
+	}
 
-	// CPX_ON = 1, CPX_OFF = 0, but unfortunately we can't call these constants
-	// here, and to be on the safe side, we will re-map on the C side in case Cplex changes.
+	names, err := p.slv.RowNames()
+	if err != nil {
+		return err
+	}
 	
-	switch sense {
-		case -1, 1:
-			status = C.cChgObjSen(C.int(sense))
-			if status != 0 {
-				return errors.Errorf("Failed to change objective sense, error %d", status)
-			}	
-		
-		default:
-			return errors.Errorf("Unexpected objective function sense %d", sense)
+	for i := 0; i < numRows; i++ {
+		sRows[i].Name = names[i]
 	}
+		
+	return nil
 	
-	return nil	
 }
 
 //==============================================================================
 
-// NewRows creates the new rows specified for this problem. In case of failure,
-// it returns an error including the error code it received from Cplex. 
-// This function uses CPXnewrows.
-//	The values supported in the Sense field of the rList structure are:
-//		L - less than or equal
-//		E - equal
-//		G - greater than or equal
-//		R - range
-//	If a constraint has rList[i].Sense = "R", the value of constraint i can be
-//	between rList[i].Rhs and (rList[i].Rhs + rList[i].RngVal). For all other cases,
-//	RngVal is set to zero.
-func NewRows(rList []InputRow) error {
-	var nameArray  []string	    // Array of row names passed to Cplex
-	var cChar        C.char     // Temporary variable for processing C chars
-	var cCharArray []C.char     // Char array needed for C functions
-	var status       C.int      // Status returned from Cplex
-	var cRhs       []C.double   // RHS value passed to Cplex
-	var cRngVal    []C.double   // Range values passed to Cplex
+// GetX populates the solution column slice passed to the function with
+// the optimal value of the variable as calculated by Cplex. It does not populate 
+// any other fields in the data structures, and assumes that the slice is large 
+// enough to contain all data (i.e. it does not modify the size in case of mismatch).
+// In case of failure, it returns an error including the error code it receives
+// from Cplex.
+// This function uses CPXgetnumcols and CPXgetx.
+func (p *Problem) GetX(sCols []SolnCol) error {
+	var numCols     int   // Number of columns in the model
 
+	if err := p.checkProb(); err != nil {
+		return err
+	}
 
-	if len(rList) < 1 {
-		return errors.Errorf("NewRows expected more than %d rows", len(rList))	
+	numCols = p.slv.NumCols()
+
+	if len(sCols) < numCols {
+		return errors.Errorf("Number of cols in target is %d, expected %d", 
+			len(sCols), numCols)		
 	}
 
-	// Build C array of row names
-	for i := 0; i < len(rList); i++ {
-		nameArray = append(nameArray, rList[i].Name)
-	}			
+	xVal := make([]float64, numCols)
+	if err := p.slv.X(xVal); err != nil {
+		return err
+	}
+
+	// Transfer the column value to the slice passed to us.
+	for i := 0; i < numCols; i++ {
+		sCols[i].Value = xVal[i]
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// GetSlack populates the solution row slice passed to the function with
+// the slack value of the constraint as calculated by Cplex. It does not populate 
+// any other fields in the data structures, and assumes that the slice is large 
+// enough to contain all data (i.e. it does not modify the size in case of mismatch).
+// In case of failure, it returns an error including the error code it receives
+// from Cplex.
+// This function uses CPXgetnumrows and CPXgetslack.
+func (p *Problem) GetSlack(sRows []SolnRow) error {
+	var numRows     int  // Number of rows in the model
 
-	cNameArray := C.makeCharArray(C.int(len(rList)))
-	defer C.freeCharArray(cNameArray, C.int(len(rList)))
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	numRows = p.slv.NumRows()
+
+	if len(sRows) < numRows {
+		return errors.Errorf("Number of rows in target is %d, expected %d", 
+			len(sRows), numRows)		
+	}
 
-	for i, s := range nameArray {
-		cString := C.CString(s)
-        C.setArrayString(cNameArray, cString, C.int(i))
-		// The cString pointers are freed as part of freeCharArray function, not here.
+	slack := make([]float64, numRows)
+	if err := p.slv.Slack(slack); err != nil {
+		return err
+	}
+
+	// Transfer the row slack to the slice passed to us.
+	for i := 0; i < numRows; i++ {
+		sRows[i].Slack = slack[i]
 	}
 
-	// Construct the lists of the other C parameters	
-	for i := 0; i < len(rList); i++ {
-		runes     := []rune(rList[i].Sense)
-		cChar      = C.char(runes[0])
-		cCharArray = append(cCharArray, cChar)		
-		cRhs       = append(cRhs, C.double(rList[i].Rhs))
-		cRngVal    = append(cRngVal, C.double(rList[i].RngVal))
-	} // End for list of rows
-		
-	status = C.cCreateRows(C.int(len(cRhs)), &cCharArray[0], cNameArray, &cRhs[0], &cRngVal[0])
-	if status != 0 {
-		return errors.Errorf("Creating rows failed with error %d", status)
-	}	
-	
 	return nil
-	
-} // End NewRows
+}
 
+//==============================================================================
+// FUNCTIONS FOR PROCESSING FILES AND MISCELANEOUS FUNCTIONALITY
 //==============================================================================
 
-// NewCols creates the new columns specified for this problem. In case of failure,
-// it returns an error including the error code it received from Cplex. 
-// This function uses CPXnewcols.
-//	The Type field in the cList data structure supports the following values:
-//		C - continuous variable (CPX_CONTINUOUS); only value supported by CPXlpopt
-//		B - binary variable (CPX_BINARY)
-//		I - general integer variable (CPX_INTEGER)
-//		S - semi-continuous variable (CPX_SEMICONT)
-//		N - semi-integer variable (CPX_SEMIINT)
-//	Any value other than 'C' (continuous) is interpreted by Cplex as a MIP. If
-//	function CPXlpopt is called for a problem containing anything other than
-//	continuous variables, it will fail with a CPXERR_NOT_FOR_MIP error. 
-func NewCols(objList []InputObjCoef, cList []InputCol) error {
+// ReadCopyProb reads the data file specified by its name and type, and populates
+// the problem from information contained in this file.
+// In case of failure, it returns an error including the error code it receives
+// from Cplex.
+// This function uses CPXreadcopyprob.
+//	The following fileType values are supported:
+//		SAV - binary format
+//		MPS - MPS format
+//		LP  - LP format
+func (p *Problem) ReadCopyProb(fileName string, fileType string) error {
 
-	var nameArray  []string	    // Array of column names
-	var cChar        C.char     // Temporary variable for processing C chars
-	var status       C.int      // Status returned from Cplex
-	var cCharArray []C.char     // Column names passed to Cplex     
-	var lb, ub     []C.double   // Upper and lower bounds of cols passed to Cplex
-	var isMip        C.int      // Flag set to true if problem is a MIP 
+	if err := p.checkProb(); err != nil {
+		return err
+	}
 
-	// The column list must be provided. 
-	if len(cList) < 1 {
-		return errors.Errorf("NewCols expected more than %d columns", len(cList))			
+	defer p.logScope()()
+
+	return p.slv.ReadCopyProb(fileName, fileType)
+}
+
+//==============================================================================
+
+// WriteProb writes current Cplex problem to the data file specified by its 
+// name and type.
+// In case of failure, it returns an error including the error code it receives
+// from Cplex.
+// This function uses CPXwriteprob.
+//	The following fileType values are supported:
+//		SAV - binary matrix and basis file
+//		MPS - MPS format
+//		LP  - CPLEX LP format with names modified to conform to LP format
+//		REW - MPS format with all names changed to generic names
+//		ALP - LP format with generic name, type, and bound of each variable
+//	If the file name ends with one of the following extensions, a compressed
+//	file is written:
+//		.bz2 - files compressed with BZip2
+//		.gz  - files compressed with GNU Zip
+func (p *Problem) WriteProb(fileName string, fileType string) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
 	}
 
-	// Build C array of objective function coefficients.
-	obj := make([]C.double, len(cList))
+	defer p.logScope()()
 
-	for i := 0; i < len(objList); i++ {
-		obj[objList[i].ColIndex] = C.double(objList[i].Value)
-	}	
+	return p.slv.WriteProb(fileName, fileType)
+}
 
-	// Build C array of column names				
-	for i := 0; i < len(cList); i++ {
-		nameArray = append(nameArray, cList[i].Name)
-	}			
+//==============================================================================
 
-	cNameArray := C.makeCharArray(C.int(len(nameArray)))
-	defer C.freeCharArray(cNameArray, C.int(len(nameArray)))
+// SolWrite writes the current Cplex problem to the data file specified by its 
+// name and type.
+// In case of failure, it returns an error including the error code it receives
+// from Cplex.
+// This function uses CPXwriteprob.
+func (p *Problem) SolWrite(fileName string) error {
 
-	for i, s := range nameArray {
-		cString := C.CString(s)
-        C.setArrayString(cNameArray, cString, C.int(i))
-		// The cString pointers are freed as part of freeCharArray function, not here.
+	if err := p.checkProb(); err != nil {
+		return err
 	}
 
-	
-	// Build C array of upper and lower bounds of the variables.
-	// Due to implementation of CPXnewrows, we also need to check whether there are
-	// any non-contiguous variables so entire array (as opposed to NULL) can be passed
-	// to Cplex.
-	
-	isMip = 0
-				
-	for i := 0; i < len(cList); i++ {
-		runes     := []rune(cList[i].Type)
-		cChar      = C.char(runes[0])
-		cCharArray = append(cCharArray, cChar)		
-		lb         = append(lb, C.double(cList[i].BndLo))
-		ub         = append(ub, C.double(cList[i].BndUp))
-		
-		if cList[i].Type != "C" {
-			isMip = 1
-		}										
-	}	
+	defer p.logScope()()
 
-	// Call the C function which passes the arrays to Cplex.	
-	status = C.cCreateCols(isMip, C.int(len(cList)), &obj[0], cNameArray, &cCharArray[0], &lb[0], &ub[0])
-	if status != 0 {
-		return errors.Errorf("Creating columns failed with error %d", status)
-	}	
+	return p.slv.SolWrite(fileName)
+}
+
+//==============================================================================
+// PACKAGE-LEVEL FUNCTIONS OPERATING ON THE DEFAULT PROBLEM
+//==============================================================================
+
+// The functions below preserve the original gpx interface, in which a single
+// environment and problem are shared by the whole program. Each of them calls
+// the method of the same name on the default problem created by CreateProb.
+
+// getDefaultProb returns the default problem, or an error if CreateProb has not
+// been called.
+func getDefaultProb() (*Problem, error) {
+
+	if defaultProb == nil {
+		return nil, errors.Wrap(ErrNoProblem, "CreateProb must be called first")
+	}
+
+	return defaultProb, nil
+}
+
+//==============================================================================
+
+// CreateProb initializes the Cplex environment and creates a problem with the
+// name passed into this function. This problem becomes the default problem used
+// by all other package-level functions. If a default problem already exists,
+// it and its environment are closed first.
+// In case of failure, it returns an error including the error code it received from Cplex. 
+// This function uses CPXopenCPLEX, CPXsetintparam, and CPXcreateprob.
+func CreateProb(Name string) error {
+	var env   *Env     // Environment of the default problem
+	var prob  *Problem // Default problem
+	var err    error   // Error returned by the functions called
+
+	if defaultProb != nil {
+		if err = CloseCplex(); err != nil {
+			return errors.Wrap(err, "CreateProb failed to close previous problem")
+		}
+	}
+
+	if env, err = OpenCplex(); err != nil {
+		return err
+	}
+
+	if prob, err = env.CreateProb(Name); err != nil {
+		_ = env.CloseCplex()
+		return err
+	}
+
+	defaultProb = prob
 			
 	return nil
 }
 
 //==============================================================================
 
-// ChgCoefList modifies the non-zero coefficients specified for the problem. 
-// The rows and columns, created by other functions, are assumed to exist. 
+// OutputToScreen turns Cplex output to screen on or off for the default problem.
+// See Env.OutputToScreen.
+func OutputToScreen(echoOn bool) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.env.OutputToScreen(echoOn)
+}
+
+//==============================================================================
+
+// CloseCplex frees the default problem, and closes and cleans up its Cplex
+// environment.
 // In case of failure, it returns an error including the error code it received from Cplex. 
-// This function uses CPXchgcoeflist.
-func ChgCoefList(eList []InputElem) error {
+// This function uses CPXfreeprob and CPXcloseCPLEX.
+func CloseCplex() error {
 
-	var rowlist, collist []C.int     // Arrays of indices for rows and columns
-	var vallist          []C.double  // Array of coefficient values 
-	var status             C.int     // Status returned from Cplex
-		
-	if len(eList) < 1 {
-		return errors.Errorf("ChgCoefList expected more than %d elements", len(eList))			
+	if defaultProb == nil {
+		return nil
 	}
-	
-	for i := 0; i < len(eList); i++ {
-		
-		rowlist = append(rowlist, C.int(eList[i].RowIndex))
-		collist = append(collist, C.int(eList[i].ColIndex))
-		vallist = append(vallist, C.double(eList[i].Value))
-		
-	} // End for all rows	
 
-    status = C.cChgCoefList(C.int(len(rowlist)), &rowlist[0], &collist[0], &vallist[0])
-	if status != 0 {
-		return errors.Errorf("Changing coefficients failed with error %d", status)
-	}	
+	env := defaultProb.env
+	defaultProb = nil
 	
-	return nil
+	return env.CloseCplex()
+}
+
+//==============================================================================
+
+// ChgProbName changes the name of the default problem. See Problem.ChgProbName.
+func ChgProbName(Name string) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.ChgProbName(Name)
+}
+
+//==============================================================================
+
+// ChgObjSen changes the objective sense of the default problem. See Problem.ChgObjSen.
+func ChgObjSen(sense int) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.ChgObjSen(sense)
+}
+
+//==============================================================================
+
+// NewRows creates new rows in the default problem. See Problem.NewRows.
+func NewRows(rList []InputRow) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.NewRows(rList)
+}
+
+//==============================================================================
+
+// NewCols creates new columns in the default problem. See Problem.NewCols.
+func NewCols(objList []InputObjCoef, cList []InputCol) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.NewCols(objList, cList)
+}
+
+//==============================================================================
+
+// ChgCoefList modifies non-zero coefficients of the default problem. 
+// See Problem.ChgCoefList.
+func ChgCoefList(eList []InputElem) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.ChgCoefList(eList)
 }
 
-//==============================================================================
-// FUNCTIONS FOR SOLVING THE PROBLEM AND GETTING SOLUTION (Get series functions)
 //==============================================================================
 
-// LpOpt solves the LP, which must is assumed to have been defined by
-// other functions. 
-// In case of failure, it returns an error including the error code it received from Cplex. 
-// This function uses CPXlpopt.
-//
-// The model can contain only continuous ('C') variables. The presence
-// of any other variable type will cause this function to fail with a CPXERR_NOT_FOR_MIP
-// error.
+// LpOpt solves the default problem as an LP. See Problem.LpOpt.
 func LpOpt() error {
-	var status C.int     // Status returned from Cplex
-	
-	status = C.cLpOpt()
-	if status != 0 {
-		return errors.Errorf("Error %d received from cLpOpt", status)
-	}	
-	
-	return nil	
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.LpOpt()
 }
 
 //==============================================================================
 
-// MipOpt solves the mixed integer problem, which is assumed to have been defined by
-// other functions. 
-// In case of failure, it returns an error including the error code it received from Cplex. 
-// This function uses CPXmipopt.
+// MipOpt solves the default problem as a MIP. See Problem.MipOpt.
 func MipOpt() error {
-	var status C.int  // Status returned from Cplex
-	
-	status = C.cMipOpt()
-	if status != 0 {
-		return errors.Errorf("Error %d received from cMipOpt", status)
-	}	
-	
-	return nil	
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.MipOpt()
 }
 
 //==============================================================================
 
-// GetSolution obtains the solution for a linear problem (LP) from Cplex. 
-// In case of failure, it returns an error including the error code it received from Cplex. 
-// This function uses CPXgetsolution as well as all other auxiliary
-// functions, such as CPXgetrowname, CPXgetcolname, and others that may be needed
-// in order to populate the solution data structures passed back to the user.
-func GetSolution(objVal *float64, sRows *[]SolnRow, sCols *[]SolnCol) error {
+// LpOptContext solves the default problem as an LP, aborting the solve once the
+// context is done. See Problem.LpOptContext.
+func LpOptContext(ctx context.Context) error {
 
-	var cObjVal  C.double   // Objective function value returned by Cplex
-	var status   C.int      // Status returned by Cplex
-	var numRows  C.int      // Number of rows in the Cplex model
-	var numCols  C.int      // Number of columns in the Cplex model
-	
-	var curCol SolnCol
-	var curRow SolnRow
-	var err error
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
 
-	// Get actual number of rows and columns and allocate memory for solution.
-	_ = C.cGetNumRows(&numRows)
-	_ = C.cGetNumCols(&numCols)
+	return prob.LpOptContext(ctx)
+}
 
-	cXval  := make([]C.double, numCols)
-	cRcost := make([]C.double, numCols)
+//==============================================================================
 
-	cPi    := make([]C.double, numRows)
-	cSlack := make([]C.double, numRows)
-	
+// MipOptContext solves the default problem as a MIP, aborting the solve once
+// the context is done. See Problem.MipOptContext.
+func MipOptContext(ctx context.Context) error {
 
-	// Get the solution using the C data structures.				
-	status = C.cGetSolution(&cObjVal, &cXval[0], &cRcost[0], &cPi[0], &cSlack[0])	
-	if status != 0 {
-		return errors.Errorf("Error %d received from cGetSolution", status)
-	}	
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
 
-	*objVal = float64(cObjVal)
+	return prob.MipOptContext(ctx)
+}
 
-	for i := 0; i < int(numCols); i++ {
-		curCol.Value   = float64(cXval[i])
-		curCol.RedCost = float64(cRcost[i])
-		*sCols = append(*sCols, curCol)	
-	}
-	
-	if err = GetColName(*sCols); err != nil {
-		return errors.Wrap(err, "GetSolution failed to get column names")
-	}
+//==============================================================================
 
-	for i := 0; i < int(numRows); i++ {
-		curRow.Pi   = float64(cPi[i])
-		curRow.Slack = float64(cSlack[i])
-		*sRows = append(*sRows, curRow)
-	}	
+// GetSolution obtains the LP solution of the default problem. See Problem.GetSolution.
+func GetSolution(objVal *float64, sRows *[]SolnRow, sCols *[]SolnCol) error {
 
-	if err = GetRowName(*sRows); err != nil {
-		return errors.Wrap(err, "GetSolution failed to get row names")
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
 	}
-				
-	return nil
 
+	return prob.GetSolution(objVal, sRows, sCols)
 }
 
 //==============================================================================
 
-// GetMipSolution obtains the solution for a mixed integer problem (MIP) from Cplex. 
-// In case of failure, it returns an error including the error code it received from Cplex.
-// This function creates the slices needed for the result and populates all
-// applicable fields with values provided by Cplex.
-// This function uses other gpx functions and indirectly call CPXgetnumrows, 
-// CPXgetnumcols, CPXgetrowname, CPXgetcolname, CPXgetobjval, CPXgetslack, and CPXgetx.
+// GetMipSolution obtains the MIP solution of the default problem. 
+// See Problem.GetMipSolution.
 func GetMipSolution(objVal *float64, sRows *[]SolnRow, sCols *[]SolnCol) error {
 
-	var numRows, numCols  int  // Number of rows and columns in the model
-	var err             error  // Error returned by the functions called
-
-	// Initialize the return values
-	*sRows  = nil
-	*sCols  = nil
-	*objVal = 0.0
-	
-	// Get the number of rows and columns, and allocate the memory for them.
-	if err = GetNumRows(&numRows); err != nil {
-		return errors.Wrap(err, "GetMipSolution failed to get number of rows") 
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
 	}
 
-	if err = GetNumCols(&numCols); err != nil {
-		return errors.Wrap(err, "GetMipSolution failed to get number of columns") 
-	}
-	
-	*sRows = make([]SolnRow, numRows)
-	*sCols = make([]SolnCol, numCols)
-	
-	if err = GetObjVal(objVal); err != nil {
-		return errors.Wrap(err, "GetMipSolution failed to get objective function value") 		
-	}	
+	return prob.GetMipSolution(objVal, sRows, sCols)
+}
 
+//==============================================================================
 
-	if err = GetRowName(*sRows); err != nil {
-		return errors.Wrap(err, "GetMipSolution failed to get row names") 		
-	}	
+// GetStatus obtains the solution status of the default problem.
+// See Problem.GetStatus.
+func GetStatus(status *SolutionStatus) error {
 
-	if err = GetSlack(*sRows); err != nil {
-		return errors.Wrap(err, "GetMipSolution failed to get row slack") 		
-	}	
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
 
-	
-	if err = GetColName(*sCols); err != nil {
-		return errors.Wrap(err, "GetMipSolution failed to get column names") 		
-	}	
+	return prob.GetStatus(status)
+}
 
-	if err = GetX(*sCols); err != nil {
-		return errors.Wrap(err, "GetMipSolution failed to get column values") 		
-	}	
-	
-	return nil
+//==============================================================================
+
+// GetSolnInfo obtains the solution method and type of the default problem.
+// See Problem.GetSolnInfo.
+func GetSolnInfo(info *SolnInfo) error {
 
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetSolnInfo(info)
 }
 
 //==============================================================================
 
-// GetNumRows obtains the number of rows in the current problem, or 0 if none exist
-// or the problem has not yet been defined. At this time the function always returns
-// nil (success).
-// This function uses CPXgetnumrows. 
+// GetNumRows obtains the number of rows in the default problem, or 0 if the
+// problem has not yet been created. At this time the function always returns
+// nil (success). See Problem.GetNumRows.
 func GetNumRows(numRows *int) error {
-	var cNumRows C.int      // Number of rows in the Cplex model
-	
-	_ = C.cGetNumRows(&cNumRows)
 
-	*numRows = int(cNumRows)	
+	if defaultProb == nil {
+		*numRows = 0
+		return nil
+	}
 
-	return nil	
+	return defaultProb.GetNumRows(numRows)
 }
 
 //==============================================================================
 
-// GetNumCols obtains the number of columns in the current problem, or 0 if none exist
-// or the problem has not yet been defined. At this time the function always returns
-// nil (success).
-// This function uses CPXgetnumcols. 
+// GetNumCols obtains the number of columns in the default problem, or 0 if the
+// problem has not yet been created. At this time the function always returns
+// nil (success). See Problem.GetNumCols.
 func GetNumCols(numCols *int) error {
-	var cNumCols C.int    // Number of columns in the Cplex model
-	
-	_ = C.cGetNumCols(&cNumCols)
 
-	*numCols = int(cNumCols)	
+	if defaultProb == nil {
+		*numCols = 0
+		return nil
+	}
 
-	return nil	
+	return defaultProb.GetNumCols(numCols)
 }
 
 //==============================================================================
 
-// GetObjVal obtains the value of the objective function. 
-// In case of failure, it returns an error including the error code it receives
-// from Cplex.
-// This function uses CPXgetobjval.
+// GetObjVal obtains the objective function value of the default problem.
+// See Problem.GetObjVal.
 func GetObjVal(objVal *float64) error {
-	var cObjVal C.double    // Objective function value calculated by Cplex
-	var status  C.int       // Status returned by Cplex
 
-	*objVal = 0
-		
-	status = C.cGetObjVal(&cObjVal)
-	if status != 0 {
-		return errors.Errorf("GetObjVal failed with error %d", status)		
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
 	}
-	*objVal = float64(cObjVal)	
 
-	return nil	
+	return prob.GetObjVal(objVal)
 }
 
 //==============================================================================
 
-// GetColName populates the solution column slice passed to the function with
-// the column names being used by Cplex. It does not populate any other fields in
-// the data structures, and assumes that the slice is large enough to contain all
-// of the column names (i.e. it does not modify the size in case of mismatch).
-// In case of failure, it returns an error including the error code it receives
-// from Cplex.
-// This function uses CPXgetnumcols and CPXgetcolname.
-func GetColName(sCols []SolnCol) error {
-	var numCols  C.int   // Number of columns in the model
-	var surplus  C.int   // Parameter from Cplex needed to calculate size of name array
-	var colSpace C.int   // Parameter needed by Cplex to get the column names
-	var status   C.int   // Status returned from Cplex
-
-	// Get actual number of columns and allocate memory for solution.
-	_ = C.cGetNumCols(&numCols)
-
-	if len(sCols) != int(numCols) {
-		return errors.Errorf("Number of cols in target is %d, expected %d", 
-			len(sCols), numCols)		
-	}
-	
-    status = C.cGetColNameSurplus(numCols, &surplus)
-	if status != 0 {
-		return errors.Errorf("GetColNameSurplus failed with error %d", status)
-	}	
-
-	colSpace = -surplus	
-
-	// Create memory for the name array.
-	cColName := C.makeCharArray(numCols)
-	defer C.free(unsafe.Pointer(cColName))
-	cColNameStore := C.makeNameStore(colSpace)
-	defer C.free(unsafe.Pointer(cColNameStore))
+// GetBestObjVal obtains the best bound found by MipOpt for the default problem.
+// See Problem.GetBestObjVal.
+func GetBestObjVal(bestObjVal *float64) error {
 
-	status = C.cGetColNames(numCols, cColName, cColNameStore, colSpace)
-	if status != 0 {
-		return errors.Errorf("Get col names failed with error %d", status)
-	}	
-	
-	for i := 0; i < int(numCols); i++ {
-		cString := C.cGetArrayItem(cColName, C.int(i))	
-		sCols[i].Name = C.GoString(cString)
-		C.free(unsafe.Pointer(cString))			
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return prob.GetBestObjVal(bestObjVal)
 }
 
-
 //==============================================================================
 
-// GetRowName populates the solution row slice passed to the function with
-// the row names being used by Cplex. It does not populate any other fields in
-// the data structures, and assumes that the slice is large enough to contain all
-// of the row names (i.e. it does not modify the size in case of mismatch).
-// In case of failure, it returns an error including the error code it receives
-// from Cplex.
-// This function uses CPXgetnumrows and CPXgetrowname.
-func GetRowName(sRows []SolnRow) error {
-	var numRows  C.int  // Number of rows in the model
-	var surplus  C.int  // Parameter from Cplex needed to calculate size of name array
-	var rowSpace C.int  // Parameter needed by Cplex to get row names
-	var status   C.int  // Status returned by Cplex
+// GetMipRelGap obtains the relative MIP gap of the default problem.
+// See Problem.GetMipRelGap.
+func GetMipRelGap(gap *float64) error {
 
-	// Get actual number of rows and allocate memory for solution.
-	_ = C.cGetNumRows(&numRows)
-
-	if len(sRows) != int(numRows) {
-		return errors.Errorf("Number of rows in target is %d, expected %d", 
-			len(sRows), numRows)		
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
 	}
-	
-    status = C.cGetRowNameSurplus(numRows, &surplus)
-	if status != 0 {
-		return errors.Errorf("GetRowNameSurplus failed with error %d", status)
-	}	
 
-	rowSpace = -surplus
-	
-	// Create memory for the name array.
-	cRowName := C.makeCharArray(numRows)
-	defer C.free(unsafe.Pointer(cRowName))
-	cRowNameStore := C.makeNameStore(rowSpace)
-	defer C.free(unsafe.Pointer(cRowNameStore))
-
-	status = C.cGetRowNames(numRows, cRowName, cRowNameStore, rowSpace)
-	if status != 0 {
-		return errors.Errorf("Get row names failed with error %d", status)
-	}	
-	
-	for i := 0; i < int(numRows); i++ {
-		cString := C.cGetArrayItem(cRowName, C.int(i))	
-		sRows[i].Name = C.GoString(cString)
-		C.free(unsafe.Pointer(cString))			
-	}
-		
-	return nil
-	
+	return prob.GetMipRelGap(gap)
 }
 
 //==============================================================================
 
-// GetX populates the solution column slice passed to the function with
-// the optimal value of the variable as calculated by Cplex. It does not populate 
-// any other fields in the data structures, and assumes that the slice is large 
-// enough to contain all data (i.e. it does not modify the size in case of mismatch).
-// In case of failure, it returns an error including the error code it receives
-// from Cplex.
-// This function uses CPXgetnumcols and CPXgetx.
-func GetX(sCols []SolnCol) error {
-	var cNumCols  C.int   // Number of columns in C int format
-	var status    C.int   // Status returned by Cplex
-	var numCols     int   // Number of columns in Go int format
-
-	// Get actual number of columns and allocate memory for solution.
-	_ = C.cGetNumCols(&cNumCols)
-
-	if len(sCols) < int(cNumCols) {
-		return errors.Errorf("Number of cols in target is %d, expected %d", 
-			len(sCols), cNumCols)		
-	}
-
-	cXval  := make([]C.double, cNumCols)
-
-	// Get the solution using the C data structures.				
-	status = C.cGetX(cNumCols, &cXval[0])	
-	if status != 0 {
-		return errors.Errorf("Error %d received from cGetX", status)
-	}	
+// GetColName populates the column names of the default problem. 
+// See Problem.GetColName.
+func GetColName(sCols []SolnCol) error {
 
-	// Transfer the column value from the C structure to the slice passed to us.
-	numCols = int(cNumCols)
-	for i := 0; i < numCols; i++ {
-		sCols[i].Value = float64(cXval[C.int(i)])
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return prob.GetColName(sCols)
 }
 
 //==============================================================================
 
-// GetSlack populates the solution row slice passed to the function with
-// the slack value of the constraint as calculated by Cplex. It does not populate 
-// any other fields in the data structures, and assumes that the slice is large 
-// enough to contain all data (i.e. it does not modify the size in case of mismatch).
-// In case of failure, it returns an error including the error code it receives
-// from Cplex.
-// This function uses CPXgetnumrows and CPXgetslack.
-func GetSlack(sRows []SolnRow) error {
-	var cNumRows  C.int  // Number of rows in C int format
-	var status    C.int  // Status returned by Cplex
-	var numRows     int  // Number of rows in Go int format
-
-	// Get actual number of rows and allocate memory for solution.
-	_ = C.cGetNumRows(&cNumRows)
+// GetRowName populates the row names of the default problem. 
+// See Problem.GetRowName.
+func GetRowName(sRows []SolnRow) error {
 
-	if len(sRows) < int(cNumRows) {
-		return errors.Errorf("Number of rows in target is %d, expected %d", 
-			len(sRows), cNumRows)		
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
 	}
 
-	cSlack  := make([]C.double, cNumRows)
+	return prob.GetRowName(sRows)
+}
 
-	// Get the solution using the C data structures.				
-	status = C.cGetSlack(cNumRows, &cSlack[0])	
-	if status != 0 {
-		return errors.Errorf("Error %d received from cGetSlack", status)
-	}	
+//==============================================================================
 
-	// Transfer the row slack from the C structure to the slice passed to us.
-	numRows = int(cNumRows)
-	for i := 0; i < numRows; i++ {
-		sRows[i].Slack = float64(cSlack[C.int(i)])
+// GetX populates the column values of the default problem. See Problem.GetX.
+func GetX(sCols []SolnCol) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return prob.GetX(sCols)
 }
 
-//==============================================================================
-// FUNCTIONS FOR PROCESSING FILES AND MISCELANEOUS FUNCTIONALITY
 //==============================================================================
 
-// CloseCplex closes and cleans up the Cplex environment. 
-// In case of failure, it returns an error including the error code it received from Cplex. 
-// This function uses CPXfreeprob and CPXcloseCPLEX.
-func CloseCplex() error {
-	var status C.int    // Status returned by Cplex
-		
-	status = C.cCloseCplex()
-	if status != 0 {
-		return errors.Errorf("Close Cplex failed with error %d", status)	
+// GetSlack populates the row slacks of the default problem. See Problem.GetSlack.
+func GetSlack(sRows []SolnRow) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
 	}
-	
-	return nil
+
+	return prob.GetSlack(sRows)
 }
 
 //==============================================================================
 
-// ReadCopyProb reads the data file specified by its name and type, and populates
-// the problem from information contained in this file.
-// In case of failure, it returns an error including the error code it receives
-// from Cplex.
-// This function uses CPXreadcopyprob.
-//	The following fileType values are supported:
-//		SAV - binary format
-//		MPS - MPS format
-//		LP  - LP format
+// ReadCopyProb reads a data file into the default problem. See Problem.ReadCopyProb.
 func ReadCopyProb(fileName string, fileType string) error {
 
-	var status C.int  // Status returned by Cplex
-
-	cFileName := C.CString(fileName)
-	defer C.free(unsafe.Pointer(cFileName))
-	cFileType := C.CString(fileType)
-	defer C.free(unsafe.Pointer(cFileType))
-	
-	status = C.cReadCopyProb(cFileName, cFileType)
-	if status != 0 {
-		return errors.Errorf("Read file failed with error %d", status)
-	}	
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
 
-	return nil	
+	return prob.ReadCopyProb(fileName, fileType)
 }
 
 //==============================================================================
 
-// WriteProb writes current Cplex problem to the data file specified by its 
-// name and type.
-// In case of failure, it returns an error including the error code it receives
-// from Cplex.
-// This function uses CPXwriteprob.
-//	The following fileType values are supported:
-//		SAV - binary matrix and basis file
-//		MPS - MPS format
-//		LP  - CPLEX LP format with names modified to conform to LP format
-//		REW - MPS format with all names changed to generic names
-//		ALP - LP format with generic name, type, and bound of each variable
-//	If the file name ends with one of the following extensions, a compressed
-//	file is written:
-//		.bz2 - files compressed with BZip2
-//		.gz  - files compressed with GNU Zip
+// WriteProb writes the default problem to a data file. See Problem.WriteProb.
 func WriteProb(fileName string, fileType string) error {
 
-	var status C.int  // Status returned by Cplex
-
-	cFileName := C.CString(fileName)
-	defer C.free(unsafe.Pointer(cFileName))
-	cFileType := C.CString(fileType)
-	defer C.free(unsafe.Pointer(cFileType))
-	
-	status = C.cWriteProb(cFileName, cFileType)
-	if status != 0 {
-		return errors.Errorf("Write problem file failed with error %d", status)
-	}	
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
 
-	return nil	
+	return prob.WriteProb(fileName, fileType)
 }
 
 //==============================================================================
 
-// SolWrite writes the current Cplex problem to the data file specified by its 
-// name and type.
-// In case of failure, it returns an error including the error code it receives
-// from Cplex.
-// This function uses CPXwriteprob.
+// SolWrite writes the solution of the default problem to a file. 
+// See Problem.SolWrite.
 func SolWrite(fileName string) error {
-	
-	var status C.int  // Status returned by Cplex
-
-	cFileName := C.CString(fileName)
-	defer C.free(unsafe.Pointer(cFileName))
 
-	status = C.cSolWrite(cFileName)
-	if status != 0 {
-		return errors.Errorf("Writing solution file failed with error %d", status)
-	}	
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
 
-	return nil	
-		
+	return prob.SolWrite(fileName)
 }
 
 //============================ END OF FILE =====================================
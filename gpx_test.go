@@ -0,0 +1,292 @@
+package gpx
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEnvProblemLifecycle(t *testing.T) {
+
+	if _, err := OpenEnv(nil); err == nil {
+		t.Error("OpenEnv(nil) succeeded, want error")
+	}
+
+	fake := NewFakeBackend()
+	env, err := OpenEnv(fake)
+	if err != nil {
+		t.Fatalf("OpenEnv failed: %v", err)
+	}
+
+	first, err := env.CreateProb("first")
+	if err != nil {
+		t.Fatalf("CreateProb failed: %v", err)
+	}
+	unnamed, err := env.CreateProb("")
+	if err != nil {
+		t.Fatalf("CreateProb failed: %v", err)
+	}
+	if unnamed.name != "DefaultName" {
+		t.Errorf("name of an unnamed problem = %q, want %q", unnamed.name, "DefaultName")
+	}
+
+	// Freeing one problem leaves the other usable.
+	if err = first.FreeProb(); err != nil {
+		t.Fatalf("FreeProb failed: %v", err)
+	}
+	if err = first.FreeProb(); err != nil {
+		t.Errorf("second FreeProb = %v, want nil", err)
+	}
+	if err = unnamed.NewRows([]InputRow{{"r", "L", 1, 0}}); err != nil {
+		t.Errorf("NewRows on the remaining problem failed: %v", err)
+	}
+
+	if err = unnamed.ChgProbName(""); err != nil || unnamed.name != "ChangedDefaultName" {
+		t.Errorf("ChgProbName(\"\") = %v, name %q, want %q", err, unnamed.name, "ChangedDefaultName")
+	}
+
+	// Closing the environment frees the problems left in it.
+	if err = env.CloseCplex(); err != nil {
+		t.Fatalf("CloseCplex failed: %v", err)
+	}
+	if err = env.CloseCplex(); err != nil {
+		t.Errorf("second CloseCplex = %v, want nil", err)
+	}
+	if err = unnamed.NewRows([]InputRow{{"r", "L", 1, 0}}); !errors.Is(err, ErrNoProblem) {
+		t.Errorf("NewRows after CloseCplex = %v, want %v", err, ErrNoProblem)
+	}
+	if _, err = env.CreateProb("late"); err == nil {
+		t.Error("CreateProb in a closed environment succeeded, want error")
+	}
+
+	var ops []string
+	for _, c := range fake.Calls() {
+		ops = append(ops, c.Prob + ":" + c.Op)
+	}
+	want := []string{":OpenEnv", "first:CreateProb", "DefaultName:CreateProb", "first:FreeProb",
+		"DefaultName:NewRows", "DefaultName:ChgProbName", "ChangedDefaultName:FreeProb", ":CloseEnv"}
+	if !reflect.DeepEqual(ops, want) {
+		t.Errorf("calls = %v, want %v", ops, want)
+	}
+}
+
+//==============================================================================
+
+func TestFreedProblem(t *testing.T) {
+
+	env, err := OpenEnv(NewFakeBackend())
+	if err != nil {
+		t.Fatalf("OpenEnv failed: %v", err)
+	}
+
+	prob, err := env.CreateProb("freed")
+	if err != nil {
+		t.Fatalf("CreateProb failed: %v", err)
+	}
+	if err = prob.FreeProb(); err != nil {
+		t.Fatalf("FreeProb failed: %v", err)
+	}
+
+	var objVal float64
+	var sRows []SolnRow
+	var sCols []SolnCol
+	var status SolutionStatus
+	var numRows int
+
+	tests := []struct {
+		name  string
+		err   error
+	}{
+		{"ChgProbName", prob.ChgProbName("x")},
+		{"ChgObjSen", prob.ChgObjSen(-1)},
+		{"NewRows", prob.NewRows([]InputRow{{"r", "L", 1, 0}})},
+		{"NewCols", prob.NewCols(nil, []InputCol{{"x", "C", 0, 1}})},
+		{"ChgCoefList", prob.ChgCoefList([]InputElem{{0, 0, 1}})},
+		{"LpOpt", prob.LpOpt()},
+		{"MipOpt", prob.MipOpt()},
+		{"GetSolution", prob.GetSolution(&objVal, &sRows, &sCols)},
+		{"GetMipSolution", prob.GetMipSolution(&objVal, &sRows, &sCols)},
+		{"GetStatus", prob.GetStatus(&status)},
+		{"GetObjVal", prob.GetObjVal(&objVal)},
+	}
+
+	for _, tt := range tests {
+		if !errors.Is(tt.err, ErrNoProblem) {
+			t.Errorf("%s on a freed problem = %v, want %v", tt.name, tt.err, ErrNoProblem)
+		}
+	}
+
+	if err = prob.GetNumRows(&numRows); err != nil || numRows != 0 {
+		t.Errorf("GetNumRows on a freed problem = %d, %v, want 0, nil", numRows, err)
+	}
+}
+
+//==============================================================================
+
+func TestFreeProbFailure(t *testing.T) {
+
+	fake := NewFakeBackend()
+	env, err := OpenEnv(fake)
+	if err != nil {
+		t.Fatalf("OpenEnv failed: %v", err)
+	}
+
+	prob, err := env.CreateProb("failing")
+	if err != nil {
+		t.Fatalf("CreateProb failed: %v", err)
+	}
+
+	// The problem leaves its environment even if the backend fails to free it.
+	fake.Errors["FreeProb"] = &CplexError{Op: "FreeProb", Code: cpxErrNoMemory}
+	if err = prob.FreeProb(); err == nil {
+		t.Error("FreeProb succeeded, want error")
+	}
+	if len(env.probs) != 0 {
+		t.Errorf("environment holds %d problems after FreeProb, want 0", len(env.probs))
+	}
+	if err = prob.ChgObjSen(-1); !errors.Is(err, ErrNoProblem) {
+		t.Errorf("ChgObjSen after a failed FreeProb = %v, want %v", err, ErrNoProblem)
+	}
+}
+
+//==============================================================================
+
+func TestDefaultProblem(t *testing.T) {
+
+	saved := DefaultBackend
+	defer func() {
+		CloseCplex()
+		DefaultBackend = saved
+	}()
+
+	if err := NewRows([]InputRow{{"r", "L", 1, 0}}); !errors.Is(err, ErrNoProblem) {
+		t.Errorf("NewRows without a default problem = %v, want %v", err, ErrNoProblem)
+	}
+
+	fake := NewFakeBackend()
+	DefaultBackend = fake
+
+	if err := CreateProb("default"); err != nil {
+		t.Fatalf("CreateProb failed: %v", err)
+	}
+	if err := NewRows([]InputRow{{"r", "L", 1, 0}}); err != nil {
+		t.Errorf("NewRows on the default problem failed: %v", err)
+	}
+
+	// A second CreateProb closes the first environment.
+	if err := CreateProb("second"); err != nil {
+		t.Fatalf("CreateProb failed: %v", err)
+	}
+
+	var numRows int
+	if err := GetNumRows(&numRows); err != nil || numRows != 0 {
+		t.Errorf("GetNumRows of the new default problem = %d, %v, want 0, nil", numRows, err)
+	}
+
+	if err := CloseCplex(); err != nil {
+		t.Fatalf("CloseCplex failed: %v", err)
+	}
+	if err := CloseCplex(); err != nil {
+		t.Errorf("second CloseCplex = %v, want nil", err)
+	}
+
+	var closes int
+	for _, c := range fake.Calls() {
+		if c.Op == "CloseEnv" {
+			closes++
+		}
+	}
+	if closes != 2 {
+		t.Errorf("CloseEnv called %d times, want 2", closes)
+	}
+}
+
+//==============================================================================
+
+func TestOptContext(t *testing.T) {
+
+	lpOpt  := func(p *Problem, ctx context.Context) error { return p.LpOptContext(ctx) }
+	mipOpt := func(p *Problem, ctx context.Context) error { return p.MipOptContext(ctx) }
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name     string
+		colType  string
+		opt      func(p *Problem, ctx context.Context) error
+		ctx      func() (context.Context, context.CancelFunc)
+		x        []float64        // Column values of the scripted solution
+		want     error            // Cause of the error returned, nil for none
+		status   SolutionStatus   // Status after the solve, StatNone if not started
+	}{
+		{"lp completes", "C", lpOpt,
+			func() (context.Context, context.CancelFunc) { return context.WithTimeout(context.Background(), time.Minute) },
+			[]float64{0, 4}, nil, StatOptimal},
+		{"lp deadline", "C", lpOpt,
+			func() (context.Context, context.CancelFunc) { return context.WithTimeout(context.Background(), time.Millisecond) },
+			[]float64{0, 4}, context.DeadlineExceeded, StatAbortUser},
+		{"lp cancelled before", "C", lpOpt,
+			func() (context.Context, context.CancelFunc) { return cancelled, func() {} },
+			[]float64{0, 4}, context.Canceled, StatNone},
+		{"mip deadline with incumbent", "I", mipOpt,
+			func() (context.Context, context.CancelFunc) { return context.WithTimeout(context.Background(), time.Millisecond) },
+			[]float64{0, 4}, context.DeadlineExceeded, MipAbortFeas},
+		{"mip deadline without incumbent", "I", mipOpt,
+			func() (context.Context, context.CancelFunc) { return context.WithTimeout(context.Background(), time.Millisecond) },
+			nil, context.DeadlineExceeded, MipAbortInfeas},
+		{"mip cancelled before", "I", mipOpt,
+			func() (context.Context, context.CancelFunc) { return cancelled, func() {} },
+			[]float64{0, 4}, context.Canceled, StatNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			// The scripted solve takes longer than the short deadlines.
+			delay := 50 * time.Millisecond
+			if tt.want == nil {
+				delay = 0
+			}
+
+			fake := NewFakeBackend()
+			fake.Solutions = []FakeSolution{{ObjVal: 8, X: tt.x, Delay: delay}}
+			_, prob := newTestProb(t, fake, fakeModel(tt.colType))
+
+			ctx, cancel := tt.ctx()
+			defer cancel()
+
+			err := tt.opt(prob, ctx)
+			if errors.Cause(err) != tt.want {
+				t.Fatalf("error = %v, want cause %v", err, tt.want)
+			}
+
+			var status SolutionStatus
+			if err = prob.GetStatus(&status); err != nil || status != tt.status {
+				t.Errorf("GetStatus = %s, %v, want %s", status, err, tt.status)
+			}
+
+			solved := false
+			for _, c := range fake.Calls() {
+				solved = solved || c.Op == "LpOpt" || c.Op == "MipOpt"
+			}
+			if solved != (tt.status != StatNone) {
+				t.Errorf("solve started = %t, want %t", solved, tt.status != StatNone)
+			}
+
+			// The incumbent remains available after an aborted MipOpt.
+			if tt.status == MipAbortFeas {
+				var objVal float64
+				var sRows []SolnRow
+				var sCols []SolnCol
+				if err = prob.GetMipSolution(&objVal, &sRows, &sCols); err != nil || objVal != 8 {
+					t.Errorf("GetMipSolution = %g, %v, want 8", objVal, err)
+				}
+			}
+		})
+	}
+}
+
+//============================ END OF FILE =====================================
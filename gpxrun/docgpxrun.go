@@ -17,6 +17,10 @@ to execute from the following list:
   2 - solve sample MILP problem (noswot) from data structures
   3 - display solution
 
+The program uses Cplex, so it must be built with the "cplex" build tag:
+
+  go build -tags cplex
+
 This program must executed from the same directory in which the program and the
 sample files are located. If the program is executed from a different directory,
 or if the sample files reside in a different directory than the executable, the 
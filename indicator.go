@@ -0,0 +1,192 @@
+// 01   Oct. 16, 2026   Initial version, indicator constraints
+// 02   Oct. 16, 2026   The constraints are copied here only, not by the backends
+
+package gpx
+
+import (
+	"github.com/pkg/errors"
+)
+
+// InputIndicator defines a data structure of an indicator constraint, passed
+// as an input argument to AddIndConstr and returned by GetIndConstr. The linear
+// constraint
+//   sum(Lin[k].Value * x[Lin[k].ColIndex])  Sense  Rhs
+// must hold whenever the binary column ColIndex takes the value ActiveValue,
+// and is ignored otherwise. Sense is "L" for <=, "G" for >= or "E" for =.
+type InputIndicator struct {
+	Name         string          // Name of the constraint
+	ColIndex     int             // Index of the binary column which activates the constraint
+	ActiveValue  int             // Value of the column, 0 or 1, for which the constraint holds
+	Lin          []InputLinCoef  // Non-zero coefficients of the linear constraint
+	Sense        string          // "L", "G" or "E"
+	Rhs          float64         // Right hand side of the linear constraint
+}
+
+//==============================================================================
+// PROBLEM FUNCTIONS
+//==============================================================================
+
+// AddIndConstr appends the indicator constraints specified to the problem.
+// They replace big-M rows, which are prone to numerical trouble, and are only
+// taken into account by MipOpt. The column of each constraint must already
+// exist and be binary.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXaddindconstr.
+func (p *Problem) AddIndConstr(iList []InputIndicator) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	if len(iList) < 1 {
+		return errors.Errorf("AddIndConstr expected more than %d constraints", len(iList))
+	}
+
+	cols := make([]InputCol, p.slv.NumCols())
+	if err := p.slv.Cols(cols); err != nil {
+		return errors.Wrap(err, "AddIndConstr failed to get column types")
+	}
+
+	for i, ind := range iList {
+		if ind.ColIndex < 0 || ind.ColIndex >= len(cols) {
+			return errors.Errorf("AddIndConstr found column %d for constraint %d, expected 0 to %d",
+				ind.ColIndex, i, len(cols) - 1)
+		}
+		if cols[ind.ColIndex].Type != "B" {
+			return errors.Errorf("AddIndConstr found type '%s' for column %d of constraint %d, expected B",
+				cols[ind.ColIndex].Type, ind.ColIndex, i)
+		}
+		if ind.ActiveValue != 0 && ind.ActiveValue != 1 {
+			return errors.Errorf("AddIndConstr found active value %d for constraint %d, expected 0 or 1",
+				ind.ActiveValue, i)
+		}
+		if ind.Sense != "L" && ind.Sense != "G" && ind.Sense != "E" {
+			return errors.Errorf("AddIndConstr found sense '%s' for constraint %d, expected L, G or E",
+				ind.Sense, i)
+		}
+
+		seen := make(map[int]bool, len(ind.Lin))
+		for _, l := range ind.Lin {
+			if l.ColIndex < 0 || l.ColIndex >= len(cols) {
+				return errors.Errorf("AddIndConstr found column %d in constraint %d, expected 0 to %d",
+					l.ColIndex, i, len(cols) - 1)
+			}
+			if seen[l.ColIndex] {
+				return errors.Errorf("AddIndConstr found column %d twice in constraint %d", l.ColIndex, i)
+			}
+			seen[l.ColIndex] = true
+		}
+	}
+
+	return p.slv.AddIndConstrs(copyIndicators(iList))
+}
+
+//==============================================================================
+
+// GetIndConstr obtains all indicator constraints of the problem, and populates
+// the slice passed to the function with one entry per constraint, in the order
+// in which they were added, as expected by AddIndConstr.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXgetindconstr and CPXgetindconstrname.
+func (p *Problem) GetIndConstr(iList *[]InputIndicator) error {
+
+	*iList = nil
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	inds := make([]InputIndicator, p.slv.NumIndConstrs())
+	if err := p.slv.IndConstrs(inds); err != nil {
+		return errors.Wrap(err, "GetIndConstr failed")
+	}
+
+	names, err := p.slv.IndConstrNames()
+	if err != nil {
+		return errors.Wrap(err, "GetIndConstr failed to get constraint names")
+	}
+
+	for i := range inds {
+		inds[i].Name = names[i]
+	}
+	*iList = inds
+
+	return nil
+}
+
+//==============================================================================
+
+// GetNumIndConstrs obtains the number of indicator constraints in the current
+// problem, or 0 if none exist or the problem has not yet been defined. At this
+// time the function always returns nil (success).
+// This function uses CPXgetnumindconstrs.
+func (p *Problem) GetNumIndConstrs(numIndConstrs *int) error {
+
+	*numIndConstrs = 0
+	if p.checkProb() == nil {
+		*numIndConstrs = p.slv.NumIndConstrs()
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// copyIndicators returns a copy of the indicator constraints, each with its
+// own Lin slice.
+func copyIndicators(iList []InputIndicator) []InputIndicator {
+
+	copied := make([]InputIndicator, len(iList))
+	for i, ind := range iList {
+		ind.Lin   = append([]InputLinCoef(nil), ind.Lin...)
+		copied[i] = ind
+	}
+
+	return copied
+}
+
+//==============================================================================
+// PACKAGE-LEVEL FUNCTIONS
+//==============================================================================
+
+// AddIndConstr appends indicator constraints to the default problem. See
+// Problem.AddIndConstr.
+func AddIndConstr(iList []InputIndicator) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.AddIndConstr(iList)
+}
+
+//==============================================================================
+
+// GetIndConstr obtains the indicator constraints of the default problem. See
+// Problem.GetIndConstr.
+func GetIndConstr(iList *[]InputIndicator) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetIndConstr(iList)
+}
+
+//==============================================================================
+
+// GetNumIndConstrs obtains the number of indicator constraints of the default
+// problem. See Problem.GetNumIndConstrs.
+func GetNumIndConstrs(numIndConstrs *int) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetNumIndConstrs(numIndConstrs)
+}
+
+//============================ END OF FILE =====================================
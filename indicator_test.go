@@ -0,0 +1,122 @@
+package gpx
+
+import (
+	"reflect"
+	"testing"
+)
+
+// indModel is a problem with the continuous column x, the binary column b and
+// the integer column n.
+func indModel() testModel {
+
+	return testModel{
+		name: "ind",
+		rows: []InputRow{{"c1", "L", 10, 0}},
+		obj:  []InputObjCoef{{0, 1}},
+		cols: []InputCol{{"x", "C", 0, 10}, {"b", "B", 0, 1}, {"n", "I", 0, 5}},
+	}
+}
+
+//==============================================================================
+
+// TestAddIndConstr adds indicator constraints to the problem of indModel, and
+// checks the constraints obtained by GetIndConstr, or that a list which is
+// rejected leaves the problem without any.
+func TestAddIndConstr(t *testing.T) {
+
+	lin := []InputLinCoef{{0, 1}, {2, -1}}
+
+	tests := []struct {
+		name   string
+		iList  []InputIndicator
+		fails  bool
+	}{
+		{"one", []InputIndicator{{"i1", 1, 1, lin, "L", 4}}, false},
+		{"active at zero", []InputIndicator{{"i1", 1, 0, lin, "G", 1}, {"i2", 1, 1, nil, "E", 0}}, false},
+		{"empty", nil, true},
+		{"continuous column", []InputIndicator{{"i1", 0, 1, lin, "L", 4}}, true},
+		{"integer column", []InputIndicator{{"i1", 2, 1, lin, "L", 4}}, true},
+		{"column out of range", []InputIndicator{{"i1", 3, 1, lin, "L", 4}}, true},
+		{"active value 2", []InputIndicator{{"i1", 1, 2, lin, "L", 4}}, true},
+		{"ranged", []InputIndicator{{"i1", 1, 1, lin, "R", 4}}, true},
+		{"no sense", []InputIndicator{{"i1", 1, 1, lin, "", 4}}, true},
+		{"linear column out of range", []InputIndicator{{"i1", 1, 1, []InputLinCoef{{-1, 1}}, "L", 4}}, true},
+		{"linear column twice", []InputIndicator{{"i1", 1, 1, []InputLinCoef{{0, 1}, {0, 1}}, "L", 4}}, true},
+		{"second constraint fails", []InputIndicator{{"i1", 1, 1, lin, "L", 4}, {"i2", 0, 1, lin, "L", 4}}, true},
+	}
+
+	for _, backend := range []string{"fake", "go"} {
+		for _, tt := range tests {
+			t.Run(backend + " " + tt.name, func(t *testing.T) {
+
+				var b Backend = NewFakeBackend()
+				if backend == "go" {
+					b = NewGoBackend()
+				}
+				_, prob := newTestProb(t, b, indModel())
+
+				err := prob.AddIndConstr(tt.iList)
+				if (err != nil) != tt.fails {
+					t.Fatalf("AddIndConstr error = %v, want error %t", err, tt.fails)
+				}
+
+				var inds []InputIndicator
+				if err = prob.GetIndConstr(&inds); err != nil {
+					t.Fatalf("GetIndConstr failed: %v", err)
+				}
+				if tt.fails {
+					if len(inds) > 0 {
+						t.Errorf("GetIndConstr = %+v, want no constraints", inds)
+					}
+					return
+				}
+				if !reflect.DeepEqual(inds, tt.iList) {
+					t.Errorf("GetIndConstr = %+v, want %+v", inds, tt.iList)
+				}
+			})
+		}
+	}
+}
+
+//==============================================================================
+
+// TestAddIndConstrCopy checks that the backend keeps the constraints as they
+// were passed, whether the caller changes its slices afterwards or columns are
+// deleted from the problem, and that the constraints obtained are copies.
+func TestAddIndConstrCopy(t *testing.T) {
+
+	fake := NewFakeBackend()
+	_, prob := newTestProb(t, fake, indModel())
+
+	iList := []InputIndicator{{"i1", 1, 1, []InputLinCoef{{0, 1}, {2, 3}}, "L", 4}}
+	want  := []InputIndicator{{"i1", 1, 1, []InputLinCoef{{0, 1}, {2, 3}}, "L", 4}}
+
+	if err := prob.AddIndConstr(iList); err != nil {
+		t.Fatalf("AddIndConstr failed: %v", err)
+	}
+	iList[0].Lin[0].Value = 7
+
+	var inds []InputIndicator
+	if err := prob.GetIndConstr(&inds); err != nil || !reflect.DeepEqual(inds, want) {
+		t.Fatalf("GetIndConstr = %+v, %v, want %+v", inds, err, want)
+	}
+	inds[0].Lin[1].Value = 7
+
+	if _, err := prob.DelCols(0, 0); err != nil {
+		t.Fatalf("DelCols failed: %v", err)
+	}
+
+	for _, c := range fake.Calls() {
+		if c.Op == "AddIndConstrs" && !reflect.DeepEqual(c.Args[0], want) {
+			t.Errorf("AddIndConstrs recorded %+v, want %+v", c.Args[0], want)
+		}
+	}
+
+	// The constraint loses the deleted column, and the others move down.
+	wantAfter := []InputIndicator{{"i1", 0, 1, []InputLinCoef{{1, 3}}, "L", 4}}
+	if err := prob.GetIndConstr(&inds); err != nil || !reflect.DeepEqual(inds, wantAfter) {
+		t.Errorf("GetIndConstr after DelCols = %+v, %v, want %+v", inds, err, wantAfter)
+	}
+}
+
+//============================ END OF FILE =====================================
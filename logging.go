@@ -0,0 +1,254 @@
+// 01   Oct. 16, 2026   Initial version, capture of solver messages
+
+package gpx
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+)
+
+// LogChannel identifies one of the Cplex message channels. The values are the
+// positions of the channels in the list returned by CPXgetchannels.
+type LogChannel int
+
+// Message channels.
+const (
+	LogResults               LogChannel = 0   // Results of the optimizers (cpxresults)
+	LogWarning               LogChannel = 1   // Warnings (cpxwarning)
+	LogError                 LogChannel = 2   // Errors (cpxerror)
+	LogLog                   LogChannel = 3   // Progress log of the optimizers (cpxlog)
+)
+
+// LogFunc receives each message sent by the solver to one of the channels. The
+// message is passed as received, usually a single line including its newline.
+// probName is the name of the problem being processed when the message was
+// sent, or empty for messages of the environment itself.
+type LogFunc func(channel LogChannel, probName string, msg string)
+
+// envLog holds the destination of the messages of an environment, and the name
+// of the problem currently processed by it.
+type envLog struct {
+	mu        sync.Mutex      // Protects the fields, messages may come from solver threads
+	fn        LogFunc         // Destination of the messages, nil if none
+	prob      string          // Name of the problem being processed
+}
+
+//==============================================================================
+
+// String returns the Cplex name of the channel.
+func (c LogChannel) String() string {
+
+	switch c {
+	case LogResults:
+		return "results"
+	case LogWarning:
+		return "warning"
+	case LogError:
+		return "error"
+	case LogLog:
+		return "log"
+	}
+
+	return "unknown"
+}
+
+//==============================================================================
+// ENVIRONMENT FUNCTIONS
+//==============================================================================
+
+// SetLogFunc sends all messages of the results, warning, error and log channels
+// of the environment to the function passed into this function, replacing any
+// destination set before. Passing nil stops the capture. Output to screen is
+// not affected, see OutputToScreen.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXgetchannels, CPXaddfuncdest and CPXdelfuncdest.
+func (e *Env) SetLogFunc(fn LogFunc) error {
+
+	if e.drv == nil {
+		return errors.New("Environment is not open")
+	}
+
+	e.log.mu.Lock()
+	e.log.fn = fn
+	e.log.mu.Unlock()
+
+	if fn == nil {
+		return e.drv.SetLogFunc(nil)
+	}
+
+	return e.drv.SetLogFunc(e.dispatchLog)
+}
+
+//==============================================================================
+
+// SetLogWriter writes all messages of the environment to the writer passed into
+// this function. Each line sent while a problem is processed is prefixed with
+// the name of the problem in brackets, e.g. "[model1] Dual simplex - Optimal".
+// Writes are serialized, so the writer does not need to be safe for concurrent
+// use. Passing nil stops the capture. See SetLogFunc.
+func (e *Env) SetLogWriter(w io.Writer) error {
+
+	if w == nil {
+		return e.SetLogFunc(nil)
+	}
+
+	return e.SetLogFunc(logWriterFunc(w))
+}
+
+//==============================================================================
+
+// SetLogger sends all messages of the environment to the structured logger
+// passed into this function, one record per non-empty line. Messages of the
+// error channel are logged at slog.LevelError, warnings at slog.LevelWarn, and
+// all others at slog.LevelInfo. Each record has the attributes "channel" and,
+// for messages sent while a problem is processed, "problem".
+// Passing nil stops the capture. See SetLogFunc.
+func (e *Env) SetLogger(logger *slog.Logger) error {
+
+	if logger == nil {
+		return e.SetLogFunc(nil)
+	}
+
+	return e.SetLogFunc(loggerFunc(logger))
+}
+
+//==============================================================================
+
+// dispatchLog passes a message received from the backend to the destination of
+// the environment, along with the name of the problem being processed.
+func (e *Env) dispatchLog(channel LogChannel, msg string) {
+
+	e.log.mu.Lock()
+	fn, prob := e.log.fn, e.log.prob
+	e.log.mu.Unlock()
+
+	if fn != nil {
+		fn(channel, prob, msg)
+	}
+}
+
+//==============================================================================
+
+// logScope records that the problem is being processed, so that messages sent
+// by the solver are tagged with its name. It returns a function which restores
+// the previous state, to be deferred by the caller:
+//
+//	defer p.logScope()()
+func (p *Problem) logScope() func() {
+
+	p.env.log.mu.Lock()
+	prev := p.env.log.prob
+	p.env.log.prob = p.name
+	p.env.log.mu.Unlock()
+
+	return func() {
+		p.env.log.mu.Lock()
+		p.env.log.prob = prev
+		p.env.log.mu.Unlock()
+	}
+}
+
+//==============================================================================
+
+// logWriterFunc returns a LogFunc writing to w, which prefixes each line with
+// the name of the problem.
+func logWriterFunc(w io.Writer) LogFunc {
+	var mu         sync.Mutex   // Serializes the writes
+	var midLine    bool         // Flag set if the last message did not end a line
+
+	return func(channel LogChannel, probName string, msg string) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		for _, line := range strings.SplitAfter(msg, "\n") {
+			if line == "" {
+				continue
+			}
+			if !midLine && probName != "" {
+				io.WriteString(w, "["+probName+"] ")
+			}
+			io.WriteString(w, line)
+			midLine = !strings.HasSuffix(line, "\n")
+		}
+	}
+}
+
+//==============================================================================
+
+// loggerFunc returns a LogFunc which logs each non-empty line as a record of
+// the logger.
+func loggerFunc(logger *slog.Logger) LogFunc {
+
+	return func(channel LogChannel, probName string, msg string) {
+		level := slog.LevelInfo
+		switch channel {
+		case LogError:
+			level = slog.LevelError
+		case LogWarning:
+			level = slog.LevelWarn
+		}
+
+		attrs := []slog.Attr{slog.String("channel", channel.String())}
+		if probName != "" {
+			attrs = append(attrs, slog.String("problem", probName))
+		}
+
+		for _, line := range strings.Split(msg, "\n") {
+			line = strings.TrimRight(line, " \r\t")
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+			logger.LogAttrs(context.Background(), level, line, attrs...)
+		}
+	}
+}
+
+//==============================================================================
+// PACKAGE-LEVEL FUNCTIONS
+//==============================================================================
+
+// SetLogFunc sends the messages of the environment of the default problem to a
+// function. See Env.SetLogFunc.
+func SetLogFunc(fn LogFunc) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.env.SetLogFunc(fn)
+}
+
+//==============================================================================
+
+// SetLogWriter writes the messages of the environment of the default problem
+// to a writer. See Env.SetLogWriter.
+func SetLogWriter(w io.Writer) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.env.SetLogWriter(w)
+}
+
+//==============================================================================
+
+// SetLogger sends the messages of the environment of the default problem to a
+// structured logger. See Env.SetLogger.
+func SetLogger(logger *slog.Logger) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.env.SetLogger(logger)
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,123 @@
+package gpx
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+)
+
+// logMsg is a message sent to a LogFunc.
+type logMsg struct {
+	channel  LogChannel
+	prob     string
+	msg      string
+}
+
+//==============================================================================
+
+func TestLogWriterFunc(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		msgs  []logMsg
+		want  string
+	}{
+		{"single line", []logMsg{{LogResults, "m1", "Dual simplex - Optimal\n"}},
+			"[m1] Dual simplex - Optimal\n"},
+		{"several lines", []logMsg{{LogLog, "m1", "Iteration 1\nIteration 2\n"}},
+			"[m1] Iteration 1\n[m1] Iteration 2\n"},
+		{"line in parts", []logMsg{{LogLog, "m1", "Iter"}, {LogLog, "m1", "ation 1\nIteration 2"},
+			{LogLog, "m1", "\n"}},
+			"[m1] Iteration 1\n[m1] Iteration 2\n"},
+		{"no problem", []logMsg{{LogWarning, "", "Version 22.1\n"}, {LogResults, "m2", "Optimal\n"}},
+			"Version 22.1\n[m2] Optimal\n"},
+		{"empty message", []logMsg{{LogResults, "m1", ""}, {LogResults, "m1", "\n"}},
+			"[m1] \n"},
+	}
+
+	for _, tt := range tests {
+		var b bytes.Buffer
+		fn := logWriterFunc(&b)
+		for _, m := range tt.msgs {
+			fn(m.channel, m.prob, m.msg)
+		}
+		if got := b.String(); got != tt.want {
+			t.Errorf("%s: output = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+//==============================================================================
+
+func TestLoggerFunc(t *testing.T) {
+
+	var b bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&b, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	fn := loggerFunc(logger)
+	fn(LogError, "m1", "CPLEX Error  1217: No solution exists.\n")
+	fn(LogWarning, "", "Warning:  Non-integral bounds.\n\n   \n")
+	fn(LogLog, "m1", "Iteration 1\r\nIteration 2\n")
+
+	want := `level=ERROR msg="CPLEX Error  1217: No solution exists." channel=error problem=m1
+level=WARN msg="Warning:  Non-integral bounds." channel=warning
+level=INFO msg="Iteration 1" channel=log problem=m1
+level=INFO msg="Iteration 2" channel=log problem=m1
+`
+	if got := b.String(); got != want {
+		t.Errorf("records =\n%s\nwant\n%s", got, want)
+	}
+}
+
+//==============================================================================
+
+// TestSetLogWriter checks that the messages sent while solving are written
+// with the name of the problem solved, and that none are written once the
+// capture stops.
+func TestSetLogWriter(t *testing.T) {
+
+	fake := NewFakeBackend()
+	env, first := newTestProb(t, fake, fakeModel("C"))
+	second, err := env.CreateProb("second")
+	if err != nil {
+		t.Fatalf("CreateProb failed: %v", err)
+	}
+
+	fake.Solutions = []FakeSolution{
+		{Log: []string{"Dual simplex - Optimal:  Objective =  8.0\n"}},
+		{Log: []string{"No rows\n", "Optimal"}},
+		{Log: []string{"Not captured\n"}},
+	}
+
+	var b bytes.Buffer
+	if err = env.SetLogWriter(&b); err != nil {
+		t.Fatalf("SetLogWriter failed: %v", err)
+	}
+	if err = first.LpOpt(); err != nil {
+		t.Fatalf("LpOpt failed: %v", err)
+	}
+	if err = second.LpOpt(); err != nil {
+		t.Fatalf("LpOpt failed: %v", err)
+	}
+
+	if err = env.SetLogWriter(nil); err != nil {
+		t.Fatalf("SetLogWriter(nil) failed: %v", err)
+	}
+	if err = first.LpOpt(); err != nil {
+		t.Fatalf("LpOpt failed: %v", err)
+	}
+
+	want := "[fake] Dual simplex - Optimal:  Objective =  8.0\n[second] No rows\n[second] Optimal"
+	if got := b.String(); got != want {
+		t.Errorf("log = %q, want %q", got, want)
+	}
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,253 @@
+// 01   Oct. 16, 2026   Initial version, explicit choice of the LP optimizer
+// 02   Oct. 16, 2026   Optimizers run through Problem.optimize
+
+package gpx
+
+import (
+	"context"
+	"github.com/pkg/errors"
+)
+
+//==============================================================================
+// PROBLEM FUNCTIONS
+//==============================================================================
+
+// PrimOpt solves the LP with the primal simplex optimizer.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXprimopt.
+func (p *Problem) PrimOpt() error {
+
+	return p.optimize(context.Background(), "PrimOpt", func(ctx context.Context) error {
+		return p.slv.PrimOpt(ctx)
+	})
+}
+
+//==============================================================================
+
+// DualOpt solves the LP with the dual simplex optimizer.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXdualopt.
+func (p *Problem) DualOpt() error {
+
+	return p.optimize(context.Background(), "DualOpt", func(ctx context.Context) error {
+		return p.slv.DualOpt(ctx)
+	})
+}
+
+//==============================================================================
+
+// BarOpt solves the LP with the barrier optimizer, followed by the crossover
+// selected by ParamBarCrossover (automatic by default), which turns the
+// barrier solution into a basic one. See HybBarOpt to choose the crossover.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXbaropt.
+func (p *Problem) BarOpt() error {
+
+	return p.HybBarOpt(AlgAuto)
+}
+
+//==============================================================================
+
+// HybBarOpt solves the LP with the barrier optimizer, followed by a primal
+// (AlgPrimal) or dual (AlgDual) crossover, or without crossover (AlgNone). A
+// solution found without crossover is not basic: GetSolnInfo reports it as
+// SolnNonbasic, and neither GetBase nor the sensitivity analysis functions are
+// available. AlgAuto selects the crossover set by ParamBarCrossover, as
+// BarOpt does.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXhybbaropt, or CPXbaropt for AlgAuto.
+func (p *Problem) HybBarOpt(crossover Algorithm) error {
+
+	switch crossover {
+	case AlgAuto, AlgNone, AlgPrimal, AlgDual:
+	default:
+		return errors.Errorf("Unexpected crossover algorithm %s", crossover)
+	}
+
+	return p.optimize(context.Background(), "BarOpt", func(ctx context.Context) error {
+		return p.slv.BarOpt(ctx, crossover)
+	})
+}
+
+//==============================================================================
+
+// HybNetOpt extracts the network embedded in the LP, solves it with the
+// network optimizer, and solves the whole LP from the resulting basis with the
+// primal (AlgPrimal) or dual (AlgDual) simplex optimizer.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXhybnetopt.
+func (p *Problem) HybNetOpt(method Algorithm) error {
+
+	if method != AlgPrimal && method != AlgDual {
+		return errors.Errorf("Unexpected simplex algorithm %s", method)
+	}
+
+	return p.optimize(context.Background(), "HybNetOpt", func(ctx context.Context) error {
+		return p.slv.HybNetOpt(ctx, method)
+	})
+}
+
+//==============================================================================
+
+// LpOptMethod solves the LP as LpOpt does, with the optimizer selected by the
+// method passed into this function instead of the one set by ParamLpMethod:
+// AlgAuto, AlgPrimal, AlgDual, AlgNet, AlgBarrier, AlgSifting or
+// AlgConcurrent. ParamLpMethod is restored afterwards.
+// It returns the algorithm which produced the solution, which is the method
+// chosen by Cplex for AlgAuto, and the optimizer which finished first for
+// AlgConcurrent; AlgNone is returned if no solution exists.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXsetintparam, CPXlpopt and CPXsolninfo.
+func (p *Problem) LpOptMethod(method Algorithm) (Algorithm, error) {
+
+	return p.LpOptMethodContext(context.Background(), method)
+}
+
+//==============================================================================
+
+// LpOptMethodContext solves the LP with the chosen optimizer in the same way as
+// LpOptMethod, but aborts the solve once the context passed into this function
+// is done, as LpOptContext does.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXsetterminate, CPXsetintparam, CPXlpopt and CPXsolninfo.
+func (p *Problem) LpOptMethodContext(ctx context.Context, method Algorithm) (Algorithm, error) {
+
+	if err := p.checkProb(); err != nil {
+		return AlgNone, err
+	}
+
+	switch method {
+	case AlgAuto, AlgPrimal, AlgDual, AlgNet, AlgBarrier, AlgSifting, AlgConcurrent:
+	default:
+		return AlgNone, errors.Errorf("Unexpected LP algorithm %s", method)
+	}
+
+	prev, err := p.env.drv.GetIntParam(int(ParamLpMethod))
+	if err != nil {
+		return AlgNone, err
+	}
+
+	if err = p.env.drv.SetIntParam(int(ParamLpMethod), int(method)); err != nil {
+		return AlgNone, err
+	}
+
+	err = p.optimize(ctx, "LpOpt", func(ctx context.Context) error {
+		return p.slv.LpOpt(ctx)
+	})
+
+	if rerr := p.env.drv.SetIntParam(int(ParamLpMethod), prev); rerr != nil && err == nil {
+		err = rerr
+	}
+	if err != nil {
+		return AlgNone, err
+	}
+
+	info, err := p.slv.SolnInfo()
+	if err != nil {
+		return AlgNone, err
+	}
+
+	return info.Method, nil
+}
+
+//==============================================================================
+// PACKAGE-LEVEL FUNCTIONS
+//==============================================================================
+
+// PrimOpt solves the default problem with the primal simplex optimizer. See
+// Problem.PrimOpt.
+func PrimOpt() error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.PrimOpt()
+}
+
+//==============================================================================
+
+// DualOpt solves the default problem with the dual simplex optimizer. See
+// Problem.DualOpt.
+func DualOpt() error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.DualOpt()
+}
+
+//==============================================================================
+
+// BarOpt solves the default problem with the barrier optimizer. See
+// Problem.BarOpt.
+func BarOpt() error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.BarOpt()
+}
+
+//==============================================================================
+
+// HybBarOpt solves the default problem with the barrier optimizer and the
+// chosen crossover. See Problem.HybBarOpt.
+func HybBarOpt(crossover Algorithm) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.HybBarOpt(crossover)
+}
+
+//==============================================================================
+
+// HybNetOpt solves the default problem with the network optimizer followed by
+// a simplex optimizer. See Problem.HybNetOpt.
+func HybNetOpt(method Algorithm) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.HybNetOpt(method)
+}
+
+//==============================================================================
+
+// LpOptMethod solves the default problem with the chosen optimizer. See
+// Problem.LpOptMethod.
+func LpOptMethod(method Algorithm) (Algorithm, error) {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return AlgNone, err
+	}
+
+	return prob.LpOptMethod(method)
+}
+
+//==============================================================================
+
+// LpOptMethodContext solves the default problem with the chosen optimizer,
+// aborting once the context is done. See Problem.LpOptMethodContext.
+func LpOptMethodContext(ctx context.Context, method Algorithm) (Algorithm, error) {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return AlgNone, err
+	}
+
+	return prob.LpOptMethodContext(ctx, method)
+}
+
+//============================ END OF FILE =====================================
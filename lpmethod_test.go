@@ -0,0 +1,105 @@
+package gpx
+
+import (
+	"github.com/pkg/errors"
+	"testing"
+)
+
+func TestLpOptMethod(t *testing.T) {
+
+	cplexErr := &CplexError{Op: "LpOpt", Code: 1001}
+
+	tests := []struct {
+		name     string
+		method   Algorithm
+		errOp    string       // Operation of the fake which fails, if any
+		want     Algorithm    // Algorithm returned
+		solved   bool         // Flag set if LpOpt is called
+		fails    bool
+	}{
+		{"primal", AlgPrimal, "", AlgPrimal, true, false},
+		{"barrier", AlgBarrier, "", AlgBarrier, true, false},
+		{"sifting", AlgSifting, "", AlgSifting, true, false},
+		{"automatic", AlgAuto, "", AlgDual, true, false},
+		{"concurrent", AlgConcurrent, "", AlgDual, true, false},
+		{"not an LP method", AlgMip, "", AlgNone, false, true},
+		{"solve fails", AlgNet, "LpOpt", AlgNone, true, true},
+		{"solution fails", AlgNet, "SolnInfo", AlgNone, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			fake := NewFakeBackend()
+			env, prob := newTestProb(t, fake, fakeModel("C"))
+			if err := env.SetIntParam(ParamLpMethod, int(AlgBarrier)); err != nil {
+				t.Fatalf("SetIntParam failed: %v", err)
+			}
+			if tt.errOp != "" {
+				fake.Errors[tt.errOp] = cplexErr
+			}
+
+			got, err := prob.LpOptMethod(tt.method)
+			if (err != nil) != tt.fails || got != tt.want {
+				t.Errorf("LpOptMethod = %s, %v, want %s, error %t", got, err, tt.want, tt.fails)
+			}
+			if tt.errOp != "" && errors.Cause(err) != cplexErr {
+				t.Errorf("LpOptMethod error = %v, want cause %v", err, cplexErr)
+			}
+
+			// The method is restored whether or not the solve succeeded.
+			var method int
+			if err = env.GetIntParam(ParamLpMethod, &method); err != nil || method != int(AlgBarrier) {
+				t.Errorf("ParamLpMethod = %d, %v after LpOptMethod, want %d", method, err, AlgBarrier)
+			}
+
+			// The method chosen is set while LpOpt runs.
+			var sets []interface{}
+			solved := false
+			for _, c := range fake.Calls() {
+				switch c.Op {
+				case "SetIntParam":
+					sets = append(sets, c.Args[1])
+				case "LpOpt":
+					solved = true
+					if len(sets) < 2 || sets[len(sets) - 1] != int(tt.method) {
+						t.Errorf("ParamLpMethod set to %v before LpOpt, want %d", sets, tt.method)
+					}
+				}
+			}
+			if solved != tt.solved {
+				t.Errorf("LpOpt called %t, want %t", solved, tt.solved)
+			}
+		})
+	}
+}
+
+//==============================================================================
+
+// TestLpOptMethodSetFails checks that the problem is not solved, and the
+// method left unchanged, if the environment refuses to set the method.
+func TestLpOptMethodSetFails(t *testing.T) {
+
+	fake := NewFakeBackend()
+	env, prob := newTestProb(t, fake, fakeModel("C"))
+
+	cplexErr := &CplexError{Op: "SetIntParam", Code: 1015}
+	fake.Errors["SetIntParam"] = cplexErr
+
+	if got, err := prob.LpOptMethod(AlgPrimal); got != AlgNone || errors.Cause(err) != cplexErr {
+		t.Errorf("LpOptMethod = %s, %v, want %s, %v", got, err, AlgNone, cplexErr)
+	}
+
+	for _, c := range fake.Calls() {
+		if c.Op == "LpOpt" {
+			t.Error("LpOpt called although the method could not be set")
+		}
+	}
+
+	var method int
+	if err := env.GetIntParam(ParamLpMethod, &method); err != nil || method != int(AlgAuto) {
+		t.Errorf("ParamLpMethod = %d, %v, want %d", method, err, AlgAuto)
+	}
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,455 @@
+// 01   Oct. 16, 2026   Initial version, MIP starts
+// 02   Oct. 16, 2026   The starts are copied here only, not by the backends
+
+package gpx
+
+import (
+	"encoding/xml"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"os"
+)
+
+// MIPStartEffort is the effort which MipOpt spends on a MIP start to turn it
+// into a first integer solution. The values are the Cplex CPX_MIPSTART_*
+// codes.
+type MIPStartEffort int
+
+// Effort levels of MIP starts.
+const (
+	EffortAuto               MIPStartEffort = 0   // Chosen by Cplex (CPX_MIPSTART_AUTO)
+	EffortCheckFeas          MIPStartEffort = 1   // Check the feasibility of a complete start (CPX_MIPSTART_CHECKFEAS)
+	EffortSolveFixed         MIPStartEffort = 2   // Solve with the integer columns fixed (CPX_MIPSTART_SOLVEFIXED)
+	EffortSolveMIP           MIPStartEffort = 3   // Solve a MIP over the columns not given (CPX_MIPSTART_SOLVEMIP)
+	EffortRepair             MIPStartEffort = 4   // Repair an infeasible start (CPX_MIPSTART_REPAIR)
+	EffortNoCheck            MIPStartEffort = 5   // Accept the start without checking it (CPX_MIPSTART_NOCHECK)
+)
+
+// InputMIPStart defines a data structure of a MIP start, passed as an input
+// argument to AddMIPStarts and ChgMIPStarts, and returned by GetMIPStarts. Each
+// value refers to its column by name or by index, as in ChgObj, so that the
+// names of the SolnCol entries returned by GetMipSolution can be used to start
+// from a previous solution. A start need not give a value to every column.
+type InputMIPStart struct {
+	Name     string           // Name of the MIP start
+	Effort   MIPStartEffort   // Effort spent on the start by MipOpt
+	Values   []InputColValue  // Values of the columns in the start
+}
+
+//==============================================================================
+// PROBLEM FUNCTIONS
+//==============================================================================
+
+// AddMIPStarts appends the MIP starts specified to the problem. MipOpt uses
+// them to find a first integer solution, with the effort set for each start.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXaddmipstarts.
+func (p *Problem) AddMIPStarts(mList []InputMIPStart) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	if len(mList) < 1 {
+		return errors.Errorf("AddMIPStarts expected more than %d MIP starts", len(mList))
+	}
+
+	starts, err := p.mipStarts("AddMIPStarts", mList)
+	if err != nil {
+		return err
+	}
+
+	return p.slv.AddMIPStarts(starts)
+}
+
+//==============================================================================
+
+// ChgMIPStarts changes the MIP starts with the indices specified, one entry of
+// mList per index. The effort of each start is replaced, the values given
+// replace those of the same columns, and the values of the other columns are
+// kept. The names in mList are ignored.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXchgmipstarts.
+func (p *Problem) ChgMIPStarts(indices []int, mList []InputMIPStart) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	if len(mList) < 1 || len(indices) != len(mList) {
+		return errors.Errorf("ChgMIPStarts found %d indices and %d MIP starts", len(indices), len(mList))
+	}
+
+	numStarts := p.slv.NumMIPStarts()
+	for i, index := range indices {
+		if index < 0 || index >= numStarts {
+			return errors.Errorf("ChgMIPStarts found index %d for item %d, expected 0 to %d",
+				index, i, numStarts - 1)
+		}
+	}
+
+	starts, err := p.mipStarts("ChgMIPStarts", mList)
+	if err != nil {
+		return err
+	}
+
+	return p.slv.ChgMIPStarts(append([]int(nil), indices...), starts)
+}
+
+//==============================================================================
+
+// GetMIPStarts obtains all MIP starts of the problem, and populates the slice
+// passed to the function with one entry per start, in the order in which they
+// were added. Each value gives both the index and the name of its column.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXgetnummipstarts, CPXgetmipstarts and
+// CPXgetmipstartname.
+func (p *Problem) GetMIPStarts(mList *[]InputMIPStart) error {
+
+	*mList = nil
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	starts := make([]InputMIPStart, p.slv.NumMIPStarts())
+	if err := p.slv.MIPStarts(starts); err != nil {
+		return errors.Wrap(err, "GetMIPStarts failed")
+	}
+
+	names, err := p.slv.MIPStartNames()
+	if err != nil {
+		return errors.Wrap(err, "GetMIPStarts failed to get MIP start names")
+	}
+
+	colNames, err := p.slv.ColNames()
+	if err != nil {
+		return errors.Wrap(err, "GetMIPStarts failed to get column names")
+	}
+
+	for i := range starts {
+		starts[i].Name = names[i]
+		for k := range starts[i].Values {
+			starts[i].Values[k].ColName = colNames[starts[i].Values[k].ColIndex]
+		}
+	}
+	*mList = starts
+
+	return nil
+}
+
+//==============================================================================
+
+// GetNumMIPStarts obtains the number of MIP starts in the current problem, or
+// 0 if none exist or the problem has not yet been defined. At this time the
+// function always returns nil (success).
+// This function uses CPXgetnummipstarts.
+func (p *Problem) GetNumMIPStarts(numStarts *int) error {
+
+	*numStarts = 0
+	if p.checkProb() == nil {
+		*numStarts = p.slv.NumMIPStarts()
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// ReadMIPStarts reads MIP starts from a file in MST format (.mst), as written
+// by WriteMIPStarts or by Cplex, and adds them to the problem. Names in the
+// file refer to the names of the columns of the problem.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXreadcopymipstarts.
+func (p *Problem) ReadMIPStarts(fileName string) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	defer p.logScope()()
+
+	return p.slv.ReadMIPStarts(fileName)
+}
+
+//==============================================================================
+
+// WriteMIPStarts writes all MIP starts of the problem to a file in MST format
+// (.mst), replacing the file if it exists.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXwritemipstarts.
+func (p *Problem) WriteMIPStarts(fileName string) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	if numStarts := p.slv.NumMIPStarts(); numStarts < 1 {
+		return errors.Errorf("WriteMIPStarts expected more than %d MIP starts", numStarts)
+	}
+
+	defer p.logScope()()
+
+	return p.slv.WriteMIPStarts(fileName)
+}
+
+//==============================================================================
+
+// mipStarts checks the MIP starts passed to operation op, and returns a copy
+// in which the columns of the values are given by index.
+func (p *Problem) mipStarts(op string, mList []InputMIPStart) ([]InputMIPStart, error) {
+
+	cols   := p.colLookup()
+	starts := make([]InputMIPStart, len(mList))
+
+	for i, m := range mList {
+		if m.Effort < EffortAuto || m.Effort > EffortNoCheck {
+			return nil, errors.Errorf("%s found effort %d for MIP start %d", op, m.Effort, i)
+		}
+
+		if len(m.Values) < 1 {
+			return nil, errors.Errorf("%s found no values for MIP start %d", op, i)
+		}
+
+		seen   := make(map[int]bool, len(m.Values))
+		values := make([]InputColValue, len(m.Values))
+		for k, v := range m.Values {
+			j, err := cols.resolve(v.ColIndex, v.ColName)
+			if err != nil {
+				return nil, errors.Wrapf(err, "%s failed for value %d of MIP start %d", op, k, i)
+			}
+			if seen[j] {
+				return nil, errors.Errorf("%s found column %d twice in MIP start %d", op, j, i)
+			}
+			seen[j]   = true
+			values[k] = InputColValue{ColIndex: j, Value: v.Value}
+		}
+
+		starts[i] = InputMIPStart{Name: m.Name, Effort: m.Effort, Values: values}
+	}
+
+	return starts, nil
+}
+
+//==============================================================================
+
+// copyMIPStarts returns a copy of the MIP starts, each with its own Values
+// slice.
+func copyMIPStarts(mList []InputMIPStart) []InputMIPStart {
+
+	copied := make([]InputMIPStart, len(mList))
+	for i, m := range mList {
+		m.Values  = append([]InputColValue(nil), m.Values...)
+		copied[i] = m
+	}
+
+	return copied
+}
+
+//==============================================================================
+// MST FILES FOR THE BACKENDS WRITTEN IN GO
+//==============================================================================
+
+// mstSolutions is the root element of an MST file holding several MIP starts.
+type mstSolutions struct {
+	XMLName    xml.Name       `xml:"CPLEXSolutions"`
+	Version    string         `xml:"version,attr"`
+	Solutions  []mstSolution  `xml:"CPLEXSolution"`
+}
+
+// mstSolution is a single MIP start, which is also the root element of an MST
+// file holding one start only.
+type mstSolution struct {
+	XMLName    xml.Name       `xml:"CPLEXSolution"`
+	Version    string         `xml:"version,attr"`
+	Header     mstHeader      `xml:"header"`
+	Variables  []mstVariable  `xml:"variables>variable"`
+}
+
+// mstHeader holds the attributes of a MIP start.
+type mstHeader struct {
+	ProblemName    string  `xml:"problemName,attr"`
+	SolutionName   string  `xml:"solutionName,attr"`
+	SolutionIndex  int     `xml:"solutionIndex,attr"`
+	Effort         int     `xml:"MIPStartEffortLevel,attr"`
+	WriteLevel     int     `xml:"writeLevel,attr"`
+}
+
+// mstVariable holds the value of a column in a MIP start.
+type mstVariable struct {
+	Name   string   `xml:"name,attr"`
+	Index  int      `xml:"index,attr"`
+	Value  float64  `xml:"value,attr"`
+}
+
+//==============================================================================
+
+// writeMIPStartFile writes the MIP starts to a file in MST format, with the
+// columns named as in a basis file.
+func writeMIPStartFile(fileName string, probName string, colNames []string, starts []InputMIPStart) error {
+
+	root := mstSolutions{Version: "1.2"}
+	for i, m := range starts {
+		s := mstSolution{Version: "1.2", Header: mstHeader{ProblemName: probName, SolutionName: m.Name,
+			SolutionIndex: i, Effort: int(m.Effort), WriteLevel: 1}}
+		for _, v := range m.Values {
+			s.Variables = append(s.Variables, mstVariable{Name: basisName(colNames, v.ColIndex, "x"),
+				Index: v.ColIndex, Value: v.Value})
+		}
+		root.Solutions = append(root.Solutions, s)
+	}
+
+	data, err := xml.MarshalIndent(root, "", " ")
+	if err != nil {
+		return errors.Wrapf(err, "Failed to write %s", fileName)
+	}
+
+	f, err := os.Create(fileName)
+	if err != nil {
+		return errors.Wrap(ErrFileOpen, err.Error())
+	}
+
+	_, err = f.Write(append([]byte(xml.Header), append(data, '\n')...))
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return errors.Wrapf(err, "Failed to write %s", fileName)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// readMIPStartFile reads the MIP starts of a file in MST format, holding either
+// several starts or a single one. Columns are found by name, named as in a
+// basis file.
+// In case the file cannot be read or refers to unknown names, it returns an
+// error.
+func readMIPStartFile(fileName string, colNames []string) ([]InputMIPStart, error) {
+
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, errors.Wrap(ErrFileOpen, err.Error())
+	}
+
+	var root mstSolutions
+	if err = xml.Unmarshal(data, &root); err != nil {
+		var single mstSolution
+		if xml.Unmarshal(data, &single) != nil {
+			return nil, errors.Wrapf(err, "Failed to read %s", fileName)
+		}
+		root.Solutions = []mstSolution{single}
+	}
+
+	colIndex := make(map[string]int, len(colNames))
+	for j := range colNames {
+		colIndex[basisName(colNames, j, "x")] = j
+	}
+
+	starts := make([]InputMIPStart, len(root.Solutions))
+	for i, s := range root.Solutions {
+		if s.Header.Effort < int(EffortAuto) || s.Header.Effort > int(EffortNoCheck) {
+			return nil, errors.Errorf("MIP start %d of %s has effort %d", i, fileName, s.Header.Effort)
+		}
+		starts[i] = InputMIPStart{Name: s.Header.SolutionName, Effort: MIPStartEffort(s.Header.Effort)}
+		for _, v := range s.Variables {
+			j, ok := colIndex[v.Name]
+			if !ok {
+				return nil, errors.Errorf("MIP start %d of %s refers to unknown column %s", i, fileName, v.Name)
+			}
+			starts[i].Values = append(starts[i].Values, InputColValue{ColIndex: j, Value: v.Value})
+		}
+	}
+
+	return starts, nil
+}
+
+//==============================================================================
+// PACKAGE-LEVEL FUNCTIONS
+//==============================================================================
+
+// AddMIPStarts appends MIP starts to the default problem. See
+// Problem.AddMIPStarts.
+func AddMIPStarts(mList []InputMIPStart) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.AddMIPStarts(mList)
+}
+
+//==============================================================================
+
+// ChgMIPStarts changes MIP starts of the default problem. See
+// Problem.ChgMIPStarts.
+func ChgMIPStarts(indices []int, mList []InputMIPStart) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.ChgMIPStarts(indices, mList)
+}
+
+//==============================================================================
+
+// GetMIPStarts obtains the MIP starts of the default problem. See
+// Problem.GetMIPStarts.
+func GetMIPStarts(mList *[]InputMIPStart) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetMIPStarts(mList)
+}
+
+//==============================================================================
+
+// GetNumMIPStarts obtains the number of MIP starts of the default problem. See
+// Problem.GetNumMIPStarts.
+func GetNumMIPStarts(numStarts *int) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetNumMIPStarts(numStarts)
+}
+
+//==============================================================================
+
+// ReadMIPStarts reads MIP starts from a file into the default problem. See
+// Problem.ReadMIPStarts.
+func ReadMIPStarts(fileName string) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.ReadMIPStarts(fileName)
+}
+
+//==============================================================================
+
+// WriteMIPStarts writes the MIP starts of the default problem to a file. See
+// Problem.WriteMIPStarts.
+func WriteMIPStarts(fileName string) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.WriteMIPStarts(fileName)
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,303 @@
+package gpx
+
+import (
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testMIPStarts are added to the problem of goModel, giving values by name
+// and by index, with a different effort level each.
+var testMIPStarts = []InputMIPStart{
+	{"first", EffortCheckFeas, []InputColValue{{ColName: "x", Value: 2}, {ColName: "y", Value: 1}}},
+	{"partial", EffortSolveMIP, []InputColValue{{ColIndex: 1, Value: 0.5}}},
+	{"repair", EffortRepair, []InputColValue{{ColIndex: 1, Value: 7}, {ColIndex: 0, Value: 3}}},
+}
+
+//==============================================================================
+
+func TestMIPStartFileRoundTrip(t *testing.T) {
+
+	_, prob := newTestProb(t, NewGoBackend(), goModel("I"))
+	if err := prob.AddMIPStarts(testMIPStarts); err != nil {
+		t.Fatalf("AddMIPStarts failed: %v", err)
+	}
+
+	var want []InputMIPStart
+	if err := prob.GetMIPStarts(&want); err != nil {
+		t.Fatalf("GetMIPStarts failed: %v", err)
+	}
+
+	fileName := filepath.Join(t.TempDir(), "starts.mst")
+	if err := prob.WriteMIPStarts(fileName); err != nil {
+		t.Fatalf("WriteMIPStarts failed: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	for _, s := range []string{"<CPLEXSolutions", `solutionName="repair"`, `MIPStartEffortLevel="4"`,
+		`<variable name="y" index="1" value="0.5">`} {
+		if !strings.Contains(string(data), s) {
+			t.Errorf("%s does not contain %s:\n%s", fileName, s, data)
+		}
+	}
+
+	_, read := newTestProb(t, NewGoBackend(), goModel("I"))
+	if err = read.ReadMIPStarts(fileName); err != nil {
+		t.Fatalf("ReadMIPStarts failed: %v", err)
+	}
+
+	var got []InputMIPStart
+	if err = read.GetMIPStarts(&got); err != nil {
+		t.Fatalf("GetMIPStarts failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetMIPStarts after ReadMIPStarts = %+v, want %+v", got, want)
+	}
+
+	// Reading the file again appends its starts.
+	if err = read.ReadMIPStarts(fileName); err != nil {
+		t.Fatalf("ReadMIPStarts failed: %v", err)
+	}
+
+	var numStarts int
+	if read.GetNumMIPStarts(&numStarts); numStarts != 2 * len(want) {
+		t.Errorf("GetNumMIPStarts = %d after reading twice, want %d", numStarts, 2 * len(want))
+	}
+}
+
+//==============================================================================
+
+func TestReadMIPStarts(t *testing.T) {
+
+	single := `<?xml version="1.0"?>
+<CPLEXSolution version="1.2">
+ <header problemName="go" solutionName="single" MIPStartEffortLevel="5"/>
+ <variables>
+  <variable name="y" index="1" value="1"/>
+ </variables>
+</CPLEXSolution>
+`
+
+	tests := []struct {
+		name   string
+		text   string
+		want   []InputMIPStart   // nil for an error
+	}{
+		{"single start", single,
+			[]InputMIPStart{{"single", EffortNoCheck, []InputColValue{{1, "y", 1}}}}},
+		{"no variables", `<CPLEXSolutions version="1.2"><CPLEXSolution version="1.2">` +
+			`<header solutionName="none" MIPStartEffortLevel="0"/></CPLEXSolution></CPLEXSolutions>`,
+			[]InputMIPStart{{"none", EffortAuto, nil}}},
+		{"unknown column", strings.Replace(single, `name="y"`, `name="z"`, 1), nil},
+		{"effort too large", strings.Replace(single, `MIPStartEffortLevel="5"`, `MIPStartEffortLevel="6"`, 1), nil},
+		{"negative effort", strings.Replace(single, `MIPStartEffortLevel="5"`, `MIPStartEffortLevel="-1"`, 1), nil},
+		{"not xml", "CPXPARAM_Threads 4\n", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			_, prob := newTestProb(t, NewGoBackend(), goModel("I"))
+
+			fileName := filepath.Join(t.TempDir(), "start.mst")
+			if err := ioutil.WriteFile(fileName, []byte(tt.text), 0644); err != nil {
+				t.Fatalf("WriteFile failed: %v", err)
+			}
+
+			err := prob.ReadMIPStarts(fileName)
+			if (err != nil) != (tt.want == nil) {
+				t.Fatalf("ReadMIPStarts error = %v, want error %t", err, tt.want == nil)
+			}
+
+			var got []InputMIPStart
+			if err = prob.GetMIPStarts(&got); err != nil {
+				t.Fatalf("GetMIPStarts failed: %v", err)
+			}
+			if tt.want == nil && len(got) != 0 {
+				t.Errorf("GetMIPStarts = %+v after a failed read, want none", got)
+			}
+			if tt.want != nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetMIPStarts = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	_, prob := newTestProb(t, NewGoBackend(), goModel("I"))
+	if err := prob.ReadMIPStarts(filepath.Join(t.TempDir(), "missing.mst")); !errors.Is(err, ErrFileOpen) {
+		t.Errorf("ReadMIPStarts of a missing file returned %v, want %v", err, ErrFileOpen)
+	}
+	if err := prob.WriteMIPStarts(filepath.Join(t.TempDir(), "none.mst")); err == nil {
+		t.Error("WriteMIPStarts without MIP starts succeeded, want error")
+	}
+}
+
+//==============================================================================
+
+func TestChgMIPStarts(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		indices  []int
+		mList    []InputMIPStart
+		want     []InputMIPStart   // nil for an error
+	}{
+		{"effort only", []int{0}, []InputMIPStart{{"", EffortNoCheck, []InputColValue{{ColName: "x", Value: 2}}}},
+			[]InputMIPStart{{"first", EffortNoCheck, []InputColValue{{0, "x", 2}, {1, "y", 1}}}}},
+		{"replace and append", []int{1}, []InputMIPStart{{"ignored", EffortAuto,
+			[]InputColValue{{ColIndex: 1, Value: 1}, {ColIndex: 0, Value: 3}}}},
+			[]InputMIPStart{{"partial", EffortAuto, []InputColValue{{1, "y", 1}, {0, "x", 3}}}}},
+		{"two starts", []int{2, 0}, []InputMIPStart{
+			{"", EffortSolveFixed, []InputColValue{{ColIndex: 0, Value: 1}}},
+			{"", EffortRepair, []InputColValue{{ColIndex: 1, Value: 0}}}},
+			[]InputMIPStart{
+				{"repair", EffortSolveFixed, []InputColValue{{1, "y", 7}, {0, "x", 1}}},
+				{"first", EffortRepair, []InputColValue{{0, "x", 2}, {1, "y", 0}}}}},
+		{"effort too large", []int{0}, []InputMIPStart{{"", EffortNoCheck + 1, []InputColValue{{0, "", 1}}}}, nil},
+		{"negative effort", []int{0}, []InputMIPStart{{"", EffortAuto - 1, []InputColValue{{0, "", 1}}}}, nil},
+		{"index out of range", []int{3}, []InputMIPStart{{"", EffortAuto, []InputColValue{{0, "", 1}}}}, nil},
+		{"fewer indices", nil, []InputMIPStart{{"", EffortAuto, []InputColValue{{0, "", 1}}}}, nil},
+		{"no values", []int{0}, []InputMIPStart{{"", EffortAuto, nil}}, nil},
+		{"column twice", []int{0}, []InputMIPStart{{"", EffortAuto,
+			[]InputColValue{{ColIndex: 0, Value: 1}, {ColName: "x", Value: 2}}}}, nil},
+		{"unknown column", []int{0}, []InputMIPStart{{"", EffortAuto, []InputColValue{{ColName: "z", Value: 1}}}}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			_, prob := newTestProb(t, NewGoBackend(), goModel("I"))
+			if err := prob.AddMIPStarts(testMIPStarts); err != nil {
+				t.Fatalf("AddMIPStarts failed: %v", err)
+			}
+
+			var before []InputMIPStart
+			prob.GetMIPStarts(&before)
+
+			err := prob.ChgMIPStarts(tt.indices, tt.mList)
+			if (err != nil) != (tt.want == nil) {
+				t.Fatalf("ChgMIPStarts error = %v, want error %t", err, tt.want == nil)
+			}
+
+			var got []InputMIPStart
+			if err = prob.GetMIPStarts(&got); err != nil {
+				t.Fatalf("GetMIPStarts failed: %v", err)
+			}
+
+			if tt.want == nil {
+				if !reflect.DeepEqual(got, before) {
+					t.Errorf("GetMIPStarts = %+v after a failed change, want %+v", got, before)
+				}
+				return
+			}
+
+			for i, index := range tt.indices {
+				if !reflect.DeepEqual(got[index], tt.want[i]) {
+					t.Errorf("MIP start %d = %+v, want %+v", index, got[index], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+//==============================================================================
+
+// TestMIPStartsCopy checks that the backend keeps the MIP starts as they were
+// passed, whether the caller changes its slices afterwards, the starts are
+// changed or columns are deleted from the problem.
+func TestMIPStartsCopy(t *testing.T) {
+
+	fake := NewFakeBackend()
+	_, prob := newTestProb(t, fake, goModel("I"))
+
+	mList   := []InputMIPStart{{"s", EffortAuto, []InputColValue{{ColName: "x", Value: 2}, {ColIndex: 1, Value: 1}}}}
+	chgList := []InputMIPStart{{"", EffortRepair, []InputColValue{{ColIndex: 0, Value: 3}}}}
+	wantAdd := []InputMIPStart{{"s", EffortAuto, []InputColValue{{0, "", 2}, {1, "", 1}}}}
+	wantChg := []InputMIPStart{{"", EffortRepair, []InputColValue{{0, "", 3}}}}
+
+	if err := prob.AddMIPStarts(mList); err != nil {
+		t.Fatalf("AddMIPStarts failed: %v", err)
+	}
+	mList[0].Values[1].Value = 7
+
+	if err := prob.ChgMIPStarts([]int{0}, chgList); err != nil {
+		t.Fatalf("ChgMIPStarts failed: %v", err)
+	}
+	chgList[0].Values[0].Value = 7
+
+	if _, err := prob.DelCols(0, 0); err != nil {
+		t.Fatalf("DelCols failed: %v", err)
+	}
+
+	for _, c := range fake.Calls() {
+		switch {
+		case c.Op == "AddMIPStarts" && !reflect.DeepEqual(c.Args[0], wantAdd):
+			t.Errorf("AddMIPStarts recorded %+v, want %+v", c.Args[0], wantAdd)
+		case c.Op == "ChgMIPStarts" && !reflect.DeepEqual(c.Args[1], wantChg):
+			t.Errorf("ChgMIPStarts recorded %+v, want %+v", c.Args[1], wantChg)
+		}
+	}
+
+	want := []InputMIPStart{{"s", EffortRepair, []InputColValue{{0, "y", 1}}}}
+	var starts []InputMIPStart
+	if err := prob.GetMIPStarts(&starts); err != nil || !reflect.DeepEqual(starts, want) {
+		t.Errorf("GetMIPStarts = %+v, %v, want %+v", starts, err, want)
+	}
+}
+
+//==============================================================================
+
+// TestChgMIPStartsMipOpt checks that MipOpt uses a MIP start once ChgMIPStarts
+// has completed it, whatever its effort level, and stops at the node limit
+// with the start as the incumbent. With the objective 3x + 4y, the root
+// relaxation is fractional, at x = 2.5 and y = 1.5.
+func TestChgMIPStartsMipOpt(t *testing.T) {
+
+	for effort := EffortAuto; effort <= EffortNoCheck; effort++ {
+
+		env, prob := newTestProb(t, NewGoBackend(), goModel("I"))
+		if err := env.SetLongParam(ParamNodeLimit, 1); err != nil {
+			t.Fatalf("SetLongParam failed: %v", err)
+		}
+		if err := prob.ChgObj([]InputColValue{{ColName: "y", Value: 4}}); err != nil {
+			t.Fatalf("ChgObj failed: %v", err)
+		}
+
+		// Alone, the start of y is incomplete and ignored.
+		if err := prob.AddMIPStarts([]InputMIPStart{testMIPStarts[1]}); err != nil {
+			t.Fatalf("AddMIPStarts failed: %v", err)
+		}
+		if err := prob.MipOpt(); err != nil {
+			t.Fatalf("MipOpt failed: %v", err)
+		}
+
+		var status SolutionStatus
+		if prob.GetStatus(&status); status != MipNodeLimInfeas {
+			t.Errorf("effort %d: GetStatus = %s with an incomplete start, want %s", effort, status, MipNodeLimInfeas)
+		}
+
+		if err := prob.ChgMIPStarts([]int{0}, []InputMIPStart{{"", effort,
+			[]InputColValue{{ColName: "x", Value: 3}, {ColName: "y", Value: 1}}}}); err != nil {
+			t.Fatalf("ChgMIPStarts failed: %v", err)
+		}
+		if err := prob.MipOpt(); err != nil {
+			t.Fatalf("MipOpt failed: %v", err)
+		}
+
+		var objVal float64
+		var sRows []SolnRow
+		var sCols []SolnCol
+		prob.GetStatus(&status)
+		if err := prob.GetMipSolution(&objVal, &sRows, &sCols); err != nil || status != MipNodeLimFeas || objVal != 13 {
+			t.Errorf("effort %d: %s, objective %g, %v, want %s, 13", effort, status, objVal, err, MipNodeLimFeas)
+		}
+	}
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,582 @@
+// 01   Oct. 16, 2026   Initial version, in-memory model shared by the Go backends
+// 02   Oct. 16, 2026   Added changes of bounds, RHS, senses, objective, types and names
+// 03   Oct. 16, 2026   Added deletion of rows and columns
+// 04   Oct. 16, 2026   Added rows and columns with their coefficients
+// 05   Oct. 16, 2026   Added the quadratic objective matrix
+// 06   Oct. 16, 2026   Added quadratic constraints
+// 07   Oct. 16, 2026   Added indicator constraints
+// 08   Oct. 16, 2026   Added special ordered sets
+// 09   Oct. 16, 2026   Added piecewise linear constraints
+// 10   Oct. 16, 2026   Added MIP starts
+// 11   Oct. 16, 2026   Added solution pool filters
+// 12   Oct. 16, 2026   chgCoefList looks coefficients up in a map
+// 13   Oct. 16, 2026   Added deletion of indicator and piecewise linear constraints
+// 14   Oct. 16, 2026   chgQPCoef looks coefficients up in a map
+// 15   Oct. 16, 2026   Deleting columns leaves the slices of quadratic constraints unchanged
+// 16   Oct. 16, 2026   Deleting columns leaves the slices of indicator constraints unchanged
+// 17   Oct. 16, 2026   Deleting columns leaves the members of special ordered sets unchanged
+// 18   Oct. 16, 2026   Changing MIP starts or deleting columns leaves their values unchanged
+// 19   Oct. 16, 2026   Deleting columns leaves the columns of pool filters unchanged
+
+package gpx
+
+import (
+	"github.com/pkg/errors"
+)
+
+// memModel is an in-memory copy of a model, kept by the backends written in Go
+// (FakeBackend and GoBackend) in the same form in which it is passed to gpx.
+// The constraints keep the slices received from gpx, which FakeBackend also
+// records, so changes to them build new slices rather than writing in place.
+type memModel struct {
+	name      string            // Name of the problem
+	sense     int               // Objective sense, 1 = minimize, -1 = maximize
+	rows      []InputRow        // Rows of the model
+	cols      []InputCol        // Columns of the model
+	obj       []float64         // Objective function coefficient of each column
+	elems     []InputElem       // Non-zero coefficients, at most one per position
+	quad      []InputQuadCoef   // Non-zero coefficients of Q, both halves, at most one per position
+	qconstrs  []InputQConstr    // Quadratic constraints
+	inds      []InputIndicator  // Indicator constraints
+	sos       []InputSOS        // Special ordered sets
+	pwl       []InputPwl        // Piecewise linear constraints
+	starts    []InputMIPStart   // MIP starts
+	filters   []InputPoolFilter // Solution pool filters
+}
+
+//==============================================================================
+
+// newRows appends copies of the rows to the model.
+func (m *memModel) newRows(rList []InputRow) {
+
+	m.rows = append(m.rows, rList...)
+}
+
+//==============================================================================
+
+// newCols appends copies of the columns and their objective function
+// coefficients to the model. Column indices in objList refer to cList.
+func (m *memModel) newCols(objList []InputObjCoef, cList []InputCol) {
+
+	obj := make([]float64, len(cList))
+	for i := 0; i < len(objList); i++ {
+		obj[objList[i].ColIndex] = objList[i].Value
+	}
+
+	m.cols = append(m.cols, cList...)
+	m.obj  = append(m.obj, obj...)
+}
+
+//==============================================================================
+
+// addRowElems appends the non-zero coefficients of rows added to the model,
+// given row by row in rMat, the first of them being row first.
+func (m *memModel) addRowElems(first int, rMat InputMatrix) {
+
+	matrixElems(rMat, func(k, j int, value float64) {
+		m.elems = append(m.elems, InputElem{RowIndex: first + k, ColIndex: j, Value: value})
+	})
+}
+
+//==============================================================================
+
+// addColElems appends the non-zero coefficients of columns added to the model,
+// given column by column in cMat, the first of them being column first.
+func (m *memModel) addColElems(first int, cMat InputMatrix) {
+
+	matrixElems(cMat, func(k, i int, value float64) {
+		m.elems = append(m.elems, InputElem{RowIndex: i, ColIndex: first + k, Value: value})
+	})
+}
+
+//==============================================================================
+
+// matrixElems calls fn for each non-zero coefficient of the matrix, with the
+// position k of its row or column in the matrix and its index in the other
+// dimension. Coefficients set to zero are skipped.
+func matrixElems(mat InputMatrix, fn func(k, index int, value float64)) {
+
+	for k := range mat.Beg {
+		end := len(mat.Ind)
+		if k + 1 < len(mat.Beg) {
+			end = mat.Beg[k + 1]
+		}
+		for n := mat.Beg[k]; n < end; n++ {
+			if mat.Val[n] != 0 {
+				fn(k, mat.Ind[n], mat.Val[n])
+			}
+		}
+	}
+}
+
+//==============================================================================
+
+// chgCoefList sets the non-zero coefficients, replacing any existing value at
+// the same position and removing coefficients set to zero. Like Cplex, it
+// rejects indices outside the model, in which case nothing is changed.
+func (m *memModel) chgCoefList(eList []InputElem) error {
+
+	for _, e := range eList {
+		if e.RowIndex < 0 || e.RowIndex >= len(m.rows) || e.ColIndex < 0 || e.ColIndex >= len(m.cols) {
+			return errors.Errorf("Coefficient (%d, %d) is out of range", e.RowIndex, e.ColIndex)
+		}
+	}
+
+	// The positions of the coefficients are indexed once, so that a batch of
+	// changes takes time proportional to its length plus the number of non-zeros.
+	pos := make(map[[2]int]int, len(m.elems) + len(eList))
+	for k, e := range m.elems {
+		pos[[2]int{e.RowIndex, e.ColIndex}] = k
+	}
+
+	for _, e := range eList {
+		key := [2]int{e.RowIndex, e.ColIndex}
+		if k, ok := pos[key]; ok {
+			m.elems[k].Value = e.Value
+		} else {
+			pos[key] = len(m.elems)
+			m.elems  = append(m.elems, e)
+		}
+	}
+
+	// Drop explicit zeros so the coefficient list only holds non-zeros.
+	nz := m.elems[:0]
+	for _, e := range m.elems {
+		if e.Value != 0 {
+			nz = append(nz, e)
+		}
+	}
+	m.elems = nz
+
+	return nil
+}
+
+//==============================================================================
+
+// chgBds sets the bounds of the columns.
+func (m *memModel) chgBds(bList []InputBound) {
+
+	for _, b := range bList {
+		if b.Bound == "L" || b.Bound == "B" {
+			m.cols[b.ColIndex].BndLo = b.Value
+		}
+		if b.Bound == "U" || b.Bound == "B" {
+			m.cols[b.ColIndex].BndUp = b.Value
+		}
+	}
+}
+
+//==============================================================================
+
+// chgRhs sets the right hand side of the rows.
+func (m *memModel) chgRhs(rList []InputRowValue) {
+
+	for _, r := range rList {
+		m.rows[r.RowIndex].Rhs = r.Value
+	}
+}
+
+//==============================================================================
+
+// chgSense sets the sense of the rows.
+func (m *memModel) chgSense(sList []InputSense) {
+
+	for _, r := range sList {
+		m.rows[r.RowIndex].Sense = r.Sense
+	}
+}
+
+//==============================================================================
+
+// chgRngVal sets the range value of the rows.
+func (m *memModel) chgRngVal(rList []InputRowValue) {
+
+	for _, r := range rList {
+		m.rows[r.RowIndex].RngVal = r.Value
+	}
+}
+
+//==============================================================================
+
+// chgObj sets the objective function coefficient of the columns.
+func (m *memModel) chgObj(oList []InputColValue) {
+
+	for _, o := range oList {
+		m.obj[o.ColIndex] = o.Value
+	}
+}
+
+//==============================================================================
+
+// chgCType sets the type of the columns.
+func (m *memModel) chgCType(tList []InputCType) {
+
+	for _, t := range tList {
+		m.cols[t.ColIndex].Type = t.Type
+	}
+}
+
+//==============================================================================
+
+// chgColName renames the columns.
+func (m *memModel) chgColName(nList []InputName) {
+
+	for _, n := range nList {
+		m.cols[n.Index].Name = n.NewName
+	}
+}
+
+//==============================================================================
+
+// chgRowName renames the rows.
+func (m *memModel) chgRowName(nList []InputName) {
+
+	for _, n := range nList {
+		m.rows[n.Index].Name = n.NewName
+	}
+}
+
+//==============================================================================
+
+// delSetRows deletes the rows set to 1 in delStat, as CPXdelsetrows does, and
+// sets each entry of delStat to the new index of the row, or -1 if it was
+// deleted. Coefficients in deleted rows are removed.
+func (m *memModel) delSetRows(delStat []int) {
+
+	rows := m.rows[:0]
+	for i := range delStat {
+		if delStat[i] == 1 {
+			delStat[i] = -1
+			continue
+		}
+		delStat[i] = len(rows)
+		rows = append(rows, m.rows[i])
+	}
+	m.rows = rows
+
+	elems := m.elems[:0]
+	for _, e := range m.elems {
+		if delStat[e.RowIndex] >= 0 {
+			e.RowIndex = delStat[e.RowIndex]
+			elems = append(elems, e)
+		}
+	}
+	m.elems = elems
+}
+
+//==============================================================================
+
+// delSetCols deletes the columns set to 1 in delStat, as CPXdelsetcols does,
+// and sets each entry of delStat to the new index of the column, or -1 if it
+// was deleted. Coefficients in deleted columns are removed.
+func (m *memModel) delSetCols(delStat []int) {
+
+	cols := m.cols[:0]
+	obj  := m.obj[:0]
+	for j := range delStat {
+		if delStat[j] == 1 {
+			delStat[j] = -1
+			continue
+		}
+		delStat[j] = len(cols)
+		cols = append(cols, m.cols[j])
+		obj  = append(obj, m.obj[j])
+	}
+	m.cols = cols
+	m.obj  = obj
+
+	elems := m.elems[:0]
+	for _, e := range m.elems {
+		if delStat[e.ColIndex] >= 0 {
+			e.ColIndex = delStat[e.ColIndex]
+			elems = append(elems, e)
+		}
+	}
+	m.elems = elems
+
+	quad := m.quad[:0]
+	for _, q := range m.quad {
+		if delStat[q.RowIndex] >= 0 && delStat[q.ColIndex] >= 0 {
+			q.RowIndex = delStat[q.RowIndex]
+			q.ColIndex = delStat[q.ColIndex]
+			quad = append(quad, q)
+		}
+	}
+	m.quad = quad
+
+	for i := range m.qconstrs {
+		q   := &m.qconstrs[i]
+		lin := make([]InputLinCoef, 0, len(q.Lin))
+		for _, l := range q.Lin {
+			if delStat[l.ColIndex] >= 0 {
+				l.ColIndex = delStat[l.ColIndex]
+				lin = append(lin, l)
+			}
+		}
+		q.Lin = lin
+
+		quad := make([]InputQuadCoef, 0, len(q.Quad))
+		for _, c := range q.Quad {
+			if delStat[c.RowIndex] >= 0 && delStat[c.ColIndex] >= 0 {
+				c.RowIndex = delStat[c.RowIndex]
+				c.ColIndex = delStat[c.ColIndex]
+				quad = append(quad, c)
+			}
+		}
+		q.Quad = quad
+	}
+
+	// As in Cplex, an indicator constraint is deleted with its binary column.
+	inds := m.inds[:0]
+	for _, ind := range m.inds {
+		if delStat[ind.ColIndex] < 0 {
+			continue
+		}
+		ind.ColIndex = delStat[ind.ColIndex]
+
+		lin := make([]InputLinCoef, 0, len(ind.Lin))
+		for _, l := range ind.Lin {
+			if delStat[l.ColIndex] >= 0 {
+				l.ColIndex = delStat[l.ColIndex]
+				lin = append(lin, l)
+			}
+		}
+		ind.Lin = lin
+		inds    = append(inds, ind)
+	}
+	m.inds = inds
+
+	// Deleted columns leave their sets, and sets left empty are deleted.
+	sos := m.sos[:0]
+	for _, s := range m.sos {
+		members := make([]InputSOSMember, 0, len(s.Members))
+		for _, mb := range s.Members {
+			if delStat[mb.ColIndex] >= 0 {
+				mb.ColIndex = delStat[mb.ColIndex]
+				members = append(members, mb)
+			}
+		}
+		if len(members) > 0 {
+			s.Members = members
+			sos = append(sos, s)
+		}
+	}
+	m.sos = sos
+
+	// A piecewise linear constraint is deleted with either of its columns.
+	pwl := m.pwl[:0]
+	for _, c := range m.pwl {
+		if delStat[c.YCol] >= 0 && delStat[c.XCol] >= 0 {
+			c.YCol = delStat[c.YCol]
+			c.XCol = delStat[c.XCol]
+			pwl    = append(pwl, c)
+		}
+	}
+	m.pwl = pwl
+
+	// Deleted columns leave the MIP starts, which are kept even when empty.
+	for i := range m.starts {
+		values := make([]InputColValue, 0, len(m.starts[i].Values))
+		for _, v := range m.starts[i].Values {
+			if delStat[v.ColIndex] >= 0 {
+				v.ColIndex = delStat[v.ColIndex]
+				values = append(values, v)
+			}
+		}
+		m.starts[i].Values = values
+	}
+
+	// Deleted columns also leave the solution pool filters.
+	for i := range m.filters {
+		cols := make([]InputPoolFilterCol, 0, len(m.filters[i].Cols))
+		for _, c := range m.filters[i].Cols {
+			if delStat[c.ColIndex] >= 0 {
+				c.ColIndex = delStat[c.ColIndex]
+				cols = append(cols, c)
+			}
+		}
+		m.filters[i].Cols = cols
+	}
+}
+
+//==============================================================================
+
+// delPoolFilters deletes the solution pool filters from begin to end.
+func (m *memModel) delPoolFilters(begin, end int) {
+
+	m.filters = append(m.filters[:begin], m.filters[end + 1:]...)
+}
+
+//==============================================================================
+
+// delSOS deletes the special ordered sets from begin to end.
+func (m *memModel) delSOS(begin, end int) {
+
+	m.sos = append(m.sos[:begin], m.sos[end + 1:]...)
+}
+
+//==============================================================================
+
+// delIndConstrs deletes the indicator constraints from begin to end.
+func (m *memModel) delIndConstrs(begin, end int) {
+
+	m.inds = append(m.inds[:begin], m.inds[end + 1:]...)
+}
+
+//==============================================================================
+
+// delPwl deletes the piecewise linear constraints from begin to end.
+func (m *memModel) delPwl(begin, end int) {
+
+	m.pwl = append(m.pwl[:begin], m.pwl[end + 1:]...)
+}
+
+//==============================================================================
+
+// chgMIPStarts changes the MIP starts with the indices given: the effort is
+// replaced, and each value replaces that of the same column or is appended.
+func (m *memModel) chgMIPStarts(indices []int, mList []InputMIPStart) {
+
+	for i, index := range indices {
+		start := &m.starts[index]
+		start.Effort = mList[i].Effort
+
+		values := append([]InputColValue(nil), start.Values...)
+		pos    := make(map[int]int, len(values))
+		for k, v := range values {
+			pos[v.ColIndex] = k
+		}
+		for _, v := range mList[i].Values {
+			if k, ok := pos[v.ColIndex]; ok {
+				values[k].Value = v.Value
+			} else {
+				values = append(values, v)
+			}
+		}
+		start.Values = values
+	}
+}
+
+//==============================================================================
+
+// rangeStat returns the delete status of count rows or columns, in which the
+// range from begin to end is set to 1.
+func rangeStat(count int, begin, end int) []int {
+
+	delStat := make([]int, count)
+	for i := begin; i <= end; i++ {
+		delStat[i] = 1
+	}
+
+	return delStat
+}
+
+//==============================================================================
+
+// without returns a copy of the basis in which the statuses of the deleted
+// columns and rows are removed. colStat and rowStat hold the new index of each
+// column and row, or -1 if it was deleted, and nil if none were deleted.
+// Entries beyond the end of the basis are ignored, and a nil basis stays nil.
+// The result may no longer hold one basic item per row, in which case the
+// solver ignores it.
+func (b *lpBasis) without(colStat, rowStat []int) *lpBasis {
+
+	if b == nil {
+		return nil
+	}
+
+	keep := func(stat []BasisStatus, newIndex []int) []BasisStatus {
+		var kept []BasisStatus
+		for i := range stat {
+			if i >= len(newIndex) || newIndex[i] >= 0 {
+				kept = append(kept, stat[i])
+			}
+		}
+		return kept
+	}
+
+	return &lpBasis{cols: keep(b.cols, colStat), rows: keep(b.rows, rowStat)}
+}
+
+//==============================================================================
+
+// chgQPCoef sets the coefficients of Q, each off-diagonal coefficient at both
+// symmetric positions, removing coefficients set to zero.
+func (m *memModel) chgQPCoef(qList []InputQuadCoef) {
+
+	// As in chgCoefList, the positions are indexed once for the whole batch.
+	pos := make(map[[2]int]int, len(m.quad) + 2 * len(qList))
+	for k, q := range m.quad {
+		pos[[2]int{q.RowIndex, q.ColIndex}] = k
+	}
+
+	set := func(i, j int, value float64) {
+		key := [2]int{i, j}
+		if k, ok := pos[key]; ok {
+			m.quad[k].Value = value
+		} else {
+			pos[key] = len(m.quad)
+			m.quad   = append(m.quad, InputQuadCoef{RowIndex: i, ColIndex: j, Value: value})
+		}
+	}
+
+	for _, q := range qList {
+		set(q.RowIndex, q.ColIndex, q.Value)
+		set(q.ColIndex, q.RowIndex, q.Value)
+	}
+
+	nz := m.quad[:0]
+	for _, q := range m.quad {
+		if q.Value != 0 {
+			nz = append(nz, q)
+		}
+	}
+	m.quad = nz
+}
+
+//==============================================================================
+
+// isMip returns true if any column has a type other than continuous, or if the
+// model has special ordered sets or piecewise linear constraints.
+func (m *memModel) isMip() bool {
+
+	if len(m.sos) > 0 || len(m.pwl) > 0 {
+		return true
+	}
+
+	for i := range m.cols {
+		if m.cols[i].Type != "C" {
+			return true
+		}
+	}
+
+	return false
+}
+
+//==============================================================================
+
+// colNames returns the names of the columns.
+func (m *memModel) colNames() []string {
+
+	names := make([]string, len(m.cols))
+	for i := range m.cols {
+		names[i] = m.cols[i].Name
+	}
+
+	return names
+}
+
+//==============================================================================
+
+// rowNames returns the names of the rows.
+func (m *memModel) rowNames() []string {
+
+	names := make([]string, len(m.rows))
+	for i := range m.rows {
+		names[i] = m.rows[i].Name
+	}
+
+	return names
+}
+
+//============================ END OF FILE =====================================
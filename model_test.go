@@ -0,0 +1,86 @@
+package gpx
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMemModelChgCoefList(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		eList   []InputElem
+		want    []InputElem
+		err     bool
+	}{
+		{"replace", []InputElem{{0, 1, 5}},
+			[]InputElem{{0, 0, 1}, {0, 1, 5}, {1, 1, 3}}, false},
+		{"append", []InputElem{{1, 0, 4}, {1, 2, 6}},
+			[]InputElem{{0, 0, 1}, {0, 1, 2}, {1, 1, 3}, {1, 0, 4}, {1, 2, 6}}, false},
+		{"remove zero", []InputElem{{0, 0, 0}},
+			[]InputElem{{0, 1, 2}, {1, 1, 3}}, false},
+		{"zero not stored", []InputElem{{1, 2, 0}},
+			[]InputElem{{0, 0, 1}, {0, 1, 2}, {1, 1, 3}}, false},
+		{"last of duplicates wins", []InputElem{{1, 2, 6}, {0, 0, 7}, {1, 2, 8}, {0, 0, 9}},
+			[]InputElem{{0, 0, 9}, {0, 1, 2}, {1, 1, 3}, {1, 2, 8}}, false},
+		{"added then removed", []InputElem{{1, 2, 6}, {1, 2, 0}},
+			[]InputElem{{0, 0, 1}, {0, 1, 2}, {1, 1, 3}}, false},
+		{"row out of range", []InputElem{{0, 1, 5}, {2, 0, 1}},
+			[]InputElem{{0, 0, 1}, {0, 1, 2}, {1, 1, 3}}, true},
+		{"column out of range", []InputElem{{0, 1, 5}, {0, -1, 1}},
+			[]InputElem{{0, 0, 1}, {0, 1, 2}, {1, 1, 3}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			m := &memModel{}
+			m.newRows([]InputRow{{"r0", "L", 1, 0}, {"r1", "L", 1, 0}})
+			m.newCols(nil, []InputCol{{"x", "C", 0, 1}, {"y", "C", 0, 1}, {"z", "C", 0, 1}})
+			if err := m.chgCoefList([]InputElem{{0, 0, 1}, {0, 1, 2}, {1, 1, 3}}); err != nil {
+				t.Fatalf("chgCoefList failed: %v", err)
+			}
+
+			if err := m.chgCoefList(tt.eList); (err != nil) != tt.err {
+				t.Errorf("chgCoefList error = %v, want error %t", err, tt.err)
+			}
+
+			if !reflect.DeepEqual(m.elems, tt.want) {
+				t.Errorf("elems = %v, want %v", m.elems, tt.want)
+			}
+		})
+	}
+}
+
+//==============================================================================
+
+// BenchmarkMemModelChgCoefList sets the coefficients of a dense 200 x 200
+// matrix in one batch, and then changes all of them in a second batch.
+func BenchmarkMemModelChgCoefList(b *testing.B) {
+
+	const n = 200
+
+	rows := make([]InputRow, n)
+	cols := make([]InputCol, n)
+	for i := range rows {
+		rows[i] = InputRow{"r", "L", 1, 0}
+		cols[i] = InputCol{"c", "C", 0, 1}
+	}
+
+	eList := make([]InputElem, 0, n * n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			eList = append(eList, InputElem{i, j, 1})
+		}
+	}
+
+	for k := 0; k < b.N; k++ {
+		m := &memModel{}
+		m.newRows(rows)
+		m.newCols(nil, cols)
+		m.chgCoefList(eList)
+		m.chgCoefList(eList)
+	}
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,290 @@
+// 01   Oct. 16, 2026   Initial version, reading the model back from the solver
+// 02   Oct. 16, 2026   GetObjSen populates its argument, as the other getters do
+
+package gpx
+
+import (
+	"github.com/pkg/errors"
+	"sort"
+)
+
+//==============================================================================
+// PROBLEM FUNCTIONS
+//==============================================================================
+
+// GetModel obtains the whole model held by the solver, e.g. after
+// ReadCopyProb, in the data structures used to create it: the rows, the
+// columns, the non-zero coefficients and the non-zero objective function
+// coefficients, populating the slices passed to the function. Passing them to
+// NewRows, NewCols, ChgCoefList, and the objective sense obtained by GetObjSen
+// to ChgObjSen, creates the same model again. See GetRows, GetCols, GetCoefs
+// and GetObj for the details of each slice.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses the same Cplex functions as GetRows, GetCols, GetCoefs and
+// GetObj.
+func (p *Problem) GetModel(rList *[]InputRow, cList *[]InputCol, eList *[]InputElem,
+	objList *[]InputObjCoef) error {
+
+	*rList   = nil
+	*cList   = nil
+	*eList   = nil
+	*objList = nil
+
+	var rows  []InputRow      // Rows of the model
+	var cols  []InputCol      // Columns of the model
+	var elems []InputElem     // Non-zero coefficients
+	var objs  []InputObjCoef  // Non-zero objective function coefficients
+
+	if err := p.GetRows(&rows); err != nil {
+		return err
+	}
+	if err := p.GetCols(&cols); err != nil {
+		return err
+	}
+	if err := p.GetCoefs(&elems); err != nil {
+		return err
+	}
+	if err := p.GetObj(&objs); err != nil {
+		return err
+	}
+
+	*rList   = rows
+	*cList   = cols
+	*eList   = elems
+	*objList = objs
+
+	return nil
+}
+
+//==============================================================================
+
+// GetRows obtains the name, sense, right hand side and range value of all
+// rows, and populates the slice passed to the function with one entry per row,
+// in row order. RngVal is zero for rows which are not ranged rows.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXgetsense, CPXgetrhs, CPXgetrngval and CPXgetrowname.
+func (p *Problem) GetRows(rList *[]InputRow) error {
+
+	*rList = nil
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	rows := make([]InputRow, p.slv.NumRows())
+	if err := p.slv.Rows(rows); err != nil {
+		return errors.Wrap(err, "GetRows failed")
+	}
+
+	names, err := p.slv.RowNames()
+	if err != nil {
+		return errors.Wrap(err, "GetRows failed to get row names")
+	}
+
+	for i := range rows {
+		rows[i].Name = names[i]
+	}
+	*rList = rows
+
+	return nil
+}
+
+//==============================================================================
+
+// GetCols obtains the name, type and bounds of all columns, and populates the
+// slice passed to the function with one entry per column, in column order. The
+// type of every column of an LP is "C".
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXgetctype, CPXgetlb, CPXgetub and CPXgetcolname.
+func (p *Problem) GetCols(cList *[]InputCol) error {
+
+	*cList = nil
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	cols := make([]InputCol, p.slv.NumCols())
+	if err := p.slv.Cols(cols); err != nil {
+		return errors.Wrap(err, "GetCols failed")
+	}
+
+	names, err := p.slv.ColNames()
+	if err != nil {
+		return errors.Wrap(err, "GetCols failed to get column names")
+	}
+
+	for j := range cols {
+		cols[j].Name = names[j]
+	}
+	*cList = cols
+
+	return nil
+}
+
+//==============================================================================
+
+// GetCoefs obtains the non-zero coefficients of the constraint matrix, and
+// populates the slice passed to the function with one entry per coefficient,
+// ordered by column, then by row.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXgetnumnz and CPXgetcols.
+func (p *Problem) GetCoefs(eList *[]InputElem) error {
+
+	*eList = nil
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	elems, err := p.slv.Coefs()
+	if err != nil {
+		return errors.Wrap(err, "GetCoefs failed")
+	}
+
+	sort.Slice(elems, func(a, b int) bool {
+		if elems[a].ColIndex != elems[b].ColIndex {
+			return elems[a].ColIndex < elems[b].ColIndex
+		}
+		return elems[a].RowIndex < elems[b].RowIndex
+	})
+	*eList = elems
+
+	return nil
+}
+
+//==============================================================================
+
+// GetObj obtains the objective function coefficients, and populates the slice
+// passed to the function with one entry per non-zero coefficient, in column
+// order, as expected by NewCols for the whole model.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXgetobj.
+func (p *Problem) GetObj(objList *[]InputObjCoef) error {
+
+	*objList = nil
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	obj := make([]float64, p.slv.NumCols())
+	if err := p.slv.Obj(obj); err != nil {
+		return errors.Wrap(err, "GetObj failed")
+	}
+
+	var objs []InputObjCoef
+	for j := range obj {
+		if obj[j] != 0 {
+			objs = append(objs, InputObjCoef{ColIndex: j, Value: obj[j]})
+		}
+	}
+	*objList = objs
+
+	return nil
+}
+
+//==============================================================================
+
+// GetObjSen obtains the sense of the objective function, 1 to minimize or -1
+// to maximize, as set by ChgObjSen.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXgetobjsen.
+func (p *Problem) GetObjSen(sense *int) error {
+	var err   error   // Error returned by the backend
+
+	*sense = 0
+
+	if err = p.checkProb(); err != nil {
+		return err
+	}
+
+	*sense, err = p.slv.ObjSen()
+
+	return err
+}
+
+//==============================================================================
+// PACKAGE-LEVEL FUNCTIONS
+//==============================================================================
+
+// GetModel obtains the model of the default problem. See Problem.GetModel.
+func GetModel(rList *[]InputRow, cList *[]InputCol, eList *[]InputElem, objList *[]InputObjCoef) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetModel(rList, cList, eList, objList)
+}
+
+//==============================================================================
+
+// GetRows obtains the rows of the default problem. See Problem.GetRows.
+func GetRows(rList *[]InputRow) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetRows(rList)
+}
+
+//==============================================================================
+
+// GetCols obtains the columns of the default problem. See Problem.GetCols.
+func GetCols(cList *[]InputCol) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetCols(cList)
+}
+
+//==============================================================================
+
+// GetCoefs obtains the non-zero coefficients of the default problem. See
+// Problem.GetCoefs.
+func GetCoefs(eList *[]InputElem) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetCoefs(eList)
+}
+
+//==============================================================================
+
+// GetObj obtains the objective function coefficients of the default problem.
+// See Problem.GetObj.
+func GetObj(objList *[]InputObjCoef) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetObj(objList)
+}
+
+//==============================================================================
+
+// GetObjSen obtains the objective sense of the default problem. See
+// Problem.GetObjSen.
+func GetObjSen(sense *int) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetObjSen(sense)
+}
+
+//============================ END OF FILE =====================================
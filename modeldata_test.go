@@ -0,0 +1,93 @@
+package gpx
+
+import (
+	"github.com/pkg/errors"
+	"reflect"
+	"testing"
+)
+
+// TestGetModelRoundTrip obtains the model of a problem, creates it again from
+// the slices and the sense obtained, and checks that the new problem holds the
+// same model.
+func TestGetModelRoundTrip(t *testing.T) {
+
+	src := goModel("C")
+	src.rows  = append(src.rows, InputRow{"c3", "R", 1, 2})
+	src.cols  = append(src.cols, InputCol{"z", "I", -1, 5})
+	src.elems = append(src.elems, InputElem{2, 2, 1}, InputElem{2, 0, -1}, InputElem{0, 2, 4})
+
+	// The coefficients are obtained by column, then by row.
+	wantElems := []InputElem{{0, 0, 1}, {1, 0, 1}, {2, 0, -1}, {0, 1, 1}, {1, 1, 3}, {0, 2, 4}, {2, 2, 1}}
+
+	for _, backend := range []string{"fake", "go"} {
+		t.Run(backend, func(t *testing.T) {
+
+			newBackend := func() Backend {
+				if backend == "go" {
+					return NewGoBackend()
+				}
+				return NewFakeBackend()
+			}
+			_, prob := newTestProb(t, newBackend(), src)
+
+			got := testModel{name: "copy"}
+			if err := prob.GetModel(&got.rows, &got.cols, &got.elems, &got.obj); err != nil {
+				t.Fatalf("GetModel failed: %v", err)
+			}
+			if err := prob.GetObjSen(&got.sense); err != nil {
+				t.Fatalf("GetObjSen failed: %v", err)
+			}
+
+			if !reflect.DeepEqual(got.rows, src.rows) || !reflect.DeepEqual(got.cols, src.cols) ||
+				!reflect.DeepEqual(got.obj, src.obj) || got.sense != src.sense {
+				t.Errorf("GetModel = %+v, want %+v", got, src)
+			}
+			if !reflect.DeepEqual(got.elems, wantElems) {
+				t.Errorf("coefficients = %v, want %v", got.elems, wantElems)
+			}
+
+			_, dst := newTestProb(t, newBackend(), got)
+
+			again := testModel{name: "copy"}
+			if err := dst.GetModel(&again.rows, &again.cols, &again.elems, &again.obj); err != nil {
+				t.Fatalf("GetModel of the copy failed: %v", err)
+			}
+			if err := dst.GetObjSen(&again.sense); err != nil {
+				t.Fatalf("GetObjSen of the copy failed: %v", err)
+			}
+			if !reflect.DeepEqual(again, got) {
+				t.Errorf("GetModel of the copy = %+v, want %+v", again, got)
+			}
+		})
+	}
+}
+
+//==============================================================================
+
+// TestGetModelFreed checks that the getters fail, and leave their arguments
+// empty, once the problem has been freed.
+func TestGetModelFreed(t *testing.T) {
+
+	_, prob := newTestProb(t, NewFakeBackend(), fakeModel("C"))
+	if err := prob.FreeProb(); err != nil {
+		t.Fatalf("FreeProb failed: %v", err)
+	}
+
+	rows  := []InputRow{{}}
+	cols  := []InputCol{{}}
+	elems := []InputElem{{}}
+	obj   := []InputObjCoef{{}}
+	sense := 1
+
+	if err := prob.GetModel(&rows, &cols, &elems, &obj); !errors.Is(err, ErrNoProblem) {
+		t.Errorf("GetModel = %v, want %v", err, ErrNoProblem)
+	}
+	if rows != nil || cols != nil || elems != nil || obj != nil {
+		t.Errorf("GetModel left %v, %v, %v, %v, want nil slices", rows, cols, elems, obj)
+	}
+	if err := prob.GetObjSen(&sense); !errors.Is(err, ErrNoProblem) || sense != 0 {
+		t.Errorf("GetObjSen = %d, %v, want 0, %v", sense, err, ErrNoProblem)
+	}
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,728 @@
+// 01   Oct. 16, 2026   Initial version, changing the model in place
+// 02   Oct. 16, 2026   Added DelRows, DelCols, DelSetRows and DelSetCols
+
+package gpx
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Each change below refers to its column or row either by name or by index:
+// if the name is not empty, it is looked up among the names of the problem and
+// the index is ignored; otherwise the index is used. Changes are applied in
+// the order given, so that a later change to the same item wins.
+
+// InputBound defines a data structure passed as an input argument to ChgBds
+// when changing the bounds of a column.
+//	The values supported in the Bound field are:
+//		L - lower bound
+//		U - upper bound
+//		B - both bounds
+type InputBound struct {
+	ColIndex  int      // Index of the column, if ColName is empty
+	ColName   string   // Name of the column
+	Bound     string   // Bound (L, U, B) to be changed
+	Value     float64  // New value of the bound
+}
+
+// InputRowValue defines a data structure passed as an input argument to ChgRhs
+// and ChgRngVal when changing a value of a row.
+type InputRowValue struct {
+	RowIndex  int      // Index of the row, if RowName is empty
+	RowName   string   // Name of the row
+	Value     float64  // New value of the RHS or range
+}
+
+// InputColValue defines a data structure passed as an input argument to ChgObj
+// when changing the objective function coefficient of a column.
+type InputColValue struct {
+	ColIndex  int      // Index of the column, if ColName is empty
+	ColName   string   // Name of the column
+	Value     float64  // New objective function coefficient, or value in a MIP start
+}
+
+// InputSense defines a data structure passed as an input argument to ChgSense
+// when changing the sense of a row.
+type InputSense struct {
+	RowIndex  int      // Index of the row, if RowName is empty
+	RowName   string   // Name of the row
+	Sense     string   // New sense (L, E, G, R) of the row
+}
+
+// InputCType defines a data structure passed as an input argument to ChgCType
+// when changing the type of a column.
+type InputCType struct {
+	ColIndex  int      // Index of the column, if ColName is empty
+	ColName   string   // Name of the column
+	Type      string   // New type (C, B, I, S, N) of the column
+}
+
+// InputName defines a data structure passed as an input argument to
+// ChgColName and ChgRowName when renaming a column or row.
+type InputName struct {
+	Index     int      // Index of the column or row, if Name is empty
+	Name      string   // Current name of the column or row
+	NewName   string   // New name of the column or row
+}
+
+// nameLookup resolves the columns or rows of a list of changes, given by name
+// or by index. The names of the problem are only obtained from the backend if
+// a change refers to a name.
+type nameLookup struct {
+	kind      string                    // "column" or "row", used in error messages
+	count     int                       // Number of columns or rows of the problem
+	names     func() ([]string, error)  // Obtains the names from the backend
+	index     map[string]int            // Index of each name, nil until first needed
+}
+
+//==============================================================================
+// PROBLEM FUNCTIONS
+//==============================================================================
+
+// ChgBds changes the lower, upper or both bounds of the columns specified.
+// Use -/+1.0e20 (CPX_INFBOUND) for an infinite bound. The problem is not
+// rebuilt, and the basis of the previous solve is kept as a starting point for
+// the next one.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXchgbds.
+func (p *Problem) ChgBds(bList []InputBound) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	if len(bList) < 1 {
+		return errors.Errorf("ChgBds expected more than %d bounds", len(bList))
+	}
+
+	cols   := p.colLookup()
+	bounds := make([]InputBound, len(bList))
+
+	for i := range bList {
+		switch bList[i].Bound {
+		case "L", "U", "B":
+		default:
+			return errors.Errorf("ChgBds found unexpected bound '%s' for item %d", bList[i].Bound, i)
+		}
+
+		j, err := cols.resolve(bList[i].ColIndex, bList[i].ColName)
+		if err != nil {
+			return errors.Wrapf(err, "ChgBds failed for item %d", i)
+		}
+
+		bounds[i] = InputBound{ColIndex: j, Bound: bList[i].Bound, Value: bList[i].Value}
+	}
+
+	return p.slv.ChgBds(bounds)
+}
+
+//==============================================================================
+
+// ChgRhs changes the right hand side of the rows specified. For a ranged row,
+// this is the lower end of the range.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXchgrhs.
+func (p *Problem) ChgRhs(rList []InputRowValue) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	rows, err := p.rowValues("ChgRhs", rList)
+	if err != nil {
+		return err
+	}
+
+	return p.slv.ChgRhs(rows)
+}
+
+//==============================================================================
+
+// ChgSense changes the sense of the rows specified.
+//	The values supported in the Sense field of the sList structure are:
+//		L - less than or equal
+//		E - equal
+//		G - greater than or equal
+//		R - range
+//	A row changed to "R" keeps its range value, which ChgRngVal can change.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXchgsense.
+func (p *Problem) ChgSense(sList []InputSense) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	if len(sList) < 1 {
+		return errors.Errorf("ChgSense expected more than %d rows", len(sList))
+	}
+
+	rows   := p.rowLookup()
+	senses := make([]InputSense, len(sList))
+
+	for i := range sList {
+		switch sList[i].Sense {
+		case "L", "E", "G", "R":
+		default:
+			return errors.Errorf("ChgSense found unexpected sense '%s' for item %d", sList[i].Sense, i)
+		}
+
+		r, err := rows.resolve(sList[i].RowIndex, sList[i].RowName)
+		if err != nil {
+			return errors.Wrapf(err, "ChgSense failed for item %d", i)
+		}
+
+		senses[i] = InputSense{RowIndex: r, Sense: sList[i].Sense}
+	}
+
+	return p.slv.ChgSense(senses)
+}
+
+//==============================================================================
+
+// ChgRngVal changes the range value of the rows specified. The value of a
+// ranged row can be between Rhs and (Rhs + RngVal); the range value of rows
+// with another sense is kept but has no effect until ChgSense makes them
+// ranged rows.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXchgrngval.
+func (p *Problem) ChgRngVal(rList []InputRowValue) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	rows, err := p.rowValues("ChgRngVal", rList)
+	if err != nil {
+		return err
+	}
+
+	return p.slv.ChgRngVal(rows)
+}
+
+//==============================================================================
+
+// ChgObj changes the objective function coefficient of the columns specified.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXchgobj.
+func (p *Problem) ChgObj(oList []InputColValue) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	if len(oList) < 1 {
+		return errors.Errorf("ChgObj expected more than %d coefficients", len(oList))
+	}
+
+	cols := p.colLookup()
+	objs := make([]InputColValue, len(oList))
+
+	for i := range oList {
+		j, err := cols.resolve(oList[i].ColIndex, oList[i].ColName)
+		if err != nil {
+			return errors.Wrapf(err, "ChgObj failed for item %d", i)
+		}
+
+		objs[i] = InputColValue{ColIndex: j, Value: oList[i].Value}
+	}
+
+	return p.slv.ChgObj(objs)
+}
+
+//==============================================================================
+
+// ChgCType changes the type of the columns specified, using the types
+// supported by NewCols. Changing any column to a type other than "C" turns an
+// LP into a MIP, and changing all columns back to "C" turns it back into an LP.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXchgctype, and CPXchgprobtype if the problem type changes.
+func (p *Problem) ChgCType(tList []InputCType) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	if len(tList) < 1 {
+		return errors.Errorf("ChgCType expected more than %d columns", len(tList))
+	}
+
+	cols  := p.colLookup()
+	types := make([]InputCType, len(tList))
+
+	for i := range tList {
+		switch tList[i].Type {
+		case "C", "B", "I", "S", "N":
+		default:
+			return errors.Errorf("ChgCType found unexpected type '%s' for item %d", tList[i].Type, i)
+		}
+
+		j, err := cols.resolve(tList[i].ColIndex, tList[i].ColName)
+		if err != nil {
+			return errors.Wrapf(err, "ChgCType failed for item %d", i)
+		}
+
+		types[i] = InputCType{ColIndex: j, Type: tList[i].Type}
+	}
+
+	return p.slv.ChgCType(types)
+}
+
+//==============================================================================
+
+// ChgColName renames the columns specified.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXchgcolname.
+func (p *Problem) ChgColName(nList []InputName) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	names, err := p.newNames("ChgColName", p.colLookup(), nList)
+	if err != nil {
+		return err
+	}
+
+	return p.slv.ChgColName(names)
+}
+
+//==============================================================================
+
+// ChgRowName renames the rows specified.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXchgrowname.
+func (p *Problem) ChgRowName(nList []InputName) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	names, err := p.newNames("ChgRowName", p.rowLookup(), nList)
+	if err != nil {
+		return err
+	}
+
+	return p.slv.ChgRowName(names)
+}
+
+//==============================================================================
+
+// DelRows deletes the rows from begin to end, inclusive. The rows which follow
+// move up to fill the gap, so row indices held by the caller, e.g. in
+// InputElem.RowIndex or the positions of SolnRow entries, must be updated.
+// It returns a table with one entry per row before the deletion, holding the
+// new index of the row, or -1 if the row was deleted.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXdelrows.
+func (p *Problem) DelRows(begin, end int) ([]int, error) {
+
+	if err := p.checkProb(); err != nil {
+		return nil, err
+	}
+
+	delStat, err := delRange("DelRows", p.slv.NumRows(), begin, end)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.slv.DelRows(begin, end); err != nil {
+		return nil, err
+	}
+
+	return delStat, nil
+}
+
+//==============================================================================
+
+// DelCols deletes the columns from begin to end, inclusive, and returns the
+// table of new column indices, as DelRows does for rows.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXdelcols.
+func (p *Problem) DelCols(begin, end int) ([]int, error) {
+
+	if err := p.checkProb(); err != nil {
+		return nil, err
+	}
+
+	delStat, err := delRange("DelCols", p.slv.NumCols(), begin, end)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.slv.DelCols(begin, end); err != nil {
+		return nil, err
+	}
+
+	return delStat, nil
+}
+
+//==============================================================================
+
+// DelSetRows deletes the rows whose indices are passed into this function, in
+// any order. The remaining rows keep their relative order, and the table of
+// new row indices is returned, as for DelRows.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXdelsetrows.
+func (p *Problem) DelSetRows(rows []int) ([]int, error) {
+
+	if err := p.checkProb(); err != nil {
+		return nil, err
+	}
+
+	delStat, err := delSet("DelSetRows", "row", p.slv.NumRows(), rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.slv.DelSetRows(delStat); err != nil {
+		return nil, err
+	}
+
+	return delStat, nil
+}
+
+//==============================================================================
+
+// DelSetCols deletes the columns whose indices are passed into this function,
+// in any order, and returns the table of new column indices, as DelSetRows
+// does for rows.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXdelsetcols.
+func (p *Problem) DelSetCols(cols []int) ([]int, error) {
+
+	if err := p.checkProb(); err != nil {
+		return nil, err
+	}
+
+	delStat, err := delSet("DelSetCols", "column", p.slv.NumCols(), cols)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.slv.DelSetCols(delStat); err != nil {
+		return nil, err
+	}
+
+	return delStat, nil
+}
+
+//==============================================================================
+
+// rowValues returns a copy of the row values passed to operation op, with the
+// rows given by name replaced by their index.
+func (p *Problem) rowValues(op string, rList []InputRowValue) ([]InputRowValue, error) {
+
+	if len(rList) < 1 {
+		return nil, errors.Errorf("%s expected more than %d rows", op, len(rList))
+	}
+
+	rows   := p.rowLookup()
+	values := make([]InputRowValue, len(rList))
+
+	for i := range rList {
+		r, err := rows.resolve(rList[i].RowIndex, rList[i].RowName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s failed for item %d", op, i)
+		}
+
+		values[i] = InputRowValue{RowIndex: r, Value: rList[i].Value}
+	}
+
+	return values, nil
+}
+
+//==============================================================================
+
+// newNames returns a copy of the new names passed to operation op, with the
+// columns or rows given by their current name replaced by their index. Names
+// are resolved before any of them is changed.
+func (p *Problem) newNames(op string, items *nameLookup, nList []InputName) ([]InputName, error) {
+
+	if len(nList) < 1 {
+		return nil, errors.Errorf("%s expected more than %d names", op, len(nList))
+	}
+
+	names := make([]InputName, len(nList))
+
+	for i := range nList {
+		if nList[i].NewName == "" {
+			return nil, errors.Errorf("%s found no new name for item %d", op, i)
+		}
+
+		k, err := items.resolve(nList[i].Index, nList[i].Name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s failed for item %d", op, i)
+		}
+
+		names[i] = InputName{Index: k, NewName: nList[i].NewName}
+	}
+
+	return names, nil
+}
+
+//==============================================================================
+
+// colLookup returns a nameLookup for the columns of the problem.
+func (p *Problem) colLookup() *nameLookup {
+
+	return &nameLookup{kind: "column", count: p.slv.NumCols(), names: p.slv.ColNames}
+}
+
+//==============================================================================
+
+// rowLookup returns a nameLookup for the rows of the problem.
+func (p *Problem) rowLookup() *nameLookup {
+
+	return &nameLookup{kind: "row", count: p.slv.NumRows(), names: p.slv.RowNames}
+}
+
+//==============================================================================
+
+// resolve returns the index of the column or row given by name, if name is not
+// empty, or by index otherwise. If several items have the same name, the first
+// one is used.
+// In case the index is out of range or the name is unknown, it returns an
+// error.
+func (l *nameLookup) resolve(index int, name string) (int, error) {
+
+	if name == "" {
+		if index < 0 || index >= l.count {
+			return 0, errors.Errorf("The %s index %d is out of range, expected 0 to %d",
+				l.kind, index, l.count - 1)
+		}
+		return index, nil
+	}
+
+	if l.index == nil {
+		names, err := l.names()
+		if err != nil {
+			return 0, errors.Wrapf(err, "Failed to get %s names", l.kind)
+		}
+
+		l.index = make(map[string]int, len(names))
+		for k := len(names) - 1; k >= 0; k-- {
+			if names[k] != "" {
+				l.index[names[k]] = k
+			}
+		}
+	}
+
+	k, ok := l.index[name]
+	if !ok {
+		return 0, errors.Errorf("Unknown %s name %s", l.kind, name)
+	}
+
+	return k, nil
+}
+
+//==============================================================================
+
+// delRange checks the range of rows or columns passed to operation op, for a
+// problem with count rows or columns, and returns the table of new indices
+// after the range is deleted.
+func delRange(op string, count int, begin, end int) ([]int, error) {
+
+	if begin < 0 || end >= count || begin > end {
+		return nil, errors.Errorf("%s found range %d to %d, expected 0 to %d", op, begin, end, count - 1)
+	}
+
+	delStat := make([]int, count)
+	for i := range delStat {
+		switch {
+		case i < begin:
+			delStat[i] = i
+		case i <= end:
+			delStat[i] = -1
+		default:
+			delStat[i] = i - (end - begin + 1)
+		}
+	}
+
+	return delStat, nil
+}
+
+//==============================================================================
+
+// delSet checks the indices of the rows or columns passed to operation op, for
+// a problem with count rows or columns, and returns the delete status used by
+// CPXdelsetrows and CPXdelsetcols: one entry per row or column, set to 1 if
+// it is to be deleted and 0 otherwise.
+func delSet(op string, kind string, count int, indices []int) ([]int, error) {
+
+	if len(indices) < 1 {
+		return nil, errors.Errorf("%s expected more than %d %ss", op, len(indices), kind)
+	}
+
+	delStat := make([]int, count)
+	for _, k := range indices {
+		if k < 0 || k >= count {
+			return nil, errors.Errorf("%s found %s index %d, expected 0 to %d", op, kind, k, count - 1)
+		}
+		delStat[k] = 1
+	}
+
+	return delStat, nil
+}
+
+//==============================================================================
+// PACKAGE-LEVEL FUNCTIONS
+//==============================================================================
+
+// ChgBds changes bounds of the default problem. See Problem.ChgBds.
+func ChgBds(bList []InputBound) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.ChgBds(bList)
+}
+
+//==============================================================================
+
+// ChgRhs changes right hand sides of the default problem. See Problem.ChgRhs.
+func ChgRhs(rList []InputRowValue) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.ChgRhs(rList)
+}
+
+//==============================================================================
+
+// ChgSense changes row senses of the default problem. See Problem.ChgSense.
+func ChgSense(sList []InputSense) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.ChgSense(sList)
+}
+
+//==============================================================================
+
+// ChgRngVal changes range values of the default problem. See
+// Problem.ChgRngVal.
+func ChgRngVal(rList []InputRowValue) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.ChgRngVal(rList)
+}
+
+//==============================================================================
+
+// ChgObj changes objective function coefficients of the default problem. See
+// Problem.ChgObj.
+func ChgObj(oList []InputColValue) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.ChgObj(oList)
+}
+
+//==============================================================================
+
+// ChgCType changes column types of the default problem. See Problem.ChgCType.
+func ChgCType(tList []InputCType) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.ChgCType(tList)
+}
+
+//==============================================================================
+
+// ChgColName renames columns of the default problem. See Problem.ChgColName.
+func ChgColName(nList []InputName) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.ChgColName(nList)
+}
+
+//==============================================================================
+
+// ChgRowName renames rows of the default problem. See Problem.ChgRowName.
+func ChgRowName(nList []InputName) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.ChgRowName(nList)
+}
+
+// DelRows deletes rows of the default problem. See Problem.DelRows.
+func DelRows(begin, end int) ([]int, error) {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return nil, err
+	}
+
+	return prob.DelRows(begin, end)
+}
+
+//==============================================================================
+
+// DelCols deletes columns of the default problem. See Problem.DelCols.
+func DelCols(begin, end int) ([]int, error) {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return nil, err
+	}
+
+	return prob.DelCols(begin, end)
+}
+
+//==============================================================================
+
+// DelSetRows deletes a set of rows of the default problem. See
+// Problem.DelSetRows.
+func DelSetRows(rows []int) ([]int, error) {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return nil, err
+	}
+
+	return prob.DelSetRows(rows)
+}
+
+//==============================================================================
+
+// DelSetCols deletes a set of columns of the default problem. See
+// Problem.DelSetCols.
+func DelSetCols(cols []int) ([]int, error) {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return nil, err
+	}
+
+	return prob.DelSetCols(cols)
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,294 @@
+package gpx
+
+import (
+	"github.com/pkg/errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestNameLookupResolve(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		index    int
+		itemName string
+		namesErr error
+		want     int      // -1 for an error
+		fetched  bool     // Flag set if the names are obtained
+	}{
+		{"index", 2, "", nil, 2, false},
+		{"first index", 0, "", nil, 0, false},
+		{"negative index", -1, "", nil, -1, false},
+		{"index out of range", 4, "", nil, -1, false},
+		{"name", 3, "b", nil, 1, true},
+		{"duplicate name", 3, "a", nil, 0, true},
+		{"unknown name", 0, "z", nil, -1, true},
+		{"names fail", 0, "a", errors.New("no names"), -1, true},
+		{"names fail by index", 1, "", errors.New("no names"), 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			fetched := false
+			l := &nameLookup{kind: "column", count: 4, names: func() ([]string, error) {
+				fetched = true
+				return []string{"a", "b", "", "a"}, tt.namesErr
+			}}
+
+			got, err := l.resolve(tt.index, tt.itemName)
+			if (err != nil) != (tt.want < 0) || (err == nil && got != tt.want) {
+				t.Errorf("resolve(%d, %q) = %d, %v, want %d", tt.index, tt.itemName, got, err, tt.want)
+			}
+			if fetched != tt.fetched {
+				t.Errorf("names obtained = %t, want %t", fetched, tt.fetched)
+			}
+		})
+	}
+}
+
+//==============================================================================
+
+// TestChgBds changes the bounds of the problem of goModel, and checks the
+// columns and the optimal objective value which result, or that a list which
+// is rejected leaves the problem unchanged.
+func TestChgBds(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		bList   []InputBound
+		cols    []InputCol   // nil for an error
+		objVal  float64
+	}{
+		{"upper by name", []InputBound{{ColName: "x", Bound: "U", Value: 1}},
+			[]InputCol{{"x", "C", 0, 1}, {"y", "C", 0, 1e20}}, 7},
+		{"lower by index", []InputBound{{ColIndex: 1, Bound: "L", Value: 2}},
+			[]InputCol{{"x", "C", 0, 3}, {"y", "C", 2, 1e20}}, 7},
+		{"both", []InputBound{{ColName: "y", Bound: "B", Value: 0.5}},
+			[]InputCol{{"x", "C", 0, 3}, {"y", "C", 0.5, 0.5}}, 10},
+		{"later change wins", []InputBound{{ColName: "x", Bound: "U", Value: 1}, {ColIndex: 0, Bound: "U", Value: 2}},
+			[]InputCol{{"x", "C", 0, 2}, {"y", "C", 0, 1e20}}, 28.0 / 3},
+		{"unknown bound", []InputBound{{ColName: "x", Bound: "X", Value: 1}}, nil, 0},
+		{"unknown name", []InputBound{{ColName: "x", Bound: "U", Value: 1}, {ColName: "z", Bound: "U", Value: 1}},
+			nil, 0},
+		{"index out of range", []InputBound{{ColIndex: 2, Bound: "U", Value: 1}}, nil, 0},
+		{"empty", nil, nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			_, prob := newTestProb(t, NewGoBackend(), goModel("C"))
+
+			err := prob.ChgBds(tt.bList)
+			if (err != nil) != (tt.cols == nil) {
+				t.Fatalf("ChgBds error = %v, want error %t", err, tt.cols == nil)
+			}
+
+			var cols []InputCol
+			if err = prob.GetCols(&cols); err != nil {
+				t.Fatalf("GetCols failed: %v", err)
+			}
+
+			want := tt.cols
+			if want == nil {
+				want = goModel("C").cols
+			}
+			if !reflect.DeepEqual(cols, want) {
+				t.Errorf("GetCols = %+v, want %+v", cols, want)
+			}
+			if tt.cols == nil {
+				return
+			}
+
+			var objVal float64
+			if err = prob.LpOpt(); err != nil {
+				t.Fatalf("LpOpt failed: %v", err)
+			}
+			if err = prob.GetObjVal(&objVal); err != nil || math.Abs(objVal - tt.objVal) > 1e-9 {
+				t.Errorf("GetObjVal = %g, %v, want %g", objVal, err, tt.objVal)
+			}
+		})
+	}
+}
+
+//==============================================================================
+
+func TestChgSense(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		sList   []InputSense
+		senses  []string   // nil for an error
+	}{
+		{"by name", []InputSense{{RowName: "c2", Sense: "G"}}, []string{"L", "G"}},
+		{"by index", []InputSense{{RowIndex: 0, Sense: "E"}, {RowIndex: 1, Sense: "R"}}, []string{"E", "R"}},
+		{"later change wins", []InputSense{{RowName: "c1", Sense: "G"}, {RowIndex: 0, Sense: "E"}},
+			[]string{"E", "L"}},
+		{"unknown sense", []InputSense{{RowName: "c1", Sense: "N"}}, nil},
+		{"unknown name", []InputSense{{RowName: "c1", Sense: "G"}, {RowName: "c3", Sense: "G"}}, nil},
+		{"index out of range", []InputSense{{RowIndex: -1, Sense: "G"}}, nil},
+		{"empty", []InputSense{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			fake := NewFakeBackend()
+			_, prob := newTestProb(t, fake, goModel("C"))
+
+			// The range value is kept when a row becomes ranged.
+			if err := prob.ChgRngVal([]InputRowValue{{RowIndex: 1, Value: 2}}); err != nil {
+				t.Fatalf("ChgRngVal failed: %v", err)
+			}
+
+			err := prob.ChgSense(tt.sList)
+			if (err != nil) != (tt.senses == nil) {
+				t.Fatalf("ChgSense error = %v, want error %t", err, tt.senses == nil)
+			}
+
+			var rows []InputRow
+			if err = prob.GetRows(&rows); err != nil {
+				t.Fatalf("GetRows failed: %v", err)
+			}
+
+			want := tt.senses
+			if want == nil {
+				want = []string{"L", "L"}
+			}
+			for i, row := range rows {
+				if row.Sense != want[i] || (i == 1 && row.RngVal != 2) {
+					t.Errorf("row %d = %+v, want sense %s", i, row, want[i])
+				}
+			}
+
+			// The backend receives the rows by index only.
+			for _, c := range fake.Calls() {
+				if c.Op != "ChgSense" {
+					continue
+				}
+				for _, s := range c.Args[0].([]InputSense) {
+					if s.RowName != "" {
+						t.Errorf("ChgSense passed %+v to the backend, want an index only", s)
+					}
+				}
+			}
+		})
+	}
+}
+
+//==============================================================================
+
+// delModel is a problem with the rows r0, r1 and r2 and the columns a, b, c
+// and d, where row i holds the coefficient 10i + j + 1 for column j.
+func delModel() testModel {
+
+	m := testModel{
+		name: "del",
+		rows: []InputRow{{"r0", "L", 1, 0}, {"r1", "L", 2, 0}, {"r2", "L", 3, 0}},
+		cols: []InputCol{{"a", "C", 0, 1}, {"b", "C", 0, 1}, {"c", "C", 0, 1}, {"d", "C", 0, 1}},
+	}
+	for i := range m.rows {
+		for j := range m.cols {
+			m.elems = append(m.elems, InputElem{i, j, float64(10 * i + j + 1)})
+		}
+	}
+
+	return m
+}
+
+//==============================================================================
+
+// TestDelete deletes rows or columns of the problem of delModel, and checks
+// the table of new indices, the names which remain, and that each remaining
+// coefficient has moved with its row and column.
+func TestDelete(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		del      func(p *Problem) ([]int, error)
+		delStat  []int      // nil for an error
+		rows     []string   // Names of the remaining rows
+		cols     []string   // Names of the remaining columns
+	}{
+		{"set of rows", func(p *Problem) ([]int, error) { return p.DelSetRows([]int{2, 0}) },
+			[]int{-1, 0, -1}, []string{"r1"}, []string{"a", "b", "c", "d"}},
+		{"row twice", func(p *Problem) ([]int, error) { return p.DelSetRows([]int{1, 1}) },
+			[]int{0, -1, 1}, []string{"r0", "r2"}, []string{"a", "b", "c", "d"}},
+		{"set of columns", func(p *Problem) ([]int, error) { return p.DelSetCols([]int{3, 1}) },
+			[]int{0, -1, 1, -1}, []string{"r0", "r1", "r2"}, []string{"a", "c"}},
+		{"all columns", func(p *Problem) ([]int, error) { return p.DelSetCols([]int{0, 1, 2, 3}) },
+			[]int{-1, -1, -1, -1}, []string{"r0", "r1", "r2"}, nil},
+		{"range of rows", func(p *Problem) ([]int, error) { return p.DelRows(0, 1) },
+			[]int{-1, -1, 0}, []string{"r2"}, []string{"a", "b", "c", "d"}},
+		{"range of columns", func(p *Problem) ([]int, error) { return p.DelCols(1, 2) },
+			[]int{0, -1, -1, 1}, []string{"r0", "r1", "r2"}, []string{"a", "d"}},
+		{"row out of range", func(p *Problem) ([]int, error) { return p.DelSetRows([]int{0, 3}) }, nil, nil, nil},
+		{"negative column", func(p *Problem) ([]int, error) { return p.DelSetCols([]int{-1}) }, nil, nil, nil},
+		{"no rows", func(p *Problem) ([]int, error) { return p.DelSetRows(nil) }, nil, nil, nil},
+		{"reversed range", func(p *Problem) ([]int, error) { return p.DelCols(2, 1) }, nil, nil, nil},
+		{"range out of range", func(p *Problem) ([]int, error) { return p.DelRows(1, 3) }, nil, nil, nil},
+	}
+
+	for _, backend := range []string{"fake", "go"} {
+		for _, tt := range tests {
+			t.Run(backend + " " + tt.name, func(t *testing.T) {
+
+				var b Backend = NewFakeBackend()
+				if backend == "go" {
+					b = NewGoBackend()
+				}
+				_, prob := newTestProb(t, b, delModel())
+
+				delStat, err := tt.del(prob)
+				if (err != nil) != (tt.delStat == nil) {
+					t.Fatalf("error = %v, want error %t", err, tt.delStat == nil)
+				}
+				if !reflect.DeepEqual(delStat, tt.delStat) {
+					t.Errorf("new indices = %v, want %v", delStat, tt.delStat)
+				}
+
+				var rows []InputRow
+				var cols []InputCol
+				var elems []InputElem
+				prob.GetRows(&rows)
+				prob.GetCols(&cols)
+				prob.GetCoefs(&elems)
+
+				// A failed deletion leaves the problem unchanged.
+				if tt.delStat == nil {
+					if len(rows) != 3 || len(cols) != 4 || len(elems) != 12 {
+						t.Errorf("%d rows, %d columns, %d coefficients, want 3, 4, 12", len(rows), len(cols), len(elems))
+					}
+					return
+				}
+
+				var rowNames, colNames []string
+				for _, row := range rows {
+					rowNames = append(rowNames, row.Name)
+				}
+				for _, col := range cols {
+					colNames = append(colNames, col.Name)
+				}
+				if !reflect.DeepEqual(rowNames, tt.rows) || !reflect.DeepEqual(colNames, tt.cols) {
+					t.Errorf("rows %v, columns %v, want %v, %v", rowNames, colNames, tt.rows, tt.cols)
+				}
+
+				if len(elems) != len(rows) * len(cols) {
+					t.Errorf("%d coefficients, want %d", len(elems), len(rows) * len(cols))
+				}
+				for _, e := range elems {
+					i := int(rows[e.RowIndex].Name[1] - '0')
+					j := int(cols[e.ColIndex].Name[0] - 'a')
+					if e.Value != float64(10 * i + j + 1) {
+						t.Errorf("coefficient %+v of %s and %s, want %d", e, rows[e.RowIndex].Name,
+							cols[e.ColIndex].Name, 10 * i + j + 1)
+					}
+				}
+			})
+		}
+	}
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,707 @@
+// 01   Oct. 16, 2026   Initial version, parameter catalogue and Set/Get functions
+// 02   Oct. 16, 2026   Added ReadParamFile and WriteParamFile
+// 03   Oct. 16, 2026   Added solution pool parameters
+
+package gpx
+
+import (
+	"github.com/pkg/errors"
+)
+
+// ParamType identifies the type of value a parameter holds. The values are the
+// Cplex CPX_PARAMTYPE_* codes.
+type ParamType int
+
+// Parameter types.
+const (
+	ParamTypeNone            ParamType = 0    // Unknown parameter (CPX_PARAMTYPE_NONE)
+	ParamTypeInt             ParamType = 1    // Integer parameter (CPX_PARAMTYPE_INT)
+	ParamTypeDbl             ParamType = 2    // Double parameter (CPX_PARAMTYPE_DOUBLE)
+	ParamTypeStr             ParamType = 3    // String parameter (CPX_PARAMTYPE_STRING)
+	ParamTypeLong            ParamType = 4    // Long integer parameter (CPX_PARAMTYPE_LONG)
+)
+
+// IntParam, DblParam, LongParam and StrParam identify Cplex parameters by their
+// Cplex numeric id. Each parameter is declared with the type of the value it
+// holds, so that e.g. SetIntParam(ParamTimeLimit, 10) does not compile.
+// Parameters missing from the catalogue below can still be used by converting
+// their id, e.g. SetIntParam(IntParam(1130), 1).
+type (
+	IntParam  int
+	DblParam  int
+	LongParam int
+	StrParam  int
+)
+
+// Integer parameters.
+const (
+	ParamAdvance             IntParam  = 1001 // Use advanced start information (CPXPARAM_Advance)
+	ParamSimplexDisplay      IntParam  = 1019 // Simplex display level (CPXPARAM_Simplex_Display)
+	ParamPresolve            IntParam  = 1030 // Presolve on (1) or off (0) (CPXPARAM_Preprocessing_Presolve)
+	ParamScreenOutput        IntParam  = 1035 // Output to screen on (1) or off (0) (CPXPARAM_ScreenOutput)
+	ParamDataCheck           IntParam  = 1056 // Data checking level (CPXPARAM_Read_DataCheck)
+	ParamLpMethod            IntParam  = 1062 // Algorithm used by LpOpt, see Algorithm (CPXPARAM_LPMethod)
+	ParamQpMethod            IntParam  = 1063 // Algorithm used for QPs, see Algorithm (CPXPARAM_QPMethod)
+	ParamThreads             IntParam  = 1067 // Number of threads, 0 for automatic (CPXPARAM_Threads)
+	ParamNumericalEmphasis   IntParam  = 1083 // Emphasize numerical precision (CPXPARAM_Emphasis_Numerical)
+	ParamParallelMode        IntParam  = 1109 // Deterministic (1), automatic (0) or opportunistic (-1) (CPXPARAM_Parallel)
+	ParamMipDisplay          IntParam  = 2012 // MIP display level (CPXPARAM_MIP_Display)
+	ParamStartAlgorithm      IntParam  = 2025 // Algorithm for the MIP root relaxation (CPXPARAM_MIP_Strategy_StartAlgorithm)
+	ParamSubAlgorithm        IntParam  = 2026 // Algorithm for the MIP node relaxations (CPXPARAM_MIP_Strategy_SubAlgorithm)
+	ParamMipEmphasis         IntParam  = 2058 // MIP emphasis, see MipEmphasis* (CPXPARAM_Emphasis_MIP)
+	ParamPoolCapacity        IntParam  = 2103 // Maximum number of solutions in the pool (CPXPARAM_MIP_Pool_Capacity)
+	ParamPoolReplace         IntParam  = 2104 // Pool replacement strategy, see PoolReplace* (CPXPARAM_MIP_Pool_Replace)
+	ParamPoolIntensity       IntParam  = 2107 // Effort spent on generating solutions, 0 to 4 (CPXPARAM_MIP_Pool_Intensity)
+	ParamPopulateLimit       IntParam  = 2108 // Solutions generated by Populate (CPXPARAM_MIP_Limits_Populate)
+	ParamMipSearch           IntParam  = 2109 // MIP search strategy (CPXPARAM_MIP_Strategy_Search)
+	ParamBarCrossover        IntParam  = 3018 // Barrier crossover algorithm (CPXPARAM_Barrier_Crossover)
+)
+
+// Long integer parameters.
+const (
+	ParamItLimit             LongParam = 1020 // Simplex iteration limit (CPXPARAM_Simplex_Limits_Iterations)
+	ParamSolutionLimit       LongParam = 2015 // MIP integer solution limit (CPXPARAM_MIP_Limits_Solutions)
+	ParamNodeLimit           LongParam = 2017 // MIP node limit (CPXPARAM_MIP_Limits_Nodes)
+)
+
+// Double parameters.
+const (
+	ParamMarkowitzTol        DblParam  = 1013 // Markowitz tolerance (CPXPARAM_Simplex_Tolerances_Markowitz)
+	ParamOptimalityTol       DblParam  = 1014 // Optimality tolerance (CPXPARAM_Simplex_Tolerances_Optimality)
+	ParamFeasibilityTol      DblParam  = 1016 // Feasibility tolerance (CPXPARAM_Simplex_Tolerances_Feasibility)
+	ParamTimeLimit           DblParam  = 1039 // Time limit in seconds (CPXPARAM_TimeLimit)
+	ParamWorkMem             DblParam  = 1065 // Working memory in megabytes (CPXPARAM_WorkMem)
+	ParamDetTimeLimit        DblParam  = 1127 // Deterministic time limit in ticks (CPXPARAM_DetTimeLimit)
+	ParamMipAbsGap           DblParam  = 2008 // Absolute MIP gap tolerance (CPXPARAM_MIP_Tolerances_AbsMIPGap)
+	ParamMipGap              DblParam  = 2009 // Relative MIP gap tolerance (CPXPARAM_MIP_Tolerances_MIPGap)
+	ParamIntegralityTol      DblParam  = 2010 // Integrality tolerance (CPXPARAM_MIP_Tolerances_Integrality)
+	ParamTreeMemLimit        DblParam  = 2027 // Tree memory limit in megabytes (CPXPARAM_MIP_Limits_TreeMemory)
+	ParamPoolRelGap          DblParam  = 2105 // Relative gap of the pool solutions to the incumbent (CPXPARAM_MIP_Pool_RelGap)
+	ParamPoolAbsGap          DblParam  = 2106 // Absolute gap of the pool solutions to the incumbent (CPXPARAM_MIP_Pool_AbsGap)
+	ParamBarConvergeTol      DblParam  = 3002 // Barrier convergence tolerance (CPXPARAM_Barrier_ConvergeTol)
+)
+
+// String parameters.
+const (
+	ParamWorkDir             StrParam  = 1064 // Directory for working files (CPXPARAM_WorkDir)
+)
+
+// Values of ParamMipEmphasis (CPX_MIPEMPHASIS_*).
+const (
+	MipEmphasisBalanced      = 0              // Balance optimality and feasibility
+	MipEmphasisFeasibility   = 1              // Emphasize finding feasible solutions
+	MipEmphasisOptimality    = 2              // Emphasize proving optimality
+	MipEmphasisBestBound     = 3              // Emphasize moving the best bound
+	MipEmphasisHiddenFeas    = 4              // Emphasize finding hard to find feasible solutions
+)
+
+// Values of ParamPoolReplace (CPX_SOLNPOOL_*).
+const (
+	PoolReplaceFIFO          = 0              // Replace the oldest solution
+	PoolReplaceObj           = 1              // Replace the solution with the worst objective
+	PoolReplaceDiv           = 2              // Replace solutions so as to keep the pool diverse
+)
+
+// ParamInfo describes a parameter of the catalogue.
+type ParamInfo struct {
+	Name      string        // Cplex name of the parameter (e.g. "CPXPARAM_TimeLimit")
+	ID        int           // Cplex numeric id of the parameter
+	Type      ParamType     // Type of value the parameter holds
+	Default   interface{}   // Value in a new environment: int, float64, int64 or string
+}
+
+// paramCatalogue lists the parameters declared above, with the values they
+// have in a newly opened gpx environment. These are the Cplex defaults, except
+// for data checking, which OpenEnv turns on.
+var paramCatalogue = []ParamInfo{
+	{"CPXPARAM_Advance",                         1001, ParamTypeInt,  1},
+	{"CPXPARAM_Simplex_Tolerances_Markowitz",    1013, ParamTypeDbl,  0.01},
+	{"CPXPARAM_Simplex_Tolerances_Optimality",   1014, ParamTypeDbl,  1.0e-6},
+	{"CPXPARAM_Simplex_Tolerances_Feasibility",  1016, ParamTypeDbl,  1.0e-6},
+	{"CPXPARAM_Simplex_Display",                 1019, ParamTypeInt,  1},
+	{"CPXPARAM_Simplex_Limits_Iterations",       1020, ParamTypeLong, int64(9223372036800000000)},
+	{"CPXPARAM_Preprocessing_Presolve",          1030, ParamTypeInt,  1},
+	{"CPXPARAM_ScreenOutput",                    1035, ParamTypeInt,  0},
+	{"CPXPARAM_TimeLimit",                       1039, ParamTypeDbl,  1.0e75},
+	{"CPXPARAM_Read_DataCheck",                  1056, ParamTypeInt,  1},
+	{"CPXPARAM_LPMethod",                        1062, ParamTypeInt,  0},
+	{"CPXPARAM_QPMethod",                        1063, ParamTypeInt,  0},
+	{"CPXPARAM_WorkDir",                         1064, ParamTypeStr,  "."},
+	{"CPXPARAM_WorkMem",                         1065, ParamTypeDbl,  2048.0},
+	{"CPXPARAM_Threads",                         1067, ParamTypeInt,  0},
+	{"CPXPARAM_Emphasis_Numerical",              1083, ParamTypeInt,  0},
+	{"CPXPARAM_Parallel",                        1109, ParamTypeInt,  0},
+	{"CPXPARAM_DetTimeLimit",                    1127, ParamTypeDbl,  1.0e75},
+	{"CPXPARAM_MIP_Tolerances_AbsMIPGap",        2008, ParamTypeDbl,  1.0e-6},
+	{"CPXPARAM_MIP_Tolerances_MIPGap",           2009, ParamTypeDbl,  1.0e-4},
+	{"CPXPARAM_MIP_Tolerances_Integrality",      2010, ParamTypeDbl,  1.0e-5},
+	{"CPXPARAM_MIP_Display",                     2012, ParamTypeInt,  2},
+	{"CPXPARAM_MIP_Limits_Solutions",            2015, ParamTypeLong, int64(9223372036800000000)},
+	{"CPXPARAM_MIP_Limits_Nodes",                2017, ParamTypeLong, int64(9223372036800000000)},
+	{"CPXPARAM_MIP_Strategy_StartAlgorithm",     2025, ParamTypeInt,  0},
+	{"CPXPARAM_MIP_Strategy_SubAlgorithm",       2026, ParamTypeInt,  0},
+	{"CPXPARAM_MIP_Limits_TreeMemory",           2027, ParamTypeDbl,  1.0e75},
+	{"CPXPARAM_Emphasis_MIP",                    2058, ParamTypeInt,  0},
+	{"CPXPARAM_MIP_Pool_Capacity",               2103, ParamTypeInt,  2100000000},
+	{"CPXPARAM_MIP_Pool_Replace",                2104, ParamTypeInt,  0},
+	{"CPXPARAM_MIP_Pool_RelGap",                 2105, ParamTypeDbl,  1.0e75},
+	{"CPXPARAM_MIP_Pool_AbsGap",                 2106, ParamTypeDbl,  1.0e75},
+	{"CPXPARAM_MIP_Pool_Intensity",              2107, ParamTypeInt,  0},
+	{"CPXPARAM_MIP_Limits_Populate",             2108, ParamTypeInt,  20},
+	{"CPXPARAM_MIP_Strategy_Search",             2109, ParamTypeInt,  0},
+	{"CPXPARAM_Barrier_ConvergeTol",             3002, ParamTypeDbl,  1.0e-8},
+	{"CPXPARAM_Barrier_Crossover",               3018, ParamTypeInt,  0},
+}
+
+// paramStore keeps parameter values for backends which have no parameter
+// handling of their own. Parameters which have not been set report their
+// default from the catalogue. It implements the parameter methods of
+// BackendEnv, and calls hook, if set, before each change.
+type paramStore struct {
+	values  map[int]interface{}                         // Values set, keyed by parameter id
+	hook    func(op string, args ...interface{}) error  // Called before each change, may veto it
+}
+
+//==============================================================================
+// PARAMETER CATALOGUE
+//==============================================================================
+
+// Params returns a copy of the catalogue of parameters declared by gpx, in
+// order of their id.
+func Params() []ParamInfo {
+
+	return append([]ParamInfo(nil), paramCatalogue...)
+}
+
+//==============================================================================
+
+// lookupParamID returns the catalogue entry of the parameter with the id passed
+// into this function. The boolean is false if the parameter is not listed.
+func lookupParamID(id int) (ParamInfo, bool) {
+
+	for _, info := range paramCatalogue {
+		if info.ID == id {
+			return info, true
+		}
+	}
+
+	return ParamInfo{}, false
+}
+
+//==============================================================================
+// PARAMETER STORE
+//==============================================================================
+
+// set stores the value of a parameter after checking its type against the
+// catalogue.
+func (s *paramStore) set(op string, id int, ptype ParamType, value interface{}) error {
+
+	if info, ok := lookupParamID(id); ok && info.Type != ptype {
+		return errors.Errorf("%s: parameter %s is not of this type", op, info.Name)
+	}
+
+	if s.hook != nil {
+		if err := s.hook(op, id, value); err != nil {
+			return err
+		}
+	}
+
+	if s.values == nil {
+		s.values = make(map[int]interface{})
+	}
+	s.values[id] = value
+
+	return nil
+}
+
+//==============================================================================
+
+// get returns the value of a parameter, or its default if it has not been set.
+// In case the parameter has not been set and is not in the catalogue, or is of
+// a different type, it returns an error.
+func (s *paramStore) get(op string, id int, ptype ParamType) (interface{}, error) {
+
+	info, known := lookupParamID(id)
+	if known && info.Type != ptype {
+		return nil, errors.Errorf("%s: parameter %s is not of this type", op, info.Name)
+	}
+
+	if value, ok := s.values[id]; ok {
+		return value, nil
+	}
+
+	if !known {
+		return nil, errors.Errorf("%s: parameter %d is unknown and has not been set", op, id)
+	}
+
+	return info.Default, nil
+}
+
+//==============================================================================
+
+// SetIntParam stores the value of an integer parameter.
+func (s *paramStore) SetIntParam(id int, value int) error {
+
+	return s.set("SetIntParam", id, ParamTypeInt, value)
+}
+
+// SetDblParam stores the value of a double parameter.
+func (s *paramStore) SetDblParam(id int, value float64) error {
+
+	return s.set("SetDblParam", id, ParamTypeDbl, value)
+}
+
+// SetLongParam stores the value of a long integer parameter.
+func (s *paramStore) SetLongParam(id int, value int64) error {
+
+	return s.set("SetLongParam", id, ParamTypeLong, value)
+}
+
+// SetStrParam stores the value of a string parameter.
+func (s *paramStore) SetStrParam(id int, value string) error {
+
+	return s.set("SetStrParam", id, ParamTypeStr, value)
+}
+
+// GetIntParam returns the value of an integer parameter.
+func (s *paramStore) GetIntParam(id int) (int, error) {
+
+	value, err := s.get("GetIntParam", id, ParamTypeInt)
+	if err != nil {
+		return 0, err
+	}
+
+	return value.(int), nil
+}
+
+// GetDblParam returns the value of a double parameter.
+func (s *paramStore) GetDblParam(id int) (float64, error) {
+
+	value, err := s.get("GetDblParam", id, ParamTypeDbl)
+	if err != nil {
+		return 0, err
+	}
+
+	return value.(float64), nil
+}
+
+// GetLongParam returns the value of a long integer parameter.
+func (s *paramStore) GetLongParam(id int) (int64, error) {
+
+	value, err := s.get("GetLongParam", id, ParamTypeLong)
+	if err != nil {
+		return 0, err
+	}
+
+	return value.(int64), nil
+}
+
+// GetStrParam returns the value of a string parameter.
+func (s *paramStore) GetStrParam(id int) (string, error) {
+
+	value, err := s.get("GetStrParam", id, ParamTypeStr)
+	if err != nil {
+		return "", err
+	}
+
+	return value.(string), nil
+}
+
+// SetDefaults discards all values set, so that every parameter reports its
+// default.
+func (s *paramStore) SetDefaults() error {
+
+	if s.hook != nil {
+		if err := s.hook("SetDefaults"); err != nil {
+			return err
+		}
+	}
+
+	s.values = nil
+
+	return nil
+}
+
+// ReadParamFile sets the parameters listed in a parameter file. Parameters
+// which are not listed keep their value.
+func (s *paramStore) ReadParamFile(fileName string) error {
+
+	if s.hook != nil {
+		if err := s.hook("ReadParamFile", fileName); err != nil {
+			return err
+		}
+	}
+
+	set, err := ReadParamSet(fileName)
+	if err != nil {
+		return err
+	}
+
+	if s.values == nil {
+		s.values = make(map[int]interface{})
+	}
+	for id, value := range set.values {
+		s.values[id] = value
+	}
+
+	return nil
+}
+
+// WriteParamFile writes the parameters of the catalogue which have been set to
+// a value other than their default to a parameter file.
+func (s *paramStore) WriteParamFile(fileName string) error {
+
+	if s.hook != nil {
+		if err := s.hook("WriteParamFile", fileName); err != nil {
+			return err
+		}
+	}
+
+	set := &ParamSet{}
+	for id, value := range s.values {
+		if info, ok := lookupParamID(id); ok && value != info.Default {
+			set.set(id, info.Type, value)
+		}
+	}
+
+	return set.WriteParamSet(fileName)
+}
+
+//==============================================================================
+// ENVIRONMENT FUNCTIONS
+//==============================================================================
+
+// SetIntParam sets the integer parameter passed into this function for all
+// problems in the environment.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXsetintparam.
+func (e *Env) SetIntParam(param IntParam, value int) error {
+
+	if e.drv == nil {
+		return errors.New("Environment is not open")
+	}
+
+	return e.drv.SetIntParam(int(param), value)
+}
+
+//==============================================================================
+
+// SetDblParam sets the double parameter passed into this function for all
+// problems in the environment.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXsetdblparam.
+func (e *Env) SetDblParam(param DblParam, value float64) error {
+
+	if e.drv == nil {
+		return errors.New("Environment is not open")
+	}
+
+	return e.drv.SetDblParam(int(param), value)
+}
+
+//==============================================================================
+
+// SetLongParam sets the long integer parameter passed into this function for
+// all problems in the environment.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXsetlongparam.
+func (e *Env) SetLongParam(param LongParam, value int64) error {
+
+	if e.drv == nil {
+		return errors.New("Environment is not open")
+	}
+
+	return e.drv.SetLongParam(int(param), value)
+}
+
+//==============================================================================
+
+// SetStrParam sets the string parameter passed into this function for all
+// problems in the environment.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXsetstrparam.
+func (e *Env) SetStrParam(param StrParam, value string) error {
+
+	if e.drv == nil {
+		return errors.New("Environment is not open")
+	}
+
+	return e.drv.SetStrParam(int(param), value)
+}
+
+//==============================================================================
+
+// GetIntParam retrieves the current value of an integer parameter.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXgetintparam.
+func (e *Env) GetIntParam(param IntParam, value *int) error {
+	var err   error   // Error returned by the backend
+
+	if e.drv == nil {
+		return errors.New("Environment is not open")
+	}
+
+	*value, err = e.drv.GetIntParam(int(param))
+
+	return err
+}
+
+//==============================================================================
+
+// GetDblParam retrieves the current value of a double parameter.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXgetdblparam.
+func (e *Env) GetDblParam(param DblParam, value *float64) error {
+	var err   error   // Error returned by the backend
+
+	if e.drv == nil {
+		return errors.New("Environment is not open")
+	}
+
+	*value, err = e.drv.GetDblParam(int(param))
+
+	return err
+}
+
+//==============================================================================
+
+// GetLongParam retrieves the current value of a long integer parameter.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXgetlongparam.
+func (e *Env) GetLongParam(param LongParam, value *int64) error {
+	var err   error   // Error returned by the backend
+
+	if e.drv == nil {
+		return errors.New("Environment is not open")
+	}
+
+	*value, err = e.drv.GetLongParam(int(param))
+
+	return err
+}
+
+//==============================================================================
+
+// GetStrParam retrieves the current value of a string parameter.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXgetstrparam.
+func (e *Env) GetStrParam(param StrParam, value *string) error {
+	var err   error   // Error returned by the backend
+
+	if e.drv == nil {
+		return errors.New("Environment is not open")
+	}
+
+	*value, err = e.drv.GetStrParam(int(param))
+
+	return err
+}
+
+//==============================================================================
+
+// SetDefaults resets all parameters of the environment to the values they have
+// in a newly opened environment. Data checking remains turned on, but output to
+// screen is turned off.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXsetdefaults and CPXsetintparam.
+func (e *Env) SetDefaults() error {
+
+	if e.drv == nil {
+		return errors.New("Environment is not open")
+	}
+
+	return e.drv.SetDefaults()
+}
+
+//==============================================================================
+
+// ReadParamFile reads parameter names and values from the file passed into this
+// function and sets them in the environment. Parameters which are not listed
+// in the file keep their value. Files written by WriteParamFile or by
+// ParamSet.WriteParamSet can be read. With the Go backends, only the
+// parameters of the catalogue can be read.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXreadcopyparam.
+func (e *Env) ReadParamFile(fileName string) error {
+
+	if e.drv == nil {
+		return errors.New("Environment is not open")
+	}
+
+	return e.drv.ReadParamFile(fileName)
+}
+
+//==============================================================================
+
+// WriteParamFile writes the name and value of each parameter which is not at
+// its default to the file passed into this function. The file can be read by
+// ReadParamFile or ReadParamSet. With the Go backends, only the parameters of
+// the catalogue which have been set are written.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXwriteparam.
+func (e *Env) WriteParamFile(fileName string) error {
+
+	if e.drv == nil {
+		return errors.New("Environment is not open")
+	}
+
+	return e.drv.WriteParamFile(fileName)
+}
+
+//==============================================================================
+// PACKAGE-LEVEL FUNCTIONS
+//==============================================================================
+
+// SetIntParam sets an integer parameter in the environment of the default
+// problem. See Env.SetIntParam.
+func SetIntParam(param IntParam, value int) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.env.SetIntParam(param, value)
+}
+
+//==============================================================================
+
+// SetDblParam sets a double parameter in the environment of the default
+// problem. See Env.SetDblParam.
+func SetDblParam(param DblParam, value float64) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.env.SetDblParam(param, value)
+}
+
+//==============================================================================
+
+// SetLongParam sets a long integer parameter in the environment of the default
+// problem. See Env.SetLongParam.
+func SetLongParam(param LongParam, value int64) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.env.SetLongParam(param, value)
+}
+
+//==============================================================================
+
+// SetStrParam sets a string parameter in the environment of the default
+// problem. See Env.SetStrParam.
+func SetStrParam(param StrParam, value string) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.env.SetStrParam(param, value)
+}
+
+//==============================================================================
+
+// GetIntParam retrieves an integer parameter from the environment of the
+// default problem. See Env.GetIntParam.
+func GetIntParam(param IntParam, value *int) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.env.GetIntParam(param, value)
+}
+
+//==============================================================================
+
+// GetDblParam retrieves a double parameter from the environment of the default
+// problem. See Env.GetDblParam.
+func GetDblParam(param DblParam, value *float64) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.env.GetDblParam(param, value)
+}
+
+//==============================================================================
+
+// GetLongParam retrieves a long integer parameter from the environment of the
+// default problem. See Env.GetLongParam.
+func GetLongParam(param LongParam, value *int64) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.env.GetLongParam(param, value)
+}
+
+//==============================================================================
+
+// GetStrParam retrieves a string parameter from the environment of the default
+// problem. See Env.GetStrParam.
+func GetStrParam(param StrParam, value *string) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.env.GetStrParam(param, value)
+}
+
+//==============================================================================
+
+// SetDefaults resets all parameters in the environment of the default problem.
+// See Env.SetDefaults.
+func SetDefaults() error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.env.SetDefaults()
+}
+
+//==============================================================================
+
+// ReadParamFile sets the parameters listed in a file in the environment of the
+// default problem. See Env.ReadParamFile.
+func ReadParamFile(fileName string) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.env.ReadParamFile(fileName)
+}
+
+//==============================================================================
+
+// WriteParamFile writes the non-default parameters of the environment of the
+// default problem to a file. See Env.WriteParamFile.
+func WriteParamFile(fileName string) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.env.WriteParamFile(fileName)
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,138 @@
+package gpx
+
+import (
+	"github.com/pkg/errors"
+	"testing"
+)
+
+func TestParamStoreTypes(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		id     int
+		ptype  ParamType
+		value  interface{}
+		ok     bool      // The value can be set and read back
+	}{
+		{"int", int(ParamThreads), ParamTypeInt, 4, true},
+		{"double", int(ParamTimeLimit), ParamTypeDbl, 10.5, true},
+		{"long", int(ParamNodeLimit), ParamTypeLong, int64(100), true},
+		{"string", int(ParamWorkDir), ParamTypeStr, "/tmp", true},
+		{"int as double", int(ParamThreads), ParamTypeDbl, 4.0, false},
+		{"double as int", int(ParamTimeLimit), ParamTypeInt, 10, false},
+		{"long as int", int(ParamNodeLimit), ParamTypeInt, 100, false},
+		{"int as long", int(ParamThreads), ParamTypeLong, int64(4), false},
+		{"string as int", int(ParamWorkDir), ParamTypeInt, 1, false},
+		{"unknown int", 1130, ParamTypeInt, 1, true},
+		{"unknown double", 1131, ParamTypeDbl, 0.5, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			var s paramStore
+			var err error
+
+			switch tt.ptype {
+			case ParamTypeInt:
+				err = s.SetIntParam(tt.id, tt.value.(int))
+			case ParamTypeDbl:
+				err = s.SetDblParam(tt.id, tt.value.(float64))
+			case ParamTypeLong:
+				err = s.SetLongParam(tt.id, tt.value.(int64))
+			case ParamTypeStr:
+				err = s.SetStrParam(tt.id, tt.value.(string))
+			}
+			if (err == nil) != tt.ok {
+				t.Fatalf("Set = %v, want success %t", err, tt.ok)
+			}
+			if !tt.ok && len(s.values) != 0 {
+				t.Errorf("values = %v after a rejected Set, want none", s.values)
+			}
+
+			var got interface{}
+			switch tt.ptype {
+			case ParamTypeInt:
+				got, err = s.GetIntParam(tt.id)
+			case ParamTypeDbl:
+				got, err = s.GetDblParam(tt.id)
+			case ParamTypeLong:
+				got, err = s.GetLongParam(tt.id)
+			case ParamTypeStr:
+				got, err = s.GetStrParam(tt.id)
+			}
+			if tt.ok && (err != nil || got != tt.value) {
+				t.Errorf("Get = %v, %v, want %v", got, err, tt.value)
+			}
+			if !tt.ok && err == nil {
+				t.Errorf("Get = %v, want error", got)
+			}
+		})
+	}
+}
+
+//==============================================================================
+
+func TestParamStoreDefaults(t *testing.T) {
+
+	var s paramStore
+
+	// Parameters of the catalogue report their default until they are set.
+	for _, info := range paramCatalogue {
+		if got, err := s.get("get", info.ID, info.Type); err != nil || got != info.Default {
+			t.Errorf("%s = %v, %v, want the default %v", info.Name, got, err, info.Default)
+		}
+	}
+
+	if _, err := s.GetIntParam(1130); err == nil {
+		t.Error("GetIntParam of an unknown parameter which has not been set succeeded, want error")
+	}
+
+	if err := s.SetIntParam(int(ParamThreads), 4); err != nil {
+		t.Fatalf("SetIntParam failed: %v", err)
+	}
+	if err := s.SetDefaults(); err != nil {
+		t.Fatalf("SetDefaults failed: %v", err)
+	}
+	if got, err := s.GetIntParam(int(ParamThreads)); err != nil || got != 0 {
+		t.Errorf("GetIntParam after SetDefaults = %d, %v, want 0", got, err)
+	}
+}
+
+//==============================================================================
+
+// TestEnvParams sets parameters through an environment of the fake backend,
+// whose hook records each change and can veto it.
+func TestEnvParams(t *testing.T) {
+
+	fake := NewFakeBackend()
+	env, err := OpenEnv(fake)
+	if err != nil {
+		t.Fatalf("OpenEnv failed: %v", err)
+	}
+
+	if err = env.SetIntParam(ParamThreads, 2); err != nil {
+		t.Fatalf("SetIntParam failed: %v", err)
+	}
+	if err = env.SetIntParam(IntParam(ParamTimeLimit), 2); err == nil {
+		t.Error("SetIntParam of a double parameter succeeded, want error")
+	}
+
+	cplexErr := &CplexError{Op: "SetIntParam", Code: 1015}
+	fake.Errors["SetIntParam"] = cplexErr
+	if err = env.SetIntParam(ParamThreads, 8); errors.Cause(err) != cplexErr {
+		t.Errorf("SetIntParam = %v, want %v", err, cplexErr)
+	}
+
+	var threads int
+	if err = env.GetIntParam(ParamThreads, &threads); err != nil || threads != 2 {
+		t.Errorf("GetIntParam = %d, %v, want 2 after the vetoed change", threads, err)
+	}
+
+	var timeLimit float64
+	if err = env.GetDblParam(ParamTimeLimit, &timeLimit); err != nil || timeLimit != 1.0e75 {
+		t.Errorf("GetDblParam = %g, %v, want the default 1e75", timeLimit, err)
+	}
+}
+
+//============================ END OF FILE =====================================
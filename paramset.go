@@ -0,0 +1,528 @@
+// 01   Oct. 16, 2026   Initial version, parameter sets and their text format
+// 02   Oct. 16, 2026   Version header of Cplex parameter files, unknown parameters skipped
+
+package gpx
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/pkg/errors"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// paramFileHeader starts the first line of Cplex parameter files, followed by
+// the version of Cplex which wrote the file. CPXreadcopyparam rejects files
+// without it.
+const (
+	paramFileHeader  = "CPLEX Parameter File Version"
+	paramFileVersion = "22.1.1.0"
+)
+
+// ParamSet holds values for parameters of the catalogue, for example the tuned
+// settings of a family of models. Parameters which are not in the set keep
+// their current value when the set is applied to an environment.
+//
+// The text form of a ParamSet is the format of Cplex parameter files: a
+// version header, followed by one parameter per line, given by its Cplex name
+// and its value, with string values in double quotes. Blank lines and text
+// following a "#" are ignored:
+//
+//	CPLEX Parameter File Version 22.1.1.0
+//	# Settings for the scheduling models
+//	CPXPARAM_TimeLimit                      60
+//	CPXPARAM_MIP_Tolerances_MIPGap          0.005
+//	CPXPARAM_Emphasis_MIP                   1
+//
+// A ParamSet can therefore be loaded from files written by WriteParamFile, and
+// files written from a ParamSet can be read by ReadParamFile. Parameters of
+// the file which are not in the catalogue are skipped when it is loaded.
+// The zero value is an empty set ready to use.
+type ParamSet struct {
+	values    map[int]interface{}   // Values in the set, keyed by parameter id
+}
+
+// ParamDiff describes a parameter whose value differs between two sets.
+// Parameters missing from a set are reported with their default value.
+type ParamDiff struct {
+	Param     ParamInfo             // Parameter which differs
+	Value     interface{}           // Value in the set on which Diff was called
+	Other     interface{}           // Value in the set passed to Diff
+}
+
+//==============================================================================
+// BUILDING PARAMETER SETS
+//==============================================================================
+
+// SetInt adds an integer parameter to the set, replacing any previous value.
+// In case the parameter is not in the catalogue, it returns an error.
+func (s *ParamSet) SetInt(param IntParam, value int) error {
+
+	return s.set(int(param), ParamTypeInt, value)
+}
+
+//==============================================================================
+
+// SetDbl adds a double parameter to the set, replacing any previous value.
+// In case the parameter is not in the catalogue, it returns an error.
+func (s *ParamSet) SetDbl(param DblParam, value float64) error {
+
+	return s.set(int(param), ParamTypeDbl, value)
+}
+
+//==============================================================================
+
+// SetLong adds a long integer parameter to the set, replacing any previous
+// value. In case the parameter is not in the catalogue, it returns an error.
+func (s *ParamSet) SetLong(param LongParam, value int64) error {
+
+	return s.set(int(param), ParamTypeLong, value)
+}
+
+//==============================================================================
+
+// SetStr adds a string parameter to the set, replacing any previous value.
+// In case the parameter is not in the catalogue, it returns an error.
+func (s *ParamSet) SetStr(param StrParam, value string) error {
+
+	return s.set(int(param), ParamTypeStr, value)
+}
+
+//==============================================================================
+
+// set adds a parameter after checking it against the catalogue.
+func (s *ParamSet) set(id int, ptype ParamType, value interface{}) error {
+
+	info, ok := lookupParamID(id)
+	if !ok {
+		return errors.Errorf("Parameter %d is not in the catalogue", id)
+	}
+
+	if info.Type != ptype {
+		return errors.Errorf("Parameter %s is not of this type", info.Name)
+	}
+
+	if s.values == nil {
+		s.values = make(map[int]interface{})
+	}
+	s.values[id] = value
+
+	return nil
+}
+
+//==============================================================================
+
+// Delete removes a parameter from the set. The parameter may be of any type,
+// e.g. Delete(int(ParamTimeLimit)).
+func (s *ParamSet) Delete(id int) {
+
+	delete(s.values, id)
+}
+
+//==============================================================================
+
+// Len returns the number of parameters in the set.
+func (s *ParamSet) Len() int {
+
+	return len(s.values)
+}
+
+//==============================================================================
+
+// Value returns the value of a parameter in the set, as an int, float64, int64
+// or string depending on its type. The boolean is false if the parameter is not
+// in the set.
+func (s *ParamSet) Value(id int) (interface{}, bool) {
+
+	value, ok := s.values[id]
+
+	return value, ok
+}
+
+//==============================================================================
+
+// ids returns the ids of the parameters in the set in increasing order.
+func (s *ParamSet) ids() []int {
+
+	ids := make([]int, 0, len(s.values))
+	for id := range s.values {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	return ids
+}
+
+//==============================================================================
+// COMBINING PARAMETER SETS
+//==============================================================================
+
+// Merge copies all values of the set passed into this function into s,
+// replacing the values s already holds for the same parameters. This allows
+// general settings to be overridden by the settings of a model family:
+//
+//	set := base.Clone()
+//	set.Merge(family)
+func (s *ParamSet) Merge(other *ParamSet) {
+
+	for id, value := range other.values {
+		if s.values == nil {
+			s.values = make(map[int]interface{})
+		}
+		s.values[id] = value
+	}
+}
+
+//==============================================================================
+
+// Clone returns a copy of the set.
+func (s *ParamSet) Clone() *ParamSet {
+
+	c := &ParamSet{}
+	c.Merge(s)
+
+	return c
+}
+
+//==============================================================================
+
+// Diff returns the parameters whose value differs between s and the set passed
+// into this function, in order of their id. A parameter missing from one of
+// the sets is compared using its default value, so that a set holding a
+// default value explicitly does not differ from a set which omits it.
+func (s *ParamSet) Diff(other *ParamSet) []ParamDiff {
+	var diffs   []ParamDiff     // Differences found
+
+	all := s.Clone()
+	all.Merge(other)
+
+	for _, id := range all.ids() {
+		info, _ := lookupParamID(id)
+
+		value, ok := s.values[id]
+		if !ok {
+			value = info.Default
+		}
+		otherValue, ok := other.values[id]
+		if !ok {
+			otherValue = info.Default
+		}
+
+		if value != otherValue {
+			diffs = append(diffs, ParamDiff{Param: info, Value: value, Other: otherValue})
+		}
+	}
+
+	return diffs
+}
+
+//==============================================================================
+// TEXT FORMAT
+//==============================================================================
+
+// ParseParamSet reads a parameter set in text form from the reader passed into
+// this function. See ParamSet for the format. The version header may be
+// omitted, and parameters which are not in the catalogue are skipped, so that
+// files written by CPXwriteparam for any version of Cplex can be read.
+// In case a line cannot be parsed or has a value of the wrong type, it returns
+// an error giving the line number.
+func ParseParamSet(r io.Reader) (*ParamSet, error) {
+
+	s := &ParamSet{}
+	scanner := bufio.NewScanner(r)
+	header := true   // The version header may still follow
+
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := scanner.Text()
+		if k := strings.Index(line, "#"); k >= 0 && !strings.Contains(line[:k], "\"") {
+			line = line[:k]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if header && strings.HasPrefix(line, paramFileHeader) {
+			header = false
+			continue
+		}
+		header = false
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return nil, errors.Errorf("Line %d: expected a parameter name and a value", lineNum)
+		}
+		name := fields[0]
+		text := strings.TrimSpace(line[len(name):])
+
+		info, ok := lookupParamName(name)
+		if !ok {
+			continue
+		}
+
+		value, err := parseParamValue(info.Type, text)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Line %d: invalid value for %s", lineNum, name)
+		}
+		s.set(info.ID, info.Type, value)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "Failed to read parameter set")
+	}
+
+	return s, nil
+}
+
+//==============================================================================
+
+// ReadParamSet reads a parameter set in text form from the file passed into
+// this function. See ParseParamSet.
+func ReadParamSet(fileName string) (*ParamSet, error) {
+
+	f, err := os.Open(fileName)
+	if err != nil {
+		return nil, errors.Wrap(ErrFileOpen, err.Error())
+	}
+	defer f.Close()
+
+	s, err := ParseParamSet(f)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to parse %s", fileName)
+	}
+
+	return s, nil
+}
+
+//==============================================================================
+
+// WriteTo writes the set in text form to the writer passed into this function,
+// the version header followed by one parameter per line in order of their id.
+// It returns the number of bytes written.
+func (s *ParamSet) WriteTo(w io.Writer) (int64, error) {
+	var total   int64     // Number of bytes written
+
+	n, err := fmt.Fprintf(w, "%s %s\n", paramFileHeader, paramFileVersion)
+	total += int64(n)
+	if err != nil {
+		return total, err
+	}
+
+	for _, id := range s.ids() {
+		info, _ := lookupParamID(id)
+		n, err := fmt.Fprintf(w, "%-48s %s\n", info.Name, formatParamValue(s.values[id]))
+		total += int64(n)
+		if err != nil {
+			return total, err
+		}
+	}
+
+	return total, nil
+}
+
+//==============================================================================
+
+// WriteParamSet writes the set in text form to the file passed into this
+// function, replacing the file if it exists.
+func (s *ParamSet) WriteParamSet(fileName string) error {
+
+	f, err := os.Create(fileName)
+	if err != nil {
+		return errors.Wrap(ErrFileOpen, err.Error())
+	}
+
+	if _, err = s.WriteTo(f); err != nil {
+		f.Close()
+		return errors.Wrapf(err, "Failed to write %s", fileName)
+	}
+
+	return f.Close()
+}
+
+//==============================================================================
+
+// String returns the set in text form.
+func (s *ParamSet) String() string {
+	var b   strings.Builder   // Text form of the set
+
+	s.WriteTo(&b)
+
+	return b.String()
+}
+
+//==============================================================================
+
+// lookupParamName returns the catalogue entry of the parameter with the Cplex
+// name passed into this function. The boolean is false if it is not listed.
+func lookupParamName(name string) (ParamInfo, bool) {
+
+	for _, info := range paramCatalogue {
+		if info.Name == name {
+			return info, true
+		}
+	}
+
+	return ParamInfo{}, false
+}
+
+//==============================================================================
+
+// parseParamValue converts the text of a value to the type of the parameter.
+// String values may be enclosed in double quotes.
+func parseParamValue(ptype ParamType, text string) (interface{}, error) {
+
+	switch ptype {
+	case ParamTypeInt:
+		return strconv.Atoi(text)
+	case ParamTypeLong:
+		return strconv.ParseInt(text, 10, 64)
+	case ParamTypeDbl:
+		return strconv.ParseFloat(text, 64)
+	case ParamTypeStr:
+		if strings.HasPrefix(text, "\"") {
+			return strconv.Unquote(text)
+		}
+		return text, nil
+	}
+
+	return nil, errors.Errorf("Unsupported parameter type %d", ptype)
+}
+
+//==============================================================================
+
+// formatParamValue returns the text form of a value.
+func formatParamValue(value interface{}) string {
+
+	switch v := value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case string:
+		return strconv.Quote(v)
+	}
+
+	return fmt.Sprint(value)
+}
+
+//==============================================================================
+// APPLYING PARAMETER SETS
+//==============================================================================
+
+// ApplyParamSet sets all parameters of the set in the environment. Parameters
+// which are not in the set keep their current value.
+// In case of failure, it returns an error including the error code it received
+// from Cplex, and the parameters following the one which failed are not set.
+// This function uses CPXsetintparam, CPXsetdblparam, CPXsetlongparam and
+// CPXsetstrparam.
+func (e *Env) ApplyParamSet(set *ParamSet) error {
+	var err   error   // Error returned by the backend
+
+	if e.drv == nil {
+		return errors.New("Environment is not open")
+	}
+
+	for _, id := range set.ids() {
+		switch value := set.values[id].(type) {
+		case int:
+			err = e.drv.SetIntParam(id, value)
+		case float64:
+			err = e.drv.SetDblParam(id, value)
+		case int64:
+			err = e.drv.SetLongParam(id, value)
+		case string:
+			err = e.drv.SetStrParam(id, value)
+		}
+		if err != nil {
+			info, _ := lookupParamID(id)
+			return errors.Wrapf(err, "Failed to set %s", info.Name)
+		}
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// GetParamSet fills the set passed into this function with the current value
+// of each parameter of the catalogue which is not at its default, replacing
+// the previous contents of the set. Comparing the result with a stored set
+// using Diff shows how the environment departs from it.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXgetintparam, CPXgetdblparam, CPXgetlongparam and
+// CPXgetstrparam.
+func (e *Env) GetParamSet(set *ParamSet) error {
+	var value   interface{}   // Current value of the parameter
+	var err     error         // Error returned by the backend
+
+	if e.drv == nil {
+		return errors.New("Environment is not open")
+	}
+
+	set.values = nil
+	for _, info := range paramCatalogue {
+		switch info.Type {
+		case ParamTypeInt:
+			value, err = e.drv.GetIntParam(info.ID)
+		case ParamTypeDbl:
+			value, err = e.drv.GetDblParam(info.ID)
+		case ParamTypeLong:
+			value, err = e.drv.GetLongParam(info.ID)
+		case ParamTypeStr:
+			value, err = e.drv.GetStrParam(info.ID)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "Failed to get %s", info.Name)
+		}
+		if value != info.Default {
+			set.set(info.ID, info.Type, value)
+		}
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// ApplyParamSet sets all parameters of the set in the environment of the
+// problem, where they also apply to the other problems of the environment.
+// See Env.ApplyParamSet.
+func (p *Problem) ApplyParamSet(set *ParamSet) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	return p.env.ApplyParamSet(set)
+}
+
+//==============================================================================
+
+// ApplyParamSet sets all parameters of the set in the environment of the
+// default problem. See Env.ApplyParamSet.
+func ApplyParamSet(set *ParamSet) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.ApplyParamSet(set)
+}
+
+//==============================================================================
+
+// GetParamSet retrieves the parameters which are not at their default from the
+// environment of the default problem. See Env.GetParamSet.
+func GetParamSet(set *ParamSet) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.env.GetParamSet(set)
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,334 @@
+package gpx
+
+import (
+	"github.com/pkg/errors"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// newTestParamSet returns a set holding a parameter of each type.
+func newTestParamSet(t *testing.T) *ParamSet {
+
+	t.Helper()
+
+	s := &ParamSet{}
+	for _, err := range []error{
+		s.SetInt(ParamThreads, 4),
+		s.SetDbl(ParamTimeLimit, 60),
+		s.SetDbl(ParamMipGap, 0.005),
+		s.SetLong(ParamNodeLimit, 1000),
+		s.SetStr(ParamWorkDir, "/tmp/my dir"),
+	} {
+		if err != nil {
+			t.Fatalf("Set failed: %v", err)
+		}
+	}
+
+	return s
+}
+
+//==============================================================================
+
+func TestParamSetRoundTrip(t *testing.T) {
+
+	s := newTestParamSet(t)
+
+	parsed, err := ParseParamSet(strings.NewReader(s.String()))
+	if err != nil {
+		t.Fatalf("ParseParamSet failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(parsed, s) {
+		t.Errorf("ParseParamSet(%q) = %v, want %v", s.String(), parsed, s)
+	}
+
+	fileName := filepath.Join(t.TempDir(), "params.prm")
+	if err = s.WriteParamSet(fileName); err != nil {
+		t.Fatalf("WriteParamSet failed: %v", err)
+	}
+
+	read, err := ReadParamSet(fileName)
+	if err != nil {
+		t.Fatalf("ReadParamSet failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(read, s) {
+		t.Errorf("ReadParamSet = %v, want %v", read, s)
+	}
+}
+
+//==============================================================================
+
+func TestParamSetWriteTo(t *testing.T) {
+
+	want := "CPLEX Parameter File Version 22.1.1.0\n" +
+		"CPXPARAM_TimeLimit                               60\n" +
+		"CPXPARAM_WorkDir                                 \"/tmp/my dir\"\n" +
+		"CPXPARAM_Threads                                 4\n" +
+		"CPXPARAM_MIP_Tolerances_MIPGap                   0.005\n" +
+		"CPXPARAM_MIP_Limits_Nodes                        1000\n"
+
+	if got := newTestParamSet(t).String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+//==============================================================================
+
+func TestParseParamSet(t *testing.T) {
+
+	text := "# Settings for the scheduling models\n" +
+		"\n" +
+		"CPXPARAM_TimeLimit      60     # one minute\n" +
+		"  CPXPARAM_MIP_Tolerances_MIPGap\t0.005\n" +
+		"CPXPARAM_Threads 4\n" +
+		"CPXPARAM_MIP_Limits_Nodes 1000\n" +
+		"CPXPARAM_WorkDir \"/tmp/my dir\"\n"
+
+	s, err := ParseParamSet(strings.NewReader(text))
+	if err != nil {
+		t.Fatalf("ParseParamSet failed: %v", err)
+	}
+
+	if want := newTestParamSet(t); !reflect.DeepEqual(s, want) {
+		t.Errorf("ParseParamSet = %v, want %v", s, want)
+	}
+
+	tests := []struct {
+		name   string
+		text   string
+		id     int
+		want   interface{}
+	}{
+		{"unquoted string", "CPXPARAM_WorkDir /tmp", int(ParamWorkDir), "/tmp"},
+		{"hash in string", "CPXPARAM_WorkDir \"/tmp/#1\"", int(ParamWorkDir), "/tmp/#1"},
+		{"exponent", "CPXPARAM_TimeLimit 1e75", int(ParamTimeLimit), 1e75},
+		{"integer double", "CPXPARAM_TimeLimit 10", int(ParamTimeLimit), 10.0},
+		{"long", "CPXPARAM_MIP_Limits_Nodes 9223372036800000000", int(ParamNodeLimit), int64(9223372036800000000)},
+		{"last value wins", "CPXPARAM_Threads 2\nCPXPARAM_Threads 3", int(ParamThreads), 3},
+		{"header", "\nCPLEX Parameter File Version 12.6.3.0\nCPXPARAM_Threads 2", int(ParamThreads), 2},
+		{"unknown name skipped", "CPXPARAM_NoSuchParam 1\nCPXPARAM_Threads 2", int(ParamThreads), 2},
+	}
+
+	for _, tt := range tests {
+		s, err := ParseParamSet(strings.NewReader(tt.text))
+		if err != nil {
+			t.Errorf("%s: ParseParamSet failed: %v", tt.name, err)
+			continue
+		}
+		if value, ok := s.Value(tt.id); !ok || value != tt.want || s.Len() != 1 {
+			t.Errorf("%s: value = %v (%T), want %v (%T)", tt.name, value, value, tt.want, tt.want)
+		}
+	}
+}
+
+//==============================================================================
+
+// TestReadCplexParamFile reads a file in the layout CPXwriteparam uses, whose
+// double values are written with 17 significant digits, and which sets
+// parameters that are not in the catalogue.
+func TestReadCplexParamFile(t *testing.T) {
+
+	s, err := ReadParamSet(filepath.Join("testdata", "cplex.prm"))
+	if err != nil {
+		t.Fatalf("ReadParamSet failed: %v", err)
+	}
+
+	want := map[int]interface{}{
+		int(ParamDataCheck):      1,
+		int(ParamFeasibilityTol): 1e-7,
+		int(ParamTimeLimit):      60.0,
+		int(ParamWorkDir):        "/tmp/cplex work",
+		int(ParamThreads):        4,
+		int(ParamMipGap):         0.005,
+		int(ParamNodeLimit):      int64(1000),
+	}
+	if !reflect.DeepEqual(s.values, want) {
+		t.Errorf("ReadParamSet = %v, want %v", s.values, want)
+	}
+
+	// Written back, the file starts with the version header.
+	if text := s.String(); !strings.HasPrefix(text, "CPLEX Parameter File Version ") {
+		t.Errorf("String() = %q, want the version header first", text)
+	}
+}
+
+//==============================================================================
+
+func TestParseParamSetErrors(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		text   string
+		line   string      // Line number expected in the error
+	}{
+		{"missing value", "CPXPARAM_Threads", "Line 1"},
+		{"double for int", "CPXPARAM_Threads 4\n\nCPXPARAM_Threads 1.5", "Line 3"},
+		{"text for double", "CPXPARAM_TimeLimit sixty", "Line 1"},
+		{"double for long", "CPXPARAM_MIP_Limits_Nodes 1e3", "Line 1"},
+		{"long out of range", "CPXPARAM_MIP_Limits_Nodes 99999999999999999999", "Line 1"},
+		{"two values", "CPXPARAM_Threads 4 5", "Line 1"},
+		{"unterminated string", "CPXPARAM_WorkDir \"/tmp", "Line 1"},
+	}
+
+	for _, tt := range tests {
+		s, err := ParseParamSet(strings.NewReader(tt.text))
+		if err == nil {
+			t.Errorf("%s: ParseParamSet = %v, want error", tt.name, s)
+			continue
+		}
+		if !strings.Contains(err.Error(), tt.line) {
+			t.Errorf("%s: error %q does not mention %q", tt.name, err, tt.line)
+		}
+	}
+
+	if _, err := ReadParamSet(filepath.Join(t.TempDir(), "missing.prm")); !errors.Is(err, ErrFileOpen) {
+		t.Errorf("ReadParamSet of a missing file returned %v, want %v", err, ErrFileOpen)
+	}
+}
+
+//==============================================================================
+
+func TestParamSetTypes(t *testing.T) {
+
+	s := &ParamSet{}
+
+	tests := []struct {
+		name  string
+		err   error
+	}{
+		{"int as double", s.SetDbl(DblParam(ParamThreads), 1)},
+		{"double as int", s.SetInt(IntParam(ParamTimeLimit), 1)},
+		{"long as int", s.SetInt(IntParam(ParamNodeLimit), 1)},
+		{"string as long", s.SetLong(LongParam(ParamWorkDir), 1)},
+		{"unknown", s.SetInt(IntParam(99999), 1)},
+	}
+
+	for _, tt := range tests {
+		if tt.err == nil {
+			t.Errorf("%s: Set succeeded, want error", tt.name)
+		}
+	}
+
+	if s.Len() != 0 {
+		t.Errorf("Len() = %d after failed Set calls, want 0", s.Len())
+	}
+}
+
+//==============================================================================
+
+func TestParamSetMerge(t *testing.T) {
+
+	base := &ParamSet{}
+	base.SetInt(ParamThreads, 4)
+	base.SetDbl(ParamTimeLimit, 60)
+
+	family := &ParamSet{}
+	family.SetDbl(ParamTimeLimit, 10)
+	family.SetDbl(ParamMipGap, 0.01)
+
+	set := base.Clone()
+	set.Merge(family)
+
+	want := map[int]interface{}{
+		int(ParamThreads):   4,
+		int(ParamTimeLimit): 10.0,
+		int(ParamMipGap):    0.01,
+	}
+	if !reflect.DeepEqual(set.values, want) {
+		t.Errorf("Merge = %v, want %v", set.values, want)
+	}
+
+	// The sets merged are not changed.
+	if value, _ := base.Value(int(ParamTimeLimit)); base.Len() != 2 || value != 60.0 {
+		t.Errorf("base changed to %v", base.values)
+	}
+	if family.Len() != 2 {
+		t.Errorf("family changed to %v", family.values)
+	}
+
+	// A clone does not share its values with the original.
+	set.Delete(int(ParamThreads))
+	if _, ok := base.Value(int(ParamThreads)); !ok {
+		t.Error("Delete on a clone removed the value from the original")
+	}
+
+	empty := &ParamSet{}
+	empty.Merge(&ParamSet{})
+	if empty.Len() != 0 {
+		t.Errorf("Merge of empty sets = %v", empty.values)
+	}
+}
+
+//==============================================================================
+
+func TestParamSetDiff(t *testing.T) {
+
+	s := &ParamSet{}
+	s.SetInt(ParamThreads, 4)
+	s.SetDbl(ParamTimeLimit, 1e75)  // Default value, given explicitly
+	s.SetDbl(ParamMipGap, 0.01)
+
+	other := &ParamSet{}
+	other.SetInt(ParamThreads, 8)
+	other.SetLong(ParamNodeLimit, 100)
+	other.SetDbl(ParamMipGap, 0.01)
+
+	diffs := s.Diff(other)
+
+	want := []struct {
+		name   string
+		value  interface{}
+		other  interface{}
+	}{
+		{"CPXPARAM_Threads", 4, 8},
+		{"CPXPARAM_MIP_Limits_Nodes", int64(9223372036800000000), int64(100)},
+	}
+
+	if len(diffs) != len(want) {
+		t.Fatalf("Diff = %v, want %d differences", diffs, len(want))
+	}
+
+	for i, d := range diffs {
+		if d.Param.Name != want[i].name || d.Value != want[i].value || d.Other != want[i].other {
+			t.Errorf("difference %d = %s %v %v, want %s %v %v", i, d.Param.Name, d.Value, d.Other,
+				want[i].name, want[i].value, want[i].other)
+		}
+	}
+
+	if diffs := s.Diff(s.Clone()); len(diffs) != 0 {
+		t.Errorf("Diff with a clone = %v, want none", diffs)
+	}
+
+	if diffs := (&ParamSet{}).Diff(&ParamSet{}); len(diffs) != 0 {
+		t.Errorf("Diff of empty sets = %v, want none", diffs)
+	}
+}
+
+//==============================================================================
+
+func TestParamSetApply(t *testing.T) {
+
+	env, err := OpenEnv(NewFakeBackend())
+	if err != nil {
+		t.Fatalf("OpenEnv failed: %v", err)
+	}
+
+	s := newTestParamSet(t)
+	if err = env.ApplyParamSet(s); err != nil {
+		t.Fatalf("ApplyParamSet failed: %v", err)
+	}
+
+	var got ParamSet
+	if err = env.GetParamSet(&got); err != nil {
+		t.Fatalf("GetParamSet failed: %v", err)
+	}
+
+	if diffs := got.Diff(s); len(diffs) != 0 || got.Len() != s.Len() {
+		t.Errorf("GetParamSet = %v, want %v", got.values, s.values)
+	}
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,347 @@
+// 01   Oct. 16, 2026   Initial version, solution pool
+// 02   Oct. 16, 2026   The filters are copied here only, not by the backends
+
+package gpx
+
+import (
+	"context"
+	"github.com/pkg/errors"
+)
+
+// InputPoolFilter defines a data structure of a solution pool filter, passed as
+// an input argument to AddPoolFilters. A solution only enters the pool if the
+// value of the filter lies between LowerBound and UpperBound. For a diversity
+// filter (Type "D") the value is
+//   sum(Cols[k].Value * |x[Cols[k].ColIndex] - Cols[k].RefValue|)
+// which, for binary columns and weights of 1, counts the columns which differ
+// from the reference values. For a range filter (Type "R") the value is the
+// linear expression sum(Cols[k].Value * x[Cols[k].ColIndex]).
+type InputPoolFilter struct {
+	Name        string                // Name of the filter
+	Type        string                // "D" for a diversity filter, "R" for a range filter
+	LowerBound  float64               // Lowest value of the filter accepted
+	UpperBound  float64               // Highest value of the filter accepted
+	Cols        []InputPoolFilterCol  // Columns of the filter
+}
+
+// InputPoolFilterCol defines a data structure of a column of a solution pool
+// filter.
+type InputPoolFilterCol struct {
+	ColIndex  int      // Column index of this entry
+	Value     float64  // Weight in a diversity filter, coefficient in a range filter
+	RefValue  float64  // Reference value in a diversity filter, ignored in a range filter
+}
+
+//==============================================================================
+// PROBLEM FUNCTIONS
+//==============================================================================
+
+// Populate generates several integer solutions of the mixed integer problem
+// and keeps them in the solution pool, from which GetPoolSolution retrieves
+// them. It first solves the problem as MipOpt does, unless it has already been
+// solved, and then searches for further solutions until ParamPopulateLimit
+// solutions have been generated or no more exist. ParamPoolCapacity,
+// ParamPoolReplace, ParamPoolRelGap and ParamPoolAbsGap control which solutions
+// the pool keeps, ParamPoolIntensity how hard Populate searches, and the
+// filters added by AddPoolFilters which solutions may enter the pool. The
+// status is then MipOptimalPopulated, MipOptimalPopulatedTol or
+// MipPopulateSolLim, and GetMipSolution still returns the incumbent.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXpopulate.
+func (p *Problem) Populate() error {
+
+	return p.PopulateContext(context.Background())
+}
+
+//==============================================================================
+
+// PopulateContext generates several integer solutions in the same way as
+// Populate, but aborts the search once the context passed into this function
+// is done, as MipOptContext does. The solutions found so far are kept in the
+// pool.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXsetterminate and CPXpopulate.
+func (p *Problem) PopulateContext(ctx context.Context) error {
+
+	return p.optimize(ctx, "Populate", func(ctx context.Context) error {
+		return p.slv.Populate(ctx)
+	})
+}
+
+//==============================================================================
+
+// GetPoolNumSolns obtains the number of solutions in the solution pool, or 0 if
+// the pool is empty or the problem has not yet been defined. MipOpt keeps the
+// integer solutions it finds in the pool, and Populate adds more. At this time
+// the function always returns nil (success).
+// This function uses CPXgetsolnpoolnumsolns.
+func (p *Problem) GetPoolNumSolns(numSolns *int) error {
+
+	*numSolns = 0
+	if p.checkProb() == nil {
+		*numSolns = p.slv.PoolNumSolns()
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// GetPoolSolution obtains the solution with the index specified from the
+// solution pool, in the same form as GetMipSolution: the objective function
+// value, the name and slack of each row, and the name and value of each
+// column. Indices range from 0 to the number of solutions in the pool minus 1.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXgetsolnpoolobjval, CPXgetsolnpoolx and
+// CPXgetsolnpoolslack, and indirectly CPXgetrowname and CPXgetcolname.
+func (p *Problem) GetPoolSolution(index int, objVal *float64, sRows *[]SolnRow, sCols *[]SolnCol) error {
+
+	var err error  // Error returned by the functions called
+
+	if err = p.checkProb(); err != nil {
+		return err
+	}
+
+	// Initialize the return values
+	*sRows  = nil
+	*sCols  = nil
+	*objVal = 0.0
+
+	numSolns := p.slv.PoolNumSolns()
+	if index < 0 || index >= numSolns {
+		return errors.Wrapf(ErrNoSolution, "GetPoolSolution found index %d, pool holds %d solutions",
+			index, numSolns)
+	}
+
+	x     := make([]float64, p.slv.NumCols())
+	slack := make([]float64, p.slv.NumRows())
+
+	obj, err := p.slv.PoolSolution(index, x, slack)
+	if err != nil {
+		return errors.Wrap(err, "GetPoolSolution failed")
+	}
+
+	rows := make([]SolnRow, len(slack))
+	for i := range rows {
+		rows[i].Slack = slack[i]
+	}
+
+	if err = p.GetRowName(rows); err != nil {
+		return errors.Wrap(err, "GetPoolSolution failed to get row names")
+	}
+
+	cols := make([]SolnCol, len(x))
+	for j := range cols {
+		cols[j].Value = x[j]
+	}
+
+	if err = p.GetColName(cols); err != nil {
+		return errors.Wrap(err, "GetPoolSolution failed to get column names")
+	}
+
+	*objVal = obj
+	*sRows  = rows
+	*sCols  = cols
+
+	return nil
+}
+
+//==============================================================================
+
+// AddPoolFilters appends the solution pool filters specified to the problem.
+// The filters apply to the solutions which MipOpt and Populate find from then
+// on; solutions already in the pool are kept.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXaddsolnpooldivfilter and CPXaddsolnpoolrngfilter.
+func (p *Problem) AddPoolFilters(fList []InputPoolFilter) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	if len(fList) < 1 {
+		return errors.Errorf("AddPoolFilters expected more than %d filters", len(fList))
+	}
+
+	numCols := p.slv.NumCols()
+	for i, f := range fList {
+		if f.Type != "D" && f.Type != "R" {
+			return errors.Errorf("AddPoolFilters found type '%s' for filter %d, expected D or R", f.Type, i)
+		}
+
+		if f.LowerBound > f.UpperBound {
+			return errors.Errorf("AddPoolFilters found lower bound %g above upper bound %g for filter %d",
+				f.LowerBound, f.UpperBound, i)
+		}
+
+		if len(f.Cols) < 1 {
+			return errors.Errorf("AddPoolFilters found no columns for filter %d", i)
+		}
+
+		seen := make(map[int]bool, len(f.Cols))
+		for _, c := range f.Cols {
+			if c.ColIndex < 0 || c.ColIndex >= numCols {
+				return errors.Errorf("AddPoolFilters found column %d in filter %d, expected 0 to %d",
+					c.ColIndex, i, numCols - 1)
+			}
+			if seen[c.ColIndex] {
+				return errors.Errorf("AddPoolFilters found column %d twice in filter %d", c.ColIndex, i)
+			}
+			seen[c.ColIndex] = true
+		}
+	}
+
+	return p.slv.AddPoolFilters(copyPoolFilters(fList))
+}
+
+//==============================================================================
+
+// GetPoolNumFilters obtains the number of solution pool filters of the current
+// problem, or 0 if none exist or the problem has not yet been defined. At this
+// time the function always returns nil (success).
+// This function uses CPXgetsolnpoolnumfilters.
+func (p *Problem) GetPoolNumFilters(numFilters *int) error {
+
+	*numFilters = 0
+	if p.checkProb() == nil {
+		*numFilters = p.slv.NumPoolFilters()
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// DelPoolFilters deletes the solution pool filters from begin to end, both
+// included. The filters after end move down to fill the gap.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXdelsolnpoolfilters.
+func (p *Problem) DelPoolFilters(begin, end int) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	if _, err := delRange("DelPoolFilters", p.slv.NumPoolFilters(), begin, end); err != nil {
+		return err
+	}
+
+	return p.slv.DelPoolFilters(begin, end)
+}
+
+//==============================================================================
+
+// copyPoolFilters returns a copy of the solution pool filters, each with its
+// own Cols slice.
+func copyPoolFilters(fList []InputPoolFilter) []InputPoolFilter {
+
+	copied := make([]InputPoolFilter, len(fList))
+	for i, f := range fList {
+		f.Cols    = append([]InputPoolFilterCol(nil), f.Cols...)
+		copied[i] = f
+	}
+
+	return copied
+}
+
+//==============================================================================
+// PACKAGE-LEVEL FUNCTIONS
+//==============================================================================
+
+// Populate generates several integer solutions of the default problem. See
+// Problem.Populate.
+func Populate() error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.Populate()
+}
+
+//==============================================================================
+
+// PopulateContext generates several integer solutions of the default problem,
+// aborting once ctx is done. See Problem.PopulateContext.
+func PopulateContext(ctx context.Context) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.PopulateContext(ctx)
+}
+
+//==============================================================================
+
+// GetPoolNumSolns obtains the number of solutions in the solution pool of the
+// default problem. See Problem.GetPoolNumSolns.
+func GetPoolNumSolns(numSolns *int) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetPoolNumSolns(numSolns)
+}
+
+//==============================================================================
+
+// GetPoolSolution obtains a solution from the solution pool of the default
+// problem. See Problem.GetPoolSolution.
+func GetPoolSolution(index int, objVal *float64, sRows *[]SolnRow, sCols *[]SolnCol) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetPoolSolution(index, objVal, sRows, sCols)
+}
+
+//==============================================================================
+
+// AddPoolFilters appends solution pool filters to the default problem. See
+// Problem.AddPoolFilters.
+func AddPoolFilters(fList []InputPoolFilter) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.AddPoolFilters(fList)
+}
+
+//==============================================================================
+
+// GetPoolNumFilters obtains the number of solution pool filters of the default
+// problem. See Problem.GetPoolNumFilters.
+func GetPoolNumFilters(numFilters *int) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetPoolNumFilters(numFilters)
+}
+
+//==============================================================================
+
+// DelPoolFilters deletes a range of solution pool filters of the default
+// problem. See Problem.DelPoolFilters.
+func DelPoolFilters(begin, end int) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.DelPoolFilters(begin, end)
+}
+
+//============================ END OF FILE =====================================
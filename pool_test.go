@@ -0,0 +1,267 @@
+package gpx
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// poolModel is the knapsack problem
+//   max 5a + 4b + 3c + 2d  st  2a + 3b + c + 2d <= 5, a, b, c, d binary
+// whose feasible solutions have the objective values 10, 9, 8, 7, 7, 6, 5, 5,
+// 4, 3, 2 and 0.
+func poolModel() testModel {
+
+	return testModel{
+		name:  "pool",
+		rows:  []InputRow{{"weight", "L", 5, 0}},
+		obj:   []InputObjCoef{{0, 5}, {1, 4}, {2, 3}, {3, 2}},
+		cols:  []InputCol{{"a", "B", 0, 1}, {"b", "B", 0, 1}, {"c", "B", 0, 1}, {"d", "B", 0, 1}},
+		elems: []InputElem{{0, 0, 2}, {0, 1, 3}, {0, 2, 1}, {0, 3, 2}},
+		sense: -1,
+	}
+}
+
+//==============================================================================
+
+// poolSolutions returns the objective values of the solutions in the pool,
+// from best to worst, and their column values in the same order.
+func poolSolutions(t *testing.T, prob *Problem) ([]float64, [][]float64) {
+
+	t.Helper()
+
+	var numSolns int
+	if err := prob.GetPoolNumSolns(&numSolns); err != nil {
+		t.Fatalf("GetPoolNumSolns failed: %v", err)
+	}
+
+	objVals := make([]float64, numSolns)
+	xs      := make([][]float64, numSolns)
+	for i := range objVals {
+		var sRows []SolnRow
+		var sCols []SolnCol
+		if err := prob.GetPoolSolution(i, &objVals[i], &sRows, &sCols); err != nil {
+			t.Fatalf("GetPoolSolution(%d) failed: %v", i, err)
+		}
+		for _, c := range sCols {
+			xs[i] = append(xs[i], c.Value)
+		}
+	}
+
+	sort.Sort(poolOrder{objVals, xs})
+
+	return objVals, xs
+}
+
+// poolOrder sorts pool solutions by decreasing objective value.
+type poolOrder struct {
+	objVals  []float64
+	xs       [][]float64
+}
+
+func (o poolOrder) Len() int           { return len(o.objVals) }
+func (o poolOrder) Less(i, j int) bool { return o.objVals[i] > o.objVals[j] }
+func (o poolOrder) Swap(i, j int) {
+	o.objVals[i], o.objVals[j] = o.objVals[j], o.objVals[i]
+	o.xs[i], o.xs[j]           = o.xs[j], o.xs[i]
+}
+
+//==============================================================================
+
+func TestTrimPool(t *testing.T) {
+
+	soln := func(objVals ...float64) []mipPoolSoln {
+		pool := make([]mipPoolSoln, len(objVals))
+		for i, v := range objVals {
+			pool[i] = mipPoolSoln{objVal: v}
+		}
+		return pool
+	}
+
+	tests := []struct {
+		name    string
+		sense   int
+		objVal  float64        // Incumbent, none if NaN
+		absGap  float64
+		relGap  float64
+		pool    []mipPoolSoln
+		want    []mipPoolSoln
+	}{
+		{"min absolute", 1, 10, 1, 1e75, soln(12, 10, 10.5, 20, 11), soln(10, 10.5, 11)},
+		{"min relative", 1, 10, 1e75, 0.1, soln(12, 10, 10.5, 20, 11), soln(10, 10.5, 11)},
+		{"smaller gap applies", 1, 10, 5, 0.05, soln(12, 10, 10.5, 20, 11), soln(10, 10.5)},
+		{"max absolute", -1, 10, 1, 1e75, soln(8, 9, 10, 9.5), soln(9, 10, 9.5)},
+		{"max relative", -1, -10, 1e75, 0.2, soln(-10, -11, -12, -13), soln(-10, -11, -12)},
+		{"zero gaps", 1, 10, 0, 0, soln(10.1, 10, 10 + 1e-9), soln(10, 10 + 1e-9)},
+		{"negative incumbent", 1, -4, 1e75, 0.5, soln(-4, -2, -3, 0), soln(-4, -2, -3)},
+		{"no incumbent", 1, math.NaN(), 0, 0, soln(12, 10), soln(12, 10)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			res := &mipResult{objVal: tt.objVal, x: []float64{0}, pool: tt.pool}
+			if math.IsNaN(tt.objVal) {
+				res.x = nil
+			}
+
+			opt := mipTestOptions()
+			opt.poolAbsGap = tt.absGap
+			opt.poolRelGap = tt.relGap
+
+			res.trimPool(tt.sense, opt)
+			if !reflect.DeepEqual(res.pool, tt.want) {
+				t.Errorf("pool = %v, want %v", res.pool, tt.want)
+			}
+		})
+	}
+}
+
+//==============================================================================
+
+func TestPopulateGaps(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		absGap  float64
+		relGap  float64
+		want    []float64   // Objective values of the pool
+	}{
+		{"no gap", 0, 1e75, []float64{10}},
+		{"absolute", 2, 1e75, []float64{10, 9, 8}},
+		{"relative", 1e75, 0.35, []float64{10, 9, 8, 7, 7}},
+		{"smaller gap applies", 3, 0.1, []float64{10, 9}},
+		{"all solutions", 1e75, 1e75, []float64{10, 9, 8, 7, 7, 6, 5, 5, 4, 3, 2, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			env, prob := newTestProb(t, NewGoBackend(), poolModel())
+			for _, err := range []error{
+				env.SetDblParam(ParamPoolAbsGap, tt.absGap),
+				env.SetDblParam(ParamPoolRelGap, tt.relGap),
+				env.SetIntParam(ParamPopulateLimit, 100),
+			} {
+				if err != nil {
+					t.Fatalf("Set failed: %v", err)
+				}
+			}
+
+			if err := prob.Populate(); err != nil {
+				t.Fatalf("Populate failed: %v", err)
+			}
+
+			objVals, _ := poolSolutions(t, prob)
+			if !reflect.DeepEqual(objVals, tt.want) {
+				t.Errorf("pool objectives = %v, want %v", objVals, tt.want)
+			}
+
+			var objVal float64
+			var sRows []SolnRow
+			var sCols []SolnCol
+			if err := prob.GetMipSolution(&objVal, &sRows, &sCols); err != nil || objVal != 10 {
+				t.Errorf("GetMipSolution = %g, %v, want 10", objVal, err)
+			}
+		})
+	}
+}
+
+//==============================================================================
+
+// TestPoolFiltersDelSetCols deletes the column a, and checks that the filters
+// refer to the columns b, c and d at their new indices, both in the model and
+// in the solutions Populate keeps.
+func TestPoolFiltersDelSetCols(t *testing.T) {
+
+	env, prob := newTestProb(t, NewGoBackend(), poolModel())
+	if err := env.SetIntParam(ParamPopulateLimit, 100); err != nil {
+		t.Fatalf("SetIntParam failed: %v", err)
+	}
+
+	filters := []InputPoolFilter{
+		{"only a", "R", 0, 0, []InputPoolFilterCol{{0, 1, 0}}},
+		{"not b and d", "R", 0, 1, []InputPoolFilterCol{{1, 1, 0}, {3, 1, 0}}},
+		{"c set", "D", 0, 0, []InputPoolFilterCol{{2, 1, 1}}},
+	}
+	if err := prob.AddPoolFilters(filters); err != nil {
+		t.Fatalf("AddPoolFilters failed: %v", err)
+	}
+
+	delStat, err := prob.DelSetCols([]int{0})
+	if err != nil {
+		t.Fatalf("DelSetCols failed: %v", err)
+	}
+	if want := []int{-1, 0, 1, 2}; !reflect.DeepEqual(delStat, want) {
+		t.Errorf("DelSetCols = %v, want %v", delStat, want)
+	}
+
+	// The filter of a alone is kept without columns, and accepts every
+	// solution.
+	want := []InputPoolFilter{
+		{"only a", "R", 0, 0, []InputPoolFilterCol{}},
+		{"not b and d", "R", 0, 1, []InputPoolFilterCol{{0, 1, 0}, {2, 1, 0}}},
+		{"c set", "D", 0, 0, []InputPoolFilterCol{{1, 1, 1}}},
+	}
+	if got := prob.slv.(*goProb).filters; !reflect.DeepEqual(got, want) {
+		t.Errorf("filters = %+v, want %+v", got, want)
+	}
+
+	var numFilters int
+	if prob.GetPoolNumFilters(&numFilters); numFilters != len(want) {
+		t.Errorf("GetPoolNumFilters = %d, want %d", numFilters, len(want))
+	}
+
+	if err = prob.Populate(); err != nil {
+		t.Fatalf("Populate failed: %v", err)
+	}
+
+	// Of the solutions of max 4b + 3c + 2d st 3b + c + 2d <= 5, those with c
+	// set and not both b and d are bc, cd and c.
+	objVals, xs := poolSolutions(t, prob)
+	if wantObj := []float64{7, 5, 3}; !reflect.DeepEqual(objVals, wantObj) {
+		t.Errorf("pool objectives = %v, want %v", objVals, wantObj)
+	}
+	for i, x := range xs {
+		if len(x) != 3 || x[1] != 1 || x[0] + x[2] > 1 {
+			t.Errorf("pool solution %d = %v rejected by the filters", i, x)
+		}
+	}
+}
+
+//==============================================================================
+
+// TestAddPoolFiltersCopy checks that the backend keeps the filters as they
+// were passed, whether the caller changes its slices afterwards or columns are
+// deleted from the problem.
+func TestAddPoolFiltersCopy(t *testing.T) {
+
+	fake := NewFakeBackend()
+	_, prob := newTestProb(t, fake, poolModel())
+
+	fList := []InputPoolFilter{{"f", "D", 0, 2, []InputPoolFilterCol{{0, 1, 1}, {2, 1, 0}}}}
+	want  := []InputPoolFilter{{"f", "D", 0, 2, []InputPoolFilterCol{{0, 1, 1}, {2, 1, 0}}}}
+
+	if err := prob.AddPoolFilters(fList); err != nil {
+		t.Fatalf("AddPoolFilters failed: %v", err)
+	}
+	fList[0].Cols[1].Value = 7
+
+	if _, err := prob.DelCols(0, 0); err != nil {
+		t.Fatalf("DelCols failed: %v", err)
+	}
+
+	for _, c := range fake.Calls() {
+		if c.Op == "AddPoolFilters" && !reflect.DeepEqual(c.Args[0], want) {
+			t.Errorf("AddPoolFilters recorded %+v, want %+v", c.Args[0], want)
+		}
+	}
+
+	wantModel := []InputPoolFilter{{"f", "D", 0, 2, []InputPoolFilterCol{{1, 1, 0}}}}
+	if got := prob.slv.(*fakeProb).filters; !reflect.DeepEqual(got, wantModel) {
+		t.Errorf("filters = %+v, want %+v", got, wantModel)
+	}
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,190 @@
+// 01   Oct. 16, 2026   Initial version, progress events during LpOpt and MipOpt
+// 02   Oct. 16, 2026   Throttling state guarded by a mutex
+
+package gpx
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// Progress reports the state of an optimizer while it is running. For LPs only
+// Elapsed, Iterations and Objective are set; for MIPs all fields except
+// Objective are set. Objective values refer to the objective in its original
+// sense.
+type Progress struct {
+	MIP           bool           // Flag set if the event comes from MipOpt
+	Elapsed       time.Duration  // Time since the start of the solve
+	Iterations    int64          // Simplex iterations performed so far
+	Objective     float64        // Current objective value of the LP optimizer
+	Nodes         int64          // Branch and bound nodes processed so far
+	HasIncumbent  bool           // Flag set if an integer solution has been found
+	BestInteger   float64        // Objective value of the best integer solution, if any
+	BestBound     float64        // Best bound on the optimal objective value
+	RelGap        float64        // Relative gap as reported by GetMipRelGap, +Inf if no incumbent
+}
+
+// ProgressFunc receives the progress events of a problem. It is called on the
+// thread running the optimizer, which waits for it to return, so it should be
+// quick.
+type ProgressFunc func(Progress)
+
+// problemProgress holds the progress destination of a problem.
+type problemProgress struct {
+	fn        ProgressFunc    // Destination of the events, nil if none
+	interval  time.Duration   // Minimum time between two events
+}
+
+//==============================================================================
+
+// SetProgressFunc registers a function which receives progress events while the
+// problem is solved by LpOpt, MipOpt or their Context variants. Events are
+// delivered at most once per interval, except that a MIP event is always
+// delivered when a new integer solution has been found; an interval of zero
+// delivers every event the optimizer produces. Passing nil removes the
+// function.
+// Cplex callbacks are installed in the environment for the duration of each
+// solve, so that problems of the same environment may have different
+// functions. This function uses CPXsetinfocallbackfunc and CPXsetlpcallbackfunc
+// when the problem is solved.
+func (p *Problem) SetProgressFunc(fn ProgressFunc, interval time.Duration) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	p.progress = problemProgress{fn: fn, interval: interval}
+
+	return nil
+}
+
+//==============================================================================
+
+// SetProgressChan sends the progress events of the problem on the channel
+// passed into this function, as SetProgressFunc does. Events are sent without
+// blocking, and are dropped while the channel is full, so that the optimizer is
+// never held up by a slow reader. The channel is not closed by gpx. Passing nil
+// removes the channel.
+func (p *Problem) SetProgressChan(ch chan<- Progress, interval time.Duration) error {
+
+	if ch == nil {
+		return p.SetProgressFunc(nil, 0)
+	}
+
+	return p.SetProgressFunc(func(pr Progress) {
+		select {
+		case ch <- pr:
+		default:
+		}
+	}, interval)
+}
+
+//==============================================================================
+
+// progressScope installs the progress function of the problem in the backend
+// for the duration of a solve. It returns a function which removes it, to be
+// deferred by the caller. Events are throttled to the interval of the problem
+// and stamped with the time elapsed since the call to progressScope, and the
+// relative gap of MIP events is computed from the best integer and bound.
+// Events may come from several solver threads at once, and are delivered one
+// at a time.
+// In case the backend fails to install the function, it returns an error.
+func (p *Problem) progressScope() (func(), error) {
+	var mu        sync.Mutex     // Serializes the events
+	var last      time.Duration  // Elapsed time at the last event delivered
+	var lastInc   float64        // Best integer value at the last event delivered
+	var sent      bool           // Flag set once an event has been delivered
+	var sentInc   bool           // Flag set once an event with an incumbent has been delivered
+
+	fn, interval := p.progress.fn, p.progress.interval
+	if fn == nil {
+		return func() {}, nil
+	}
+
+	start := time.Now()
+	throttled := func(pr Progress) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		pr.Elapsed = time.Since(start)
+		if pr.MIP {
+			pr.RelGap = math.Inf(1)
+			if pr.HasIncumbent {
+				pr.RelGap = mipRelGap(pr.BestInteger, pr.BestBound)
+			}
+		}
+
+		newInc := pr.HasIncumbent && (!sentInc || pr.BestInteger != lastInc)
+		if sent && !newInc && pr.Elapsed - last < interval {
+			return
+		}
+
+		sent, last = true, pr.Elapsed
+		if pr.HasIncumbent {
+			sentInc, lastInc = true, pr.BestInteger
+		}
+		fn(pr)
+	}
+
+	if err := p.env.drv.SetProgressFunc(throttled); err != nil {
+		return nil, err
+	}
+
+	return func() {
+		_ = p.env.drv.SetProgressFunc(nil)
+	}, nil
+}
+
+//==============================================================================
+
+// beginSolve prepares the environment for solving the problem: solver messages
+// are tagged with the name of the problem, and its progress function is
+// installed. It returns a function which restores the environment, to be
+// deferred by the caller.
+// In case of failure, it returns an error.
+func (p *Problem) beginSolve() (func(), error) {
+
+	endLog := p.logScope()
+
+	endProgress, err := p.progressScope()
+	if err != nil {
+		endLog()
+		return nil, err
+	}
+
+	return func() {
+		endProgress()
+		endLog()
+	}, nil
+}
+
+//==============================================================================
+
+// SetProgressFunc registers a progress function for the default problem. See
+// Problem.SetProgressFunc.
+func SetProgressFunc(fn ProgressFunc, interval time.Duration) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.SetProgressFunc(fn, interval)
+}
+
+//==============================================================================
+
+// SetProgressChan sends the progress events of the default problem on a
+// channel. See Problem.SetProgressChan.
+func SetProgressChan(ch chan<- Progress, interval time.Duration) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.SetProgressChan(ch, interval)
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,120 @@
+package gpx
+
+import (
+	"math"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestProgressThrottling(t *testing.T) {
+
+	lp  := func(iter int64) Progress { return Progress{Iterations: iter} }
+	mip := func(nodes int64, inc float64) Progress {
+		return Progress{MIP: true, Nodes: nodes, HasIncumbent: !math.IsNaN(inc), BestInteger: inc, BestBound: 12}
+	}
+	none := math.NaN()
+
+	tests := []struct {
+		name      string
+		interval  time.Duration
+		events    []Progress
+		want      []int64     // Iterations or nodes of the events delivered
+	}{
+		{"lp every event", 0, []Progress{lp(1), lp(2), lp(3)}, []int64{1, 2, 3}},
+		{"lp throttled", time.Hour, []Progress{lp(1), lp(2), lp(3)}, []int64{1}},
+		{"mip every event", 0, []Progress{mip(1, none), mip(2, 10), mip(3, 10)}, []int64{1, 2, 3}},
+		{"mip new incumbents", time.Hour,
+			[]Progress{mip(1, none), mip(2, none), mip(3, 10), mip(4, 10), mip(5, 11), mip(6, 11)},
+			[]int64{1, 3, 5}},
+		{"mip first incumbent", time.Hour, []Progress{mip(1, 10), mip(2, 10)}, []int64{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			fake := NewFakeBackend()
+			fake.Solutions = []FakeSolution{{Progress: tt.events}}
+			_, prob := newTestProb(t, fake, fakeModel("I"))
+
+			var got []Progress
+			if err := prob.SetProgressFunc(func(pr Progress) { got = append(got, pr) }, tt.interval); err != nil {
+				t.Fatalf("SetProgressFunc failed: %v", err)
+			}
+			if err := prob.MipOpt(); err != nil {
+				t.Fatalf("MipOpt failed: %v", err)
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("%d events delivered, want %d: %+v", len(got), len(tt.want), got)
+			}
+			for i, pr := range got {
+				if pr.Iterations + pr.Nodes != tt.want[i] {
+					t.Errorf("event %d = %+v, want %d", i, pr, tt.want[i])
+				}
+				if i > 0 && pr.Elapsed < got[i - 1].Elapsed {
+					t.Errorf("event %d elapsed %v before event %d", i, pr.Elapsed, i - 1)
+				}
+
+				wantGap := 0.0
+				switch {
+				case pr.MIP && pr.HasIncumbent:
+					wantGap = mipRelGap(pr.BestInteger, pr.BestBound)
+				case pr.MIP:
+					wantGap = math.Inf(1)
+				}
+				if pr.RelGap != wantGap {
+					t.Errorf("event %d gap = %g, want %g", i, pr.RelGap, wantGap)
+				}
+			}
+		})
+	}
+}
+
+//==============================================================================
+
+// TestProgressConcurrent sends events from several goroutines, as Cplex does
+// from its threads, and checks that they reach the function one at a time.
+func TestProgressConcurrent(t *testing.T) {
+
+	_, prob := newTestProb(t, NewFakeBackend(), fakeModel("I"))
+
+	var inside int32
+	var delivered int
+	if err := prob.SetProgressFunc(func(pr Progress) {
+		if atomic.AddInt32(&inside, 1) != 1 {
+			t.Error("progress function called concurrently")
+		}
+		delivered++
+		atomic.AddInt32(&inside, -1)
+	}, 0); err != nil {
+		t.Fatalf("SetProgressFunc failed: %v", err)
+	}
+
+	end, err := prob.progressScope()
+	if err != nil {
+		t.Fatalf("progressScope failed: %v", err)
+	}
+	defer end()
+
+	send := prob.env.drv.(*fakeEnv).progressFn
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for k := 0; k < 100; k++ {
+				send(Progress{MIP: true, Nodes: int64(k), HasIncumbent: true, BestInteger: float64(g)})
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if delivered != 800 {
+		t.Errorf("%d events delivered, want 800", delivered)
+	}
+}
+
+//============================ END OF FILE =====================================
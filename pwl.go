@@ -0,0 +1,440 @@
+// 01   Oct. 16, 2026   Initial version, piecewise linear and general constraints
+// 02   Oct. 16, 2026   AddGenConstr removes what it added when it fails
+// 03   Oct. 16, 2026   The constraints are copied here only, not by the backends
+
+package gpx
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+)
+
+// InputPwl defines a data structure of a piecewise linear constraint
+// y = f(x), passed as an input argument to AddPwl and returned by GetPwl. The
+// function f goes through the breakpoints, which are ordered by X, and
+// continues with PreSlope before the first breakpoint and PostSlope after the
+// last one. Two consecutive breakpoints with the same X define a jump.
+type InputPwl struct {
+	Name       string           // Name of the constraint
+	YCol       int              // Index of the column y
+	XCol       int              // Index of the column x
+	PreSlope   float64          // Slope of f before the first breakpoint
+	PostSlope  float64          // Slope of f after the last breakpoint
+	Breaks     []InputPwlBreak  // Breakpoints of f, ordered by X
+}
+
+// InputPwlBreak defines a data structure of a breakpoint of a piecewise linear
+// function.
+type InputPwlBreak struct {
+	X  float64  // Value of x at the breakpoint
+	Y  float64  // Value of f(x) at the breakpoint
+}
+
+// InputGenConstr defines a data structure of a general constraint, passed as
+// an input argument to AddGenConstr. The column ResCol is set to a function of
+// the columns Cols, depending on the type:
+//   "ABS"   ResCol = |Cols[0]|, Cols holding exactly one column
+//   "MIN"   ResCol = min(Cols)
+//   "MAX"   ResCol = max(Cols)
+//   "AND"   ResCol = Cols[0] and Cols[1] and ..., all columns binary
+//   "OR"    ResCol = Cols[0] or Cols[1] or ..., all columns binary
+type InputGenConstr struct {
+	Name    string  // Name of the constraint
+	Type    string  // Type of the constraint, "ABS", "MIN", "MAX", "AND" or "OR"
+	ResCol  int     // Index of the column holding the result
+	Cols    []int   // Indices of the operand columns
+}
+
+//==============================================================================
+// PROBLEM FUNCTIONS
+//==============================================================================
+
+// AddPwl appends the piecewise linear constraints specified to the problem,
+// which becomes a MIP. The columns x and y must be different.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXaddpwl, and CPXchgprobtype if the problem is an LP or QP.
+func (p *Problem) AddPwl(pList []InputPwl) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	if len(pList) < 1 {
+		return errors.Errorf("AddPwl expected more than %d constraints", len(pList))
+	}
+
+	numCols := p.slv.NumCols()
+	for i, pwl := range pList {
+		for _, col := range []int{pwl.YCol, pwl.XCol} {
+			if col < 0 || col >= numCols {
+				return errors.Errorf("AddPwl found column %d for constraint %d, expected 0 to %d",
+					col, i, numCols - 1)
+			}
+		}
+		if pwl.YCol == pwl.XCol {
+			return errors.Errorf("AddPwl found column %d as both x and y of constraint %d", pwl.XCol, i)
+		}
+
+		if len(pwl.Breaks) < 1 {
+			return errors.Errorf("AddPwl found no breakpoints for constraint %d", i)
+		}
+		for k := 1; k < len(pwl.Breaks); k++ {
+			if pwl.Breaks[k].X < pwl.Breaks[k - 1].X {
+				return errors.Errorf("AddPwl found breakpoint %d of constraint %d out of order", k, i)
+			}
+			if k > 1 && pwl.Breaks[k].X == pwl.Breaks[k - 2].X {
+				return errors.Errorf("AddPwl found three breakpoints at x = %g for constraint %d",
+					pwl.Breaks[k].X, i)
+			}
+		}
+	}
+
+	return p.slv.AddPwl(copyPwl(pList))
+}
+
+//==============================================================================
+
+// GetPwl obtains all piecewise linear constraints of the problem, and
+// populates the slice passed to the function with one entry per constraint, in
+// the order in which they were added, as expected by AddPwl. Constraints added
+// by AddGenConstr for "ABS" are included.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXgetnumpwl, CPXgetpwl and CPXgetpwlname.
+func (p *Problem) GetPwl(pList *[]InputPwl) error {
+
+	*pList = nil
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	pwls := make([]InputPwl, p.slv.NumPwl())
+	if err := p.slv.Pwl(pwls); err != nil {
+		return errors.Wrap(err, "GetPwl failed")
+	}
+
+	names, err := p.slv.PwlNames()
+	if err != nil {
+		return errors.Wrap(err, "GetPwl failed to get constraint names")
+	}
+
+	for i := range pwls {
+		pwls[i].Name = names[i]
+	}
+	*pList = pwls
+
+	return nil
+}
+
+//==============================================================================
+
+// GetNumPwl obtains the number of piecewise linear constraints in the current
+// problem, or 0 if none exist or the problem has not yet been defined. At this
+// time the function always returns nil (success).
+// This function uses CPXgetnumpwl.
+func (p *Problem) GetNumPwl(numPwl *int) error {
+
+	*numPwl = 0
+	if p.checkProb() == nil {
+		*numPwl = p.slv.NumPwl()
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// AddGenConstr appends the general constraints specified to the problem. The
+// Cplex callable library has no functions for these constraints, so each one
+// is added with the usual formulation:
+//   "ABS"       a piecewise linear constraint with slopes -1 and 1 around 0
+//   "AND", "OR" one row per operand, and one row bounding the result by the
+//               sum of the operands
+//   "MIN","MAX" one row and one new binary column per operand, one indicator
+//               constraint per binary column, and one row selecting exactly
+//               one of them
+// The rows, columns and constraints created are named after the constraint,
+// followed by "_" and a number, and are appended after the existing ones. The
+// result column must not be one of the operands. The Go backend solves the rows
+// of "AND" and "OR" only: "ABS", "MIN" and "MAX" need Cplex, since MipOpt of
+// the Go backend refuses indicator and piecewise linear constraints.
+// In case of failure, the rows, columns and constraints already added for the
+// list are deleted again, and it returns an error including the error code it
+// received from Cplex. Cplex leaves the problem a MIP.
+// This function uses CPXaddpwl, CPXaddrows, CPXnewcols and CPXaddindconstr, and
+// CPXdelpwl, CPXdelindconstrs, CPXdelrows and CPXdelcols in case of failure.
+func (p *Problem) AddGenConstr(gList []InputGenConstr) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	if len(gList) < 1 {
+		return errors.Errorf("AddGenConstr expected more than %d constraints", len(gList))
+	}
+
+	cols := make([]InputCol, p.slv.NumCols())
+	if err := p.slv.Cols(cols); err != nil {
+		return errors.Wrap(err, "AddGenConstr failed to get column types")
+	}
+
+	for i, g := range gList {
+		switch g.Type {
+		case "ABS", "MIN", "MAX", "AND", "OR":
+		default:
+			return errors.Errorf("AddGenConstr found type '%s' for constraint %d, expected ABS, MIN, MAX, AND or OR",
+				g.Type, i)
+		}
+
+		if len(g.Cols) < 1 || (g.Type == "ABS" && len(g.Cols) != 1) {
+			return errors.Errorf("AddGenConstr found %d operands for constraint %d", len(g.Cols), i)
+		}
+
+		logical := g.Type == "AND" || g.Type == "OR"
+		seen    := make(map[int]bool, len(g.Cols) + 1)
+		for _, col := range append([]int{g.ResCol}, g.Cols...) {
+			if col < 0 || col >= len(cols) {
+				return errors.Errorf("AddGenConstr found column %d for constraint %d, expected 0 to %d",
+					col, i, len(cols) - 1)
+			}
+			if seen[col] {
+				return errors.Errorf("AddGenConstr found column %d twice in constraint %d", col, i)
+			}
+			if logical && cols[col].Type != "B" {
+				return errors.Errorf("AddGenConstr found type '%s' for column %d of constraint %d, expected B",
+					cols[col].Type, col, i)
+			}
+			seen[col] = true
+		}
+	}
+
+	numRows := p.slv.NumRows()
+	numInds := p.slv.NumIndConstrs()
+	numPwl  := p.slv.NumPwl()
+
+	for _, g := range gList {
+		var err error
+
+		switch g.Type {
+		case "ABS":
+			err = p.slv.AddPwl([]InputPwl{{Name: g.Name, YCol: g.ResCol, XCol: g.Cols[0], PreSlope: -1,
+				PostSlope: 1, Breaks: []InputPwlBreak{{X: 0, Y: 0}}}})
+		case "AND":
+			err = p.addLogical(g, "L", "G", 1 - float64(len(g.Cols)))
+		case "OR":
+			err = p.addLogical(g, "G", "L", 0)
+		case "MIN":
+			err = p.addExtremum(g, "L", "G")
+		case "MAX":
+			err = p.addExtremum(g, "G", "L")
+		}
+		if err != nil {
+			err = errors.Wrapf(err, "AddGenConstr failed for constraint '%s'", g.Name)
+			if rbErr := p.delGenConstr(len(cols), numRows, numInds, numPwl); rbErr != nil {
+				return errors.Wrapf(err, "AddGenConstr failed to remove the constraints added (%v)", rbErr)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// delGenConstr deletes the piecewise linear constraints, indicator
+// constraints, rows and columns beyond the numbers given, which AddGenConstr
+// recorded before adding anything.
+func (p *Problem) delGenConstr(numCols, numRows, numInds, numPwl int) error {
+
+	if n := p.slv.NumPwl(); n > numPwl {
+		if err := p.slv.DelPwl(numPwl, n - 1); err != nil {
+			return err
+		}
+	}
+
+	if n := p.slv.NumIndConstrs(); n > numInds {
+		if err := p.slv.DelIndConstrs(numInds, n - 1); err != nil {
+			return err
+		}
+	}
+
+	if n := p.slv.NumRows(); n > numRows {
+		if err := p.slv.DelRows(numRows, n - 1); err != nil {
+			return err
+		}
+	}
+
+	if n := p.slv.NumCols(); n > numCols {
+		if err := p.slv.DelCols(numCols, n - 1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// addLogical adds the rows of an "AND" or "OR" constraint: ResCol - Cols[k]
+// with the sense opSense for each operand, and ResCol - sum(Cols) with the sense
+// sumSense and the right hand side sumRhs.
+func (p *Problem) addLogical(g InputGenConstr, opSense string, sumSense string, sumRhs float64) error {
+
+	n     := len(g.Cols)
+	rList := make([]InputRow, n + 1)
+	rMat  := InputMatrix{Beg: make([]int, n + 1)}
+
+	for k, col := range g.Cols {
+		rList[k]    = InputRow{Name: genName(g.Name, k), Sense: opSense}
+		rMat.Beg[k] = len(rMat.Ind)
+		rMat.Ind    = append(rMat.Ind, g.ResCol, col)
+		rMat.Val    = append(rMat.Val, 1, -1)
+	}
+
+	rList[n]    = InputRow{Name: genName(g.Name, n), Sense: sumSense, Rhs: sumRhs}
+	rMat.Beg[n] = len(rMat.Ind)
+	rMat.Ind    = append(rMat.Ind, g.ResCol)
+	rMat.Val    = append(rMat.Val, 1)
+	for _, col := range g.Cols {
+		rMat.Ind = append(rMat.Ind, col)
+		rMat.Val = append(rMat.Val, -1)
+	}
+
+	return p.slv.AddRows(rList, rMat)
+}
+
+//==============================================================================
+
+// addExtremum adds a "MIN" or "MAX" constraint: a row ResCol - Cols[k] with the
+// sense bound for each operand, a binary column z[k] for each operand, an
+// indicator constraint forcing ResCol - Cols[k] with the sense tight when z[k]
+// is 1, and a row setting the sum of the binary columns to 1.
+func (p *Problem) addExtremum(g InputGenConstr, bound string, tight string) error {
+
+	n     := len(g.Cols)
+	first := p.slv.NumCols()
+
+	zList := make([]InputCol, n)
+	for k := range zList {
+		zList[k] = InputCol{Name: genName(g.Name, k), Type: "B", BndLo: 0, BndUp: 1}
+	}
+	if err := p.slv.NewCols(nil, zList); err != nil {
+		return err
+	}
+
+	rList := make([]InputRow, n + 1)
+	rMat  := InputMatrix{Beg: make([]int, n + 1)}
+	iList := make([]InputIndicator, n)
+
+	for k, col := range g.Cols {
+		rList[k]    = InputRow{Name: genName(g.Name, k), Sense: bound}
+		rMat.Beg[k] = len(rMat.Ind)
+		rMat.Ind    = append(rMat.Ind, g.ResCol, col)
+		rMat.Val    = append(rMat.Val, 1, -1)
+
+		iList[k] = InputIndicator{Name: genName(g.Name, k), ColIndex: first + k, ActiveValue: 1,
+			Lin: []InputLinCoef{{ColIndex: g.ResCol, Value: 1}, {ColIndex: col, Value: -1}}, Sense: tight}
+	}
+
+	rList[n]    = InputRow{Name: genName(g.Name, n), Sense: "E", Rhs: 1}
+	rMat.Beg[n] = len(rMat.Ind)
+	for k := 0; k < n; k++ {
+		rMat.Ind = append(rMat.Ind, first + k)
+		rMat.Val = append(rMat.Val, 1)
+	}
+
+	if err := p.slv.AddRows(rList, rMat); err != nil {
+		return err
+	}
+
+	return p.slv.AddIndConstrs(iList)
+}
+
+//==============================================================================
+
+// genName returns the name of the k-th row, column or constraint created for
+// the general constraint name, or an empty name if the constraint has none.
+func genName(name string, k int) string {
+
+	if name == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s_%d", name, k)
+}
+
+//==============================================================================
+
+// copyPwl returns a copy of the piecewise linear constraints, each with its own
+// Breaks slice.
+func copyPwl(pList []InputPwl) []InputPwl {
+
+	copied := make([]InputPwl, len(pList))
+	for i, pwl := range pList {
+		pwl.Breaks = append([]InputPwlBreak(nil), pwl.Breaks...)
+		copied[i]  = pwl
+	}
+
+	return copied
+}
+
+//==============================================================================
+// PACKAGE-LEVEL FUNCTIONS
+//==============================================================================
+
+// AddPwl appends piecewise linear constraints to the default problem. See
+// Problem.AddPwl.
+func AddPwl(pList []InputPwl) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.AddPwl(pList)
+}
+
+//==============================================================================
+
+// GetPwl obtains the piecewise linear constraints of the default problem. See
+// Problem.GetPwl.
+func GetPwl(pList *[]InputPwl) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetPwl(pList)
+}
+
+//==============================================================================
+
+// GetNumPwl obtains the number of piecewise linear constraints of the default
+// problem. See Problem.GetNumPwl.
+func GetNumPwl(numPwl *int) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetNumPwl(numPwl)
+}
+
+//==============================================================================
+
+// AddGenConstr appends general constraints to the default problem. See
+// Problem.AddGenConstr.
+func AddGenConstr(gList []InputGenConstr) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.AddGenConstr(gList)
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,363 @@
+package gpx
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+// genModel is a problem with the continuous columns a, b and r, the binary
+// columns u, v and w, and the row a + b <= 10.
+func genModel() testModel {
+
+	return testModel{
+		name:  "gen",
+		rows:  []InputRow{{"sum", "L", 10, 0}},
+		cols:  []InputCol{{"a", "C", -10, 10}, {"b", "C", -10, 10}, {"r", "C", -10, 10},
+			{"u", "B", 0, 1}, {"v", "B", 0, 1}, {"w", "B", 0, 1}},
+		elems: []InputElem{{0, 0, 1}, {0, 1, 1}},
+	}
+}
+
+//==============================================================================
+
+// genFeasible returns true if the values x of all columns satisfy their
+// bounds and types, and the rows, indicator constraints and piecewise linear
+// constraints of the problem.
+func genFeasible(t *testing.T, prob *Problem, x []float64) bool {
+
+	t.Helper()
+
+	var rows []InputRow
+	var cols []InputCol
+	var elems []InputElem
+	var inds []InputIndicator
+	var pwls []InputPwl
+
+	if err := prob.GetCols(&cols); err != nil {
+		t.Fatalf("GetCols failed: %v", err)
+	}
+	if err := prob.GetRows(&rows); err != nil {
+		t.Fatalf("GetRows failed: %v", err)
+	}
+	if err := prob.GetCoefs(&elems); err != nil {
+		t.Fatalf("GetCoefs failed: %v", err)
+	}
+	if err := prob.GetIndConstr(&inds); err != nil {
+		t.Fatalf("GetIndConstr failed: %v", err)
+	}
+	if err := prob.GetPwl(&pwls); err != nil {
+		t.Fatalf("GetPwl failed: %v", err)
+	}
+
+	satisfied := func(lhs float64, sense string, rhs float64) bool {
+		switch sense {
+		case "L":
+			return lhs <= rhs + 1e-9
+		case "G":
+			return lhs >= rhs - 1e-9
+		default:
+			return math.Abs(lhs - rhs) <= 1e-9
+		}
+	}
+
+	for j, col := range cols {
+		if x[j] < col.BndLo || x[j] > col.BndUp || (col.Type != "C" && x[j] != math.Round(x[j])) {
+			return false
+		}
+	}
+
+	lhs := make([]float64, len(rows))
+	for _, e := range elems {
+		lhs[e.RowIndex] += e.Value * x[e.ColIndex]
+	}
+	for i, row := range rows {
+		if !satisfied(lhs[i], row.Sense, row.Rhs) {
+			return false
+		}
+	}
+
+	for _, ind := range inds {
+		if x[ind.ColIndex] != float64(ind.ActiveValue) {
+			continue
+		}
+		sum := 0.0
+		for _, l := range ind.Lin {
+			sum += l.Value * x[l.ColIndex]
+		}
+		if !satisfied(sum, ind.Sense, ind.Rhs) {
+			return false
+		}
+	}
+
+	for _, pwl := range pwls {
+		first := pwl.Breaks[0]
+		last  := pwl.Breaks[len(pwl.Breaks) - 1]
+		xv    := x[pwl.XCol]
+
+		var y float64
+		switch {
+		case xv < first.X:
+			y = first.Y + pwl.PreSlope * (xv - first.X)
+		case xv >= last.X:
+			y = last.Y + pwl.PostSlope * (xv - last.X)
+		default:
+			for k := 1; k < len(pwl.Breaks); k++ {
+				lo, hi := pwl.Breaks[k - 1], pwl.Breaks[k]
+				if xv < hi.X {
+					y = lo.Y + (hi.Y - lo.Y) * (xv - lo.X) / (hi.X - lo.X)
+					break
+				}
+			}
+		}
+		if math.Abs(x[pwl.YCol] - y) > 1e-9 {
+			return false
+		}
+	}
+
+	return true
+}
+
+//==============================================================================
+
+// TestAddGenConstr checks the rows, columns and constraints which each type
+// adds, and then that the formulation admits the correct result, and no other,
+// for each combination of operand values tried.
+func TestAddGenConstr(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		gen       InputGenConstr
+		numRows   int            // Rows added
+		numCols   int            // Binary columns added
+		numInds   int            // Indicator constraints added
+		numPwl    int            // Piecewise linear constraints added
+		operands  [][]float64    // Values of the operands tried
+		result    func(v []float64) float64
+	}{
+		{"abs", InputGenConstr{"g", "ABS", 2, []int{0}}, 0, 0, 0, 1,
+			[][]float64{{-3}, {0}, {2.5}},
+			func(v []float64) float64 { return math.Abs(v[0]) }},
+		{"and", InputGenConstr{"g", "AND", 5, []int{3, 4}}, 3, 0, 0, 0,
+			[][]float64{{0, 0}, {0, 1}, {1, 0}, {1, 1}},
+			func(v []float64) float64 { return math.Min(v[0], v[1]) }},
+		{"or", InputGenConstr{"g", "OR", 5, []int{3, 4}}, 3, 0, 0, 0,
+			[][]float64{{0, 0}, {0, 1}, {1, 0}, {1, 1}},
+			func(v []float64) float64 { return math.Max(v[0], v[1]) }},
+		{"min", InputGenConstr{"g", "MIN", 2, []int{0, 1}}, 3, 2, 2, 0,
+			[][]float64{{1, 4}, {4, 1}, {2, 2}, {-3, 0}},
+			func(v []float64) float64 { return math.Min(v[0], v[1]) }},
+		{"max", InputGenConstr{"g", "MAX", 2, []int{0, 1}}, 3, 2, 2, 0,
+			[][]float64{{1, 4}, {4, 1}, {2, 2}, {-3, 0}},
+			func(v []float64) float64 { return math.Max(v[0], v[1]) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			_, prob := newTestProb(t, NewGoBackend(), genModel())
+			if err := prob.AddGenConstr([]InputGenConstr{tt.gen}); err != nil {
+				t.Fatalf("AddGenConstr failed: %v", err)
+			}
+
+			var rows []InputRow
+			var cols []InputCol
+			var numInds, numPwl int
+
+			prob.GetRows(&rows)
+			prob.GetCols(&cols)
+			prob.GetNumIndConstrs(&numInds)
+			prob.GetNumPwl(&numPwl)
+
+			if len(rows) != 1 + tt.numRows || len(cols) != 6 + tt.numCols || numInds != tt.numInds ||
+				numPwl != tt.numPwl {
+				t.Fatalf("counts = %d rows, %d columns, %d indicators, %d pwl, want %d, %d, %d, %d",
+					len(rows), len(cols), numInds, numPwl, 1 + tt.numRows, 6 + tt.numCols, tt.numInds, tt.numPwl)
+			}
+
+			for k, row := range rows[1:] {
+				if want := genName("g", k); row.Name != want {
+					t.Errorf("row %d name = %q, want %q", k + 1, row.Name, want)
+				}
+			}
+			for k, col := range cols[6:] {
+				if want := genName("g", k); col.Name != want || col.Type != "B" {
+					t.Errorf("column %d = %+v, want a binary named %q", k + 6, col, want)
+				}
+			}
+
+			// Each candidate result is tried with all values of the binary
+			// columns added.
+			x := make([]float64, len(cols))
+			feasible := func(result float64) bool {
+				x[tt.gen.ResCol] = result
+				for z := 0; z < 1 << uint(tt.numCols); z++ {
+					for k := 0; k < tt.numCols; k++ {
+						x[6 + k] = float64((z >> uint(k)) & 1)
+					}
+					if genFeasible(t, prob, x) {
+						return true
+					}
+				}
+				return false
+			}
+
+			for _, v := range tt.operands {
+				for k, col := range tt.gen.Cols {
+					x[col] = v[k]
+				}
+				want := tt.result(v)
+
+				if !feasible(want) {
+					t.Errorf("operands %v: result %g infeasible", v, want)
+				}
+				for _, wrong := range []float64{want - 1, want - 0.5, want + 0.5, want + 1} {
+					if feasible(wrong) {
+						t.Errorf("operands %v: result %g feasible, want only %g", v, wrong, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+//==============================================================================
+
+// TestAddGenConstrMipOpt solves the problem of genModel with each type of
+// general constraint and fixed operands, minimizing and maximizing the
+// result, which must be the function of the operands either way. The Go
+// backend refuses the types which need indicator or piecewise linear
+// constraints.
+func TestAddGenConstrMipOpt(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		gen       InputGenConstr
+		operands  [][]float64   // Values at which the operands are fixed
+		result    func(v []float64) float64
+		solved    bool          // The Go backend solves the problem
+	}{
+		{"and", InputGenConstr{"g", "AND", 5, []int{3, 4}},
+			[][]float64{{0, 0}, {0, 1}, {1, 0}, {1, 1}},
+			func(v []float64) float64 { return math.Min(v[0], v[1]) }, true},
+		{"or", InputGenConstr{"g", "OR", 5, []int{3, 4}},
+			[][]float64{{0, 0}, {0, 1}, {1, 0}, {1, 1}},
+			func(v []float64) float64 { return math.Max(v[0], v[1]) }, true},
+		{"and into u", InputGenConstr{"g", "AND", 3, []int{4, 5}},
+			[][]float64{{1, 1}, {0, 1}},
+			func(v []float64) float64 { return math.Min(v[0], v[1]) }, true},
+		{"abs", InputGenConstr{"g", "ABS", 2, []int{0}}, [][]float64{{-3}}, nil, false},
+		{"min", InputGenConstr{"g", "MIN", 2, []int{0, 1}}, [][]float64{{1, 4}}, nil, false},
+		{"max", InputGenConstr{"g", "MAX", 2, []int{0, 1}}, [][]float64{{1, 4}}, nil, false},
+	}
+
+	for _, tt := range tests {
+		for _, v := range tt.operands {
+			for _, sense := range []int{1, -1} {
+				t.Run(fmt.Sprintf("%s %v sense %d", tt.name, v, sense), func(t *testing.T) {
+
+					m := genModel()
+					m.cols  = append([]InputCol(nil), m.cols...)
+					m.obj   = []InputObjCoef{{tt.gen.ResCol, 1}}
+					m.sense = sense
+					for k, col := range tt.gen.Cols {
+						m.cols[col].BndLo = v[k]
+						m.cols[col].BndUp = v[k]
+					}
+
+					_, prob := newTestProb(t, NewGoBackend(), m)
+					if err := prob.AddGenConstr([]InputGenConstr{tt.gen}); err != nil {
+						t.Fatalf("AddGenConstr failed: %v", err)
+					}
+
+					err := prob.MipOpt()
+					if !tt.solved {
+						if err == nil || !strings.Contains(err.Error(), "cannot solve") {
+							t.Errorf("MipOpt error = %v, want the Go backend to refuse the problem", err)
+						}
+						return
+					}
+					if err != nil {
+						t.Fatalf("MipOpt failed: %v", err)
+					}
+
+					var objVal float64
+					want := tt.result(v)
+					if err = prob.GetObjVal(&objVal); err != nil || math.Abs(objVal - want) > 1e-9 {
+						t.Errorf("result = %g, %v, want %g", objVal, err, want)
+					}
+				})
+			}
+		}
+	}
+}
+
+//==============================================================================
+
+func TestAddGenConstrRollback(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		op       string  // Operation which fails
+		rbError  bool    // The rollback fails as well
+	}{
+		{"rows", "AddRows", false},
+		{"columns", "NewCols", false},
+		{"indicators", "AddIndConstrs", false},
+		{"rollback fails", "AddIndConstrs", true},
+	}
+
+	gList := []InputGenConstr{
+		{"abs", "ABS", 2, []int{0}},
+		{"and", "AND", 5, []int{3, 4}},
+		{"max", "MAX", 1, []int{0, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			fake := NewFakeBackend()
+			_, prob := newTestProb(t, fake, genModel())
+			cplexErr := &CplexError{Op: tt.op, Code: 1001}
+			fake.Errors[tt.op] = cplexErr
+			if tt.rbError {
+				fake.Errors["DelRows"] = errors.New("DelRows failed")
+			}
+
+			err := prob.AddGenConstr(gList)
+			if errors.Cause(err) != cplexErr {
+				t.Fatalf("AddGenConstr error = %v, want cause %v", err, cplexErr)
+			}
+			if rb := strings.Contains(err.Error(), "failed to remove"); rb != tt.rbError {
+				t.Errorf("AddGenConstr error = %q, want rollback failure %t", err, tt.rbError)
+			}
+			if tt.rbError {
+				return
+			}
+
+			var rows []InputRow
+			var cols []InputCol
+			var numInds, numPwl int
+
+			prob.GetRows(&rows)
+			prob.GetCols(&cols)
+			prob.GetNumIndConstrs(&numInds)
+			prob.GetNumPwl(&numPwl)
+
+			if len(rows) != 1 || len(cols) != 6 || numInds != 0 || numPwl != 0 {
+				t.Errorf("after the failure: %d rows, %d columns, %d indicators, %d pwl, want 1, 6, 0, 0",
+					len(rows), len(cols), numInds, numPwl)
+			}
+
+			for _, call := range fake.Calls() {
+				if call.Op == "DelIndConstrs" {
+					t.Errorf("DelIndConstrs called with %v, but no indicator constraint was added", call.Args)
+				}
+			}
+		})
+	}
+}
+
+//============================ END OF FILE =====================================
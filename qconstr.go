@@ -0,0 +1,230 @@
+// 01   Oct. 16, 2026   Initial version, quadratic constraints
+// 02   Oct. 16, 2026   The constraints are copied here only, not by the backends
+
+package gpx
+
+import (
+	"github.com/pkg/errors"
+)
+
+// InputLinCoef defines a data structure of a coefficient of a linear
+// expression over the columns of the problem, such as the linear part of a
+// quadratic constraint.
+type InputLinCoef struct {
+	ColIndex  int      // Column index of this coefficient
+	Value     float64  // Value of this coefficient
+}
+
+// InputQConstr defines a data structure of a quadratic constraint, passed as an
+// input argument to AddQConstrs. The constraint is
+//   sum(Lin[k].Value * x[Lin[k].ColIndex]) +
+//   sum(Quad[k].Value * x[Quad[k].RowIndex] * x[Quad[k].ColIndex])  <=  Rhs
+// for the sense "L", or >= Rhs for the sense "G". Unlike the quadratic
+// objective, there is no factor 1/2 and Quad need not be symmetric: each
+// coefficient adds one term, so that x0*x1 is written as Quad(0,1) = 1. A
+// second-order cone constraint x0*x0 + x1*x1 - t*t <= 0, with t >= 0, is
+// recognized as such by Cplex.
+type InputQConstr struct {
+	Name   string           // Name of the constraint
+	Sense  string           // "L" for <=, "G" for >=
+	Rhs    float64          // Right hand side of the constraint
+	Lin    []InputLinCoef   // Non-zero coefficients of the linear part
+	Quad   []InputQuadCoef  // Non-zero coefficients of the quadratic part
+}
+
+// SolnQConstr defines a data structure of the solved quadratic constraints
+// returned from Cplex.
+type SolnQConstr struct {
+	Name       string          // Name of the quadratic constraint
+	Slack      float64         // Rhs minus the value of the left hand side
+	DualSlack  []InputLinCoef  // Non-zero entries of the dual slack vector
+}
+
+//==============================================================================
+// PROBLEM FUNCTIONS
+//==============================================================================
+
+// AddQConstrs appends the quadratic constraints specified to the problem, which
+// becomes a QCP, or a MIQCP if it has integer columns. A QCP is solved by
+// BarOpt, and a MIQCP by MipOpt. The constraints must define a convex feasible
+// region.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXaddqconstr.
+func (p *Problem) AddQConstrs(qList []InputQConstr) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	if len(qList) < 1 {
+		return errors.Errorf("AddQConstrs expected more than %d constraints", len(qList))
+	}
+
+	numCols := p.slv.NumCols()
+	for i, q := range qList {
+		if q.Sense != "L" && q.Sense != "G" {
+			return errors.Errorf("AddQConstrs found sense '%s' for constraint %d, expected L or G", q.Sense, i)
+		}
+
+		if len(q.Quad) < 1 {
+			return errors.Errorf("AddQConstrs found no quadratic coefficients for constraint %d", i)
+		}
+
+		seen := make(map[int]bool, len(q.Lin))
+		for _, l := range q.Lin {
+			if l.ColIndex < 0 || l.ColIndex >= numCols {
+				return errors.Errorf("AddQConstrs found column %d for constraint %d, expected 0 to %d",
+					l.ColIndex, i, numCols - 1)
+			}
+			if seen[l.ColIndex] {
+				return errors.Errorf("AddQConstrs found column %d twice for constraint %d", l.ColIndex, i)
+			}
+			seen[l.ColIndex] = true
+		}
+
+		pos := make(map[[2]int]bool, len(q.Quad))
+		for _, c := range q.Quad {
+			if c.RowIndex < 0 || c.RowIndex >= numCols || c.ColIndex < 0 || c.ColIndex >= numCols {
+				return errors.Errorf("AddQConstrs found coefficient (%d, %d) for constraint %d, expected 0 to %d",
+					c.RowIndex, c.ColIndex, i, numCols - 1)
+			}
+			if pos[[2]int{c.RowIndex, c.ColIndex}] {
+				return errors.Errorf("AddQConstrs found coefficient (%d, %d) twice for constraint %d",
+					c.RowIndex, c.ColIndex, i)
+			}
+			pos[[2]int{c.RowIndex, c.ColIndex}] = true
+		}
+	}
+
+	return p.slv.AddQConstrs(copyQConstrs(qList))
+}
+
+//==============================================================================
+
+// GetNumQConstrs obtains the number of quadratic constraints in the current
+// problem, or 0 if none exist or the problem has not yet been defined. At this
+// time the function always returns nil (success).
+// This function uses CPXgetnumqconstrs.
+func (p *Problem) GetNumQConstrs(numQConstrs *int) error {
+
+	*numQConstrs = 0
+	if p.checkProb() == nil {
+		*numQConstrs = p.slv.NumQConstrs()
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// GetQConstrSolution obtains the name, slack and dual slack vector of each
+// quadratic constraint from the solution of the problem, and appends them to
+// the slice passed to the function, in the order in which the constraints were
+// added. The dual slack vector of a constraint plays the role of its dual
+// value; it is left empty after MipOpt, since a MIQCP has no duals. The values
+// of the columns, and the slacks and duals of the linear rows, are obtained by
+// GetSolution or GetMipSolution.
+// If no primal feasible solution exists, it returns an error including the
+// solution status.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXgetqconstrslack, CPXgetqconstrdslack and
+// CPXgetqconstrname.
+func (p *Problem) GetQConstrSolution(sList *[]SolnQConstr) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	if err := p.checkFeasible(); err != nil {
+		return errors.Wrap(err, "GetQConstrSolution failed")
+	}
+
+	info, err := p.slv.SolnInfo()
+	if err != nil {
+		return err
+	}
+
+	slack := make([]float64, p.slv.NumQConstrs())
+	if err = p.slv.QConstrSlack(slack); err != nil {
+		return errors.Wrap(err, "GetQConstrSolution failed")
+	}
+
+	names, err := p.slv.QConstrNames()
+	if err != nil {
+		return errors.Wrap(err, "GetQConstrSolution failed to get constraint names")
+	}
+
+	for i := range slack {
+		soln := SolnQConstr{Name: names[i], Slack: slack[i]}
+		if info.Type != SolnPrimal {
+			if soln.DualSlack, err = p.slv.QConstrDSlack(i); err != nil {
+				return errors.Wrap(err, "GetQConstrSolution failed")
+			}
+		}
+		*sList = append(*sList, soln)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// copyQConstrs returns a copy of the quadratic constraints with their own Lin
+// and Quad slices.
+func copyQConstrs(qList []InputQConstr) []InputQConstr {
+
+	copied := make([]InputQConstr, len(qList))
+	for i, q := range qList {
+		q.Lin     = append([]InputLinCoef(nil), q.Lin...)
+		q.Quad    = append([]InputQuadCoef(nil), q.Quad...)
+		copied[i] = q
+	}
+
+	return copied
+}
+
+//==============================================================================
+// PACKAGE-LEVEL FUNCTIONS
+//==============================================================================
+
+// AddQConstrs appends quadratic constraints to the default problem. See
+// Problem.AddQConstrs.
+func AddQConstrs(qList []InputQConstr) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.AddQConstrs(qList)
+}
+
+//==============================================================================
+
+// GetNumQConstrs obtains the number of quadratic constraints of the default
+// problem. See Problem.GetNumQConstrs.
+func GetNumQConstrs(numQConstrs *int) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetNumQConstrs(numQConstrs)
+}
+
+//==============================================================================
+
+// GetQConstrSolution obtains the solution of the quadratic constraints of the
+// default problem. See Problem.GetQConstrSolution.
+func GetQConstrSolution(sList *[]SolnQConstr) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetQConstrSolution(sList)
+}
+
+//============================ END OF FILE =====================================
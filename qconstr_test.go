@@ -0,0 +1,112 @@
+package gpx
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestAddQConstrs adds quadratic constraints to the problem of fakeModel, and
+// checks the constraints received by the backend, or that a list which is
+// rejected never reaches it.
+func TestAddQConstrs(t *testing.T) {
+
+	lin  := []InputLinCoef{{0, 1}, {1, -1}}
+	quad := []InputQuadCoef{{0, 0, 1}, {0, 1, 2}}
+
+	tests := []struct {
+		name   string
+		qList  []InputQConstr
+		fails  bool
+	}{
+		{"one", []InputQConstr{{"q1", "L", 4, lin, quad}}, false},
+		{"two", []InputQConstr{{"q1", "L", 4, nil, quad}, {"q2", "G", -1, lin, []InputQuadCoef{{1, 1, -1}}}}, false},
+		{"lower half only", []InputQConstr{{"q1", "L", 1, nil, []InputQuadCoef{{1, 0, 1}}}}, false},
+		{"empty", nil, true},
+		{"no sense", []InputQConstr{{"q1", "", 4, lin, quad}}, true},
+		{"ranged", []InputQConstr{{"q1", "R", 4, lin, quad}}, true},
+		{"no quadratic part", []InputQConstr{{"q1", "L", 4, lin, nil}}, true},
+		{"linear column out of range", []InputQConstr{{"q1", "L", 4, []InputLinCoef{{2, 1}}, quad}}, true},
+		{"linear column twice", []InputQConstr{{"q1", "L", 4, []InputLinCoef{{1, 1}, {1, 2}}, quad}}, true},
+		{"negative quadratic row", []InputQConstr{{"q1", "L", 4, lin, []InputQuadCoef{{-1, 0, 1}}}}, true},
+		{"quadratic coefficient twice", []InputQConstr{{"q1", "L", 4, lin, []InputQuadCoef{{0, 1, 1}, {0, 1, 1}}}},
+			true},
+		{"second constraint fails", []InputQConstr{{"q1", "L", 4, lin, quad}, {"q2", "E", 4, lin, quad}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			fake := NewFakeBackend()
+			_, prob := newTestProb(t, fake, fakeModel("C"))
+
+			err := prob.AddQConstrs(tt.qList)
+			if (err != nil) != tt.fails {
+				t.Fatalf("AddQConstrs error = %v, want error %t", err, tt.fails)
+			}
+
+			want := len(tt.qList)
+			if tt.fails {
+				want = 0
+			}
+			var numQConstrs int
+			if prob.GetNumQConstrs(&numQConstrs); numQConstrs != want {
+				t.Errorf("GetNumQConstrs = %d, want %d", numQConstrs, want)
+			}
+
+			var received [][]InputQConstr
+			for _, c := range fake.Calls() {
+				if c.Op == "AddQConstrs" {
+					received = append(received, c.Args[0].([]InputQConstr))
+				}
+			}
+			if tt.fails {
+				if len(received) > 0 {
+					t.Errorf("AddQConstrs passed %+v to the backend, want no call", received)
+				}
+				return
+			}
+			if len(received) != 1 || !reflect.DeepEqual(received[0], tt.qList) {
+				t.Errorf("AddQConstrs passed %+v to the backend, want %+v", received, tt.qList)
+			}
+		})
+	}
+}
+
+//==============================================================================
+
+// TestAddQConstrsCopy checks that the backend keeps the constraints as they
+// were passed, whether the caller changes its slices afterwards or columns are
+// deleted from the problem.
+func TestAddQConstrsCopy(t *testing.T) {
+
+	fake := NewFakeBackend()
+	_, prob := newTestProb(t, fake, fakeModel("C"))
+
+	qList := []InputQConstr{{"q1", "L", 4, []InputLinCoef{{0, 1}, {1, 3}}, []InputQuadCoef{{1, 1, 2}, {0, 0, 1}}}}
+	want  := []InputQConstr{{"q1", "L", 4, []InputLinCoef{{0, 1}, {1, 3}}, []InputQuadCoef{{1, 1, 2}, {0, 0, 1}}}}
+
+	if err := prob.AddQConstrs(qList); err != nil {
+		t.Fatalf("AddQConstrs failed: %v", err)
+	}
+
+	qList[0].Lin[0].Value  = 7
+	qList[0].Quad[0].Value = 7
+	if _, err := prob.DelCols(0, 0); err != nil {
+		t.Fatalf("DelCols failed: %v", err)
+	}
+
+	for _, c := range fake.Calls() {
+		if c.Op == "AddQConstrs" && !reflect.DeepEqual(c.Args[0], want) {
+			t.Errorf("AddQConstrs recorded %+v, want %+v", c.Args[0], want)
+		}
+	}
+
+	// The model keeps the constraint without the deleted column.
+	fp := prob.slv.(*fakeProb)
+	wantModel := []InputQConstr{{"q1", "L", 4, []InputLinCoef{{0, 3}}, []InputQuadCoef{{0, 0, 2}}}}
+	if !reflect.DeepEqual(fp.qconstrs, wantModel) {
+		t.Errorf("quadratic constraints = %+v, want %+v", fp.qconstrs, wantModel)
+	}
+}
+
+//============================ END OF FILE =====================================
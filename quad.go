@@ -0,0 +1,244 @@
+// 01   Oct. 16, 2026   Initial version, quadratic objective functions
+
+package gpx
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"sort"
+)
+
+// InputQuadCoef defines a data structure of a coefficient of the quadratic
+// objective matrix Q. The objective function of a QP is c'x + 1/2 x'Qx, so
+// that minimizing x0*x0 + x0*x1 is written as Q(0,0) = 2 and
+// Q(0,1) = Q(1,0) = 1. Both indices are column indices of the problem.
+type InputQuadCoef struct {
+	RowIndex  int      // Row index of this coefficient in Q, i.e. the first column
+	ColIndex  int      // Column index of this coefficient in Q, i.e. the second column
+	Value     float64  // Value of this coefficient
+}
+
+//==============================================================================
+// PROBLEM FUNCTIONS
+//==============================================================================
+
+// CopyQuad sets the quadratic objective matrix Q of the problem, replacing any
+// previous one, which turns an LP into a QP and a MIP into a MIQP. Q must be
+// symmetric: an off-diagonal coefficient given only once is also used for the
+// symmetric position, and a coefficient given for both positions must have the
+// same value. An empty list removes all quadratic coefficients.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXcopyquad, and CPXchgprobtype if the problem is an LP or MIP.
+func (p *Problem) CopyQuad(qList []InputQuadCoef) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	numCols := p.slv.NumCols()
+	coefs   := make(map[[2]int]float64, 2 * len(qList))
+
+	for i, q := range qList {
+		if q.RowIndex < 0 || q.RowIndex >= numCols || q.ColIndex < 0 || q.ColIndex >= numCols {
+			return errors.Errorf("CopyQuad found coefficient (%d, %d) for item %d, expected 0 to %d",
+				q.RowIndex, q.ColIndex, i, numCols - 1)
+		}
+		pos := [2]int{q.RowIndex, q.ColIndex}
+		if _, ok := coefs[pos]; ok {
+			return errors.Errorf("CopyQuad found coefficient (%d, %d) twice", q.RowIndex, q.ColIndex)
+		}
+		coefs[pos] = q.Value
+	}
+
+	for pos, value := range coefs {
+		sym := [2]int{pos[1], pos[0]}
+		other, ok := coefs[sym]
+		if !ok {
+			coefs[sym] = value
+		} else if other != value {
+			return errors.Errorf("CopyQuad found Q(%d, %d) = %g and Q(%d, %d) = %g, expected a symmetric matrix",
+				pos[0], pos[1], value, sym[0], sym[1], other)
+		}
+	}
+
+	var quad []InputQuadCoef
+	for pos, value := range coefs {
+		if value != 0 {
+			quad = append(quad, InputQuadCoef{RowIndex: pos[0], ColIndex: pos[1], Value: value})
+		}
+	}
+	sortQuad(quad)
+
+	return p.slv.CopyQuad(quad)
+}
+
+//==============================================================================
+
+// ChgQPCoef changes coefficients of the quadratic objective matrix Q. An
+// off-diagonal coefficient is changed at both symmetric positions, so that Q
+// stays symmetric. The problem becomes a QP or MIQP if it was not one already.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXchgqpcoef, and CPXchgprobtype if the problem is an LP or MIP.
+func (p *Problem) ChgQPCoef(qList []InputQuadCoef) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	if len(qList) < 1 {
+		return errors.Errorf("ChgQPCoef expected more than %d coefficients", len(qList))
+	}
+
+	numCols := p.slv.NumCols()
+	for i, q := range qList {
+		if q.RowIndex < 0 || q.RowIndex >= numCols || q.ColIndex < 0 || q.ColIndex >= numCols {
+			return errors.Errorf("ChgQPCoef found coefficient (%d, %d) for item %d, expected 0 to %d",
+				q.RowIndex, q.ColIndex, i, numCols - 1)
+		}
+	}
+
+	return p.slv.ChgQPCoef(append([]InputQuadCoef(nil), qList...))
+}
+
+//==============================================================================
+
+// GetQuad obtains the non-zero coefficients of the quadratic objective matrix
+// Q, and populates the slice passed to the function with one entry per
+// coefficient, both positions of off-diagonal coefficients included, ordered by
+// column, then by row. The slice is empty if the problem has no quadratic
+// objective.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXgetnumqpnz and CPXgetquad.
+func (p *Problem) GetQuad(qList *[]InputQuadCoef) error {
+
+	*qList = nil
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	quad, err := p.slv.Quad()
+	if err != nil {
+		return errors.Wrap(err, "GetQuad failed")
+	}
+
+	sortQuad(quad)
+	*qList = quad
+
+	return nil
+}
+
+//==============================================================================
+
+// QPOpt solves the problem as a QP, with the optimizer selected by
+// ParamQpMethod (chosen by Cplex by default). The objective must be
+// convex for a minimization, or concave for a maximization. Once solved,
+// GetSolution returns the values of the columns and slacks, together with the
+// duals of the rows and the reduced costs of the columns. A MIQP is solved by
+// MipOpt instead.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXqpopt.
+func (p *Problem) QPOpt() error {
+
+	return p.QPOptContext(context.Background())
+}
+
+//==============================================================================
+
+// QPOptContext solves the problem as a QP in the same way as QPOpt, but aborts
+// the solve once the context passed into this function is done, as
+// LpOptContext does.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXsetterminate and CPXqpopt.
+func (p *Problem) QPOptContext(ctx context.Context) error {
+
+	return p.optimize(ctx, "QPOpt", func(ctx context.Context) error {
+		return p.slv.QPOpt(ctx)
+	})
+}
+
+//==============================================================================
+
+// sortQuad sorts the coefficients of Q by column, then by row.
+func sortQuad(quad []InputQuadCoef) {
+
+	sort.Slice(quad, func(a, b int) bool {
+		if quad[a].ColIndex != quad[b].ColIndex {
+			return quad[a].ColIndex < quad[b].ColIndex
+		}
+		return quad[a].RowIndex < quad[b].RowIndex
+	})
+}
+
+//==============================================================================
+// PACKAGE-LEVEL FUNCTIONS
+//==============================================================================
+
+// CopyQuad sets the quadratic objective matrix of the default problem. See
+// Problem.CopyQuad.
+func CopyQuad(qList []InputQuadCoef) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.CopyQuad(qList)
+}
+
+//==============================================================================
+
+// ChgQPCoef changes coefficients of the quadratic objective matrix of the
+// default problem. See Problem.ChgQPCoef.
+func ChgQPCoef(qList []InputQuadCoef) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.ChgQPCoef(qList)
+}
+
+//==============================================================================
+
+// GetQuad obtains the quadratic objective matrix of the default problem. See
+// Problem.GetQuad.
+func GetQuad(qList *[]InputQuadCoef) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetQuad(qList)
+}
+
+//==============================================================================
+
+// QPOpt solves the default problem as a QP. See Problem.QPOpt.
+func QPOpt() error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.QPOpt()
+}
+
+//==============================================================================
+
+// QPOptContext solves the default problem as a QP, aborting once the context
+// is done. See Problem.QPOptContext.
+func QPOptContext(ctx context.Context) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.QPOptContext(ctx)
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,121 @@
+package gpx
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestCopyQuad sets Q for the problem of fakeModel, after Q(0,0) = 1 has been
+// set, and checks the symmetric matrix which results, or that a list which is
+// rejected leaves the previous matrix unchanged.
+func TestCopyQuad(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		qList  []InputQuadCoef
+		want   []InputQuadCoef   // nil for an error
+	}{
+		{"diagonal", []InputQuadCoef{{1, 1, 4}, {0, 0, 2}},
+			[]InputQuadCoef{{0, 0, 2}, {1, 1, 4}}},
+		{"upper half", []InputQuadCoef{{0, 1, 3}},
+			[]InputQuadCoef{{1, 0, 3}, {0, 1, 3}}},
+		{"lower half", []InputQuadCoef{{1, 0, -3}, {1, 1, 2}},
+			[]InputQuadCoef{{1, 0, -3}, {0, 1, -3}, {1, 1, 2}}},
+		{"both halves", []InputQuadCoef{{0, 1, 3}, {1, 0, 3}},
+			[]InputQuadCoef{{1, 0, 3}, {0, 1, 3}}},
+		{"zeros dropped", []InputQuadCoef{{0, 0, 0}, {0, 1, 0}, {1, 1, 5}},
+			[]InputQuadCoef{{1, 1, 5}}},
+		{"empty", nil, []InputQuadCoef{}},
+		{"not symmetric", []InputQuadCoef{{0, 1, 3}, {1, 0, 2}}, nil},
+		{"same position twice", []InputQuadCoef{{1, 1, 3}, {1, 1, 3}}, nil},
+		{"column out of range", []InputQuadCoef{{0, 2, 1}}, nil},
+		{"negative row", []InputQuadCoef{{-1, 0, 1}}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			_, prob := newTestProb(t, NewFakeBackend(), fakeModel("C"))
+			if err := prob.CopyQuad([]InputQuadCoef{{0, 0, 1}}); err != nil {
+				t.Fatalf("CopyQuad failed: %v", err)
+			}
+
+			err := prob.CopyQuad(tt.qList)
+			if (err != nil) != (tt.want == nil) {
+				t.Fatalf("CopyQuad error = %v, want error %t", err, tt.want == nil)
+			}
+
+			want := tt.want
+			if want == nil {
+				want = []InputQuadCoef{{0, 0, 1}}
+			}
+
+			var quad []InputQuadCoef
+			if err = prob.GetQuad(&quad); err != nil {
+				t.Fatalf("GetQuad failed: %v", err)
+			}
+			if len(quad) != len(want) || (len(want) > 0 && !reflect.DeepEqual(quad, want)) {
+				t.Errorf("GetQuad = %v, want %v", quad, want)
+			}
+		})
+	}
+}
+
+//==============================================================================
+
+// TestChgQPCoef changes coefficients of Q, set by CopyQuad, on both backends
+// which keep the model in memory.
+func TestChgQPCoef(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		qList  []InputQuadCoef
+		want   []InputQuadCoef   // nil for an error
+	}{
+		{"diagonal", []InputQuadCoef{{1, 1, 6}},
+			[]InputQuadCoef{{0, 0, 2}, {1, 0, 1}, {0, 1, 1}, {1, 1, 6}}},
+		{"off-diagonal", []InputQuadCoef{{1, 0, 5}},
+			[]InputQuadCoef{{0, 0, 2}, {1, 0, 5}, {0, 1, 5}}},
+		{"removed", []InputQuadCoef{{0, 1, 0}, {0, 0, 0}}, []InputQuadCoef{}},
+		{"later change wins", []InputQuadCoef{{0, 1, 4}, {1, 0, 7}},
+			[]InputQuadCoef{{0, 0, 2}, {1, 0, 7}, {0, 1, 7}}},
+		{"empty", nil, nil},
+		{"column out of range", []InputQuadCoef{{0, 0, 3}, {2, 0, 1}}, nil},
+	}
+
+	for _, backend := range []string{"fake", "go"} {
+		for _, tt := range tests {
+			t.Run(backend + " " + tt.name, func(t *testing.T) {
+
+				var b Backend = NewFakeBackend()
+				if backend == "go" {
+					b = NewGoBackend()
+				}
+				_, prob := newTestProb(t, b, fakeModel("C"))
+				if err := prob.CopyQuad([]InputQuadCoef{{0, 0, 2}, {0, 1, 1}}); err != nil {
+					t.Fatalf("CopyQuad failed: %v", err)
+				}
+
+				err := prob.ChgQPCoef(tt.qList)
+				if (err != nil) != (tt.want == nil) {
+					t.Fatalf("ChgQPCoef error = %v, want error %t", err, tt.want == nil)
+				}
+
+				want := tt.want
+				if want == nil {
+					want = []InputQuadCoef{{0, 0, 2}, {1, 0, 1}, {0, 1, 1}}
+				}
+
+				var quad []InputQuadCoef
+				if err = prob.GetQuad(&quad); err != nil {
+					t.Fatalf("GetQuad failed: %v", err)
+				}
+				if len(quad) != len(want) || (len(want) > 0 && !reflect.DeepEqual(quad, want)) {
+					t.Errorf("GetQuad = %v, want %v", quad, want)
+				}
+			})
+		}
+	}
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,205 @@
+// 01   Oct. 16, 2026   Initial version, sensitivity analysis of LP solutions
+
+package gpx
+
+import (
+	"github.com/pkg/errors"
+)
+
+// SolnObjSA defines a data structure of the objective ranges returned from
+// Cplex for each column. The optimal basis remains optimal as long as the
+// objective coefficient of the column, all other data unchanged, stays within
+// [Lower, Upper].
+type SolnObjSA struct {
+	Name    string     // Name of the column
+	Lower   float64    // Lowest objective coefficient for which the basis remains optimal
+	Upper   float64    // Highest objective coefficient for which the basis remains optimal
+}
+
+// SolnRhsSA defines a data structure of the right hand side ranges returned
+// from Cplex for each row. The optimal basis remains feasible, and the duals
+// unchanged, as long as the right hand side of the row stays within
+// [Lower, Upper].
+type SolnRhsSA struct {
+	Name    string     // Name of the row
+	Lower   float64    // Lowest right hand side for which the basis remains feasible
+	Upper   float64    // Highest right hand side for which the basis remains feasible
+}
+
+// SolnBoundSA defines a data structure of the bound ranges returned from Cplex
+// for each column. The optimal basis remains feasible as long as the lower
+// bound of the column stays within [LbLower, LbUpper], or its upper bound
+// within [UbLower, UbUpper].
+type SolnBoundSA struct {
+	Name    string     // Name of the column
+	LbLower float64    // Lowest lower bound for which the basis remains feasible
+	LbUpper float64    // Highest lower bound for which the basis remains feasible
+	UbLower float64    // Lowest upper bound for which the basis remains feasible
+	UbUpper float64    // Highest upper bound for which the basis remains feasible
+}
+
+//==============================================================================
+
+// GetObjSA obtains the objective ranges of all columns for the optimal basis
+// found by LpOpt, and populates the slice passed to the function with one entry
+// per column, in column order. Ranges without a limit are reported as
+// -/+1.0e20 (CPX_INFBOUND).
+// Ranges are only available for an LP solved to optimality with a basis; after
+// MipOpt the error matches ErrNotForMIP.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXobjsa and CPXgetcolname.
+func (p *Problem) GetObjSA(saCols *[]SolnObjSA) error {
+
+	*saCols = nil
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	numCols := p.slv.NumCols()
+	lower   := make([]float64, numCols)
+	upper   := make([]float64, numCols)
+
+	if err := p.slv.ObjSA(lower, upper); err != nil {
+		return errors.Wrap(err, "GetObjSA failed")
+	}
+
+	names, err := p.slv.ColNames()
+	if err != nil {
+		return errors.Wrap(err, "GetObjSA failed to get column names")
+	}
+
+	*saCols = make([]SolnObjSA, numCols)
+	for j := 0; j < numCols; j++ {
+		(*saCols)[j] = SolnObjSA{Name: names[j], Lower: lower[j], Upper: upper[j]}
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// GetRHSSA obtains the right hand side ranges of all rows for the optimal basis
+// found by LpOpt, and populates the slice passed to the function with one entry
+// per row, in row order. Ranges without a limit are reported as -/+1.0e20
+// (CPX_INFBOUND).
+// Ranges are only available for an LP solved to optimality with a basis; after
+// MipOpt the error matches ErrNotForMIP.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXrhssa and CPXgetrowname.
+func (p *Problem) GetRHSSA(saRows *[]SolnRhsSA) error {
+
+	*saRows = nil
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	numRows := p.slv.NumRows()
+	lower   := make([]float64, numRows)
+	upper   := make([]float64, numRows)
+
+	if err := p.slv.RhsSA(lower, upper); err != nil {
+		return errors.Wrap(err, "GetRHSSA failed")
+	}
+
+	names, err := p.slv.RowNames()
+	if err != nil {
+		return errors.Wrap(err, "GetRHSSA failed to get row names")
+	}
+
+	*saRows = make([]SolnRhsSA, numRows)
+	for i := 0; i < numRows; i++ {
+		(*saRows)[i] = SolnRhsSA{Name: names[i], Lower: lower[i], Upper: upper[i]}
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// GetBoundSA obtains the lower and upper bound ranges of all columns for the
+// optimal basis found by LpOpt, and populates the slice passed to the function
+// with one entry per column, in column order. Ranges without a limit are
+// reported as -/+1.0e20 (CPX_INFBOUND).
+// Ranges are only available for an LP solved to optimality with a basis; after
+// MipOpt the error matches ErrNotForMIP.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXboundsa and CPXgetcolname.
+func (p *Problem) GetBoundSA(saCols *[]SolnBoundSA) error {
+
+	*saCols = nil
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	numCols := p.slv.NumCols()
+	lbLo    := make([]float64, numCols)
+	lbUp    := make([]float64, numCols)
+	ubLo    := make([]float64, numCols)
+	ubUp    := make([]float64, numCols)
+
+	if err := p.slv.BoundSA(lbLo, lbUp, ubLo, ubUp); err != nil {
+		return errors.Wrap(err, "GetBoundSA failed")
+	}
+
+	names, err := p.slv.ColNames()
+	if err != nil {
+		return errors.Wrap(err, "GetBoundSA failed to get column names")
+	}
+
+	*saCols = make([]SolnBoundSA, numCols)
+	for j := 0; j < numCols; j++ {
+		(*saCols)[j] = SolnBoundSA{Name: names[j], LbLower: lbLo[j], LbUpper: lbUp[j],
+			UbLower: ubLo[j], UbUpper: ubUp[j]}
+	}
+
+	return nil
+}
+
+//==============================================================================
+// PACKAGE-LEVEL FUNCTIONS
+//==============================================================================
+
+// GetObjSA obtains the objective ranges of the default problem. See
+// Problem.GetObjSA.
+func GetObjSA(saCols *[]SolnObjSA) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetObjSA(saCols)
+}
+
+//==============================================================================
+
+// GetRHSSA obtains the right hand side ranges of the default problem. See
+// Problem.GetRHSSA.
+func GetRHSSA(saRows *[]SolnRhsSA) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetRHSSA(saRows)
+}
+
+//==============================================================================
+
+// GetBoundSA obtains the bound ranges of the default problem. See
+// Problem.GetBoundSA.
+func GetBoundSA(saCols *[]SolnBoundSA) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetBoundSA(saCols)
+}
+
+//============================ END OF FILE =====================================
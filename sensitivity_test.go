@@ -0,0 +1,118 @@
+package gpx
+
+import (
+	"github.com/pkg/errors"
+	"math"
+	"testing"
+)
+
+// TestGoSensitivity checks the ranges of the Go backend on the problem of
+// goModel, whose optimal basis has x at its upper bound, and on the problem
+//   min -x - 2y  st  x + y <= 4, x + 3y <= 7, 0 <= x <= 3, y >= 0
+// whose optimal basis has both rows binding.
+func TestGoSensitivity(t *testing.T) {
+
+	minModel := goModel("C")
+	minModel.obj   = []InputObjCoef{{0, -1}, {1, -2}}
+	minModel.sense = 1
+
+	tests := []struct {
+		name   string
+		model  testModel
+		obj    []SolnObjSA
+		rhs    []SolnRhsSA
+		bound  []SolnBoundSA
+	}{
+		{"max, x at its bound", goModel("C"),
+			[]SolnObjSA{{"x", 2, 1e20}, {"y", 0, 3}},
+			[]SolnRhsSA{{"c1", 3, 13.0 / 3}, {"c2", 6, 1e20}},
+			[]SolnBoundSA{{"x", -1e20, 3, 2.5, 4}, {"y", -1e20, 1, 1, 1e20}}},
+		{"min, rows binding", minModel,
+			[]SolnObjSA{{"x", -2, -2.0 / 3}, {"y", -3, -1}},
+			[]SolnRhsSA{{"c1", 7.0 / 3, 13.0 / 3}, {"c2", 6, 12}},
+			[]SolnBoundSA{{"x", -1e20, 2.5, 2.5, 1e20}, {"y", -1e20, 1.5, 1.5, 1e20}}},
+	}
+
+	near := func(a, b float64) bool { return math.Abs(a - b) <= 1e-9 * (1 + math.Abs(b)) }
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			_, prob := newTestProb(t, NewGoBackend(), tt.model)
+			if err := prob.LpOpt(); err != nil {
+				t.Fatalf("LpOpt failed: %v", err)
+			}
+
+			var obj []SolnObjSA
+			if err := prob.GetObjSA(&obj); err != nil || len(obj) != len(tt.obj) {
+				t.Fatalf("GetObjSA = %v, %v, want %v", obj, err, tt.obj)
+			}
+			for j, sa := range obj {
+				want := tt.obj[j]
+				if sa.Name != want.Name || !near(sa.Lower, want.Lower) || !near(sa.Upper, want.Upper) {
+					t.Errorf("objective range %d = %+v, want %+v", j, sa, want)
+				}
+			}
+
+			var rhs []SolnRhsSA
+			if err := prob.GetRHSSA(&rhs); err != nil || len(rhs) != len(tt.rhs) {
+				t.Fatalf("GetRHSSA = %v, %v, want %v", rhs, err, tt.rhs)
+			}
+			for i, sa := range rhs {
+				want := tt.rhs[i]
+				if sa.Name != want.Name || !near(sa.Lower, want.Lower) || !near(sa.Upper, want.Upper) {
+					t.Errorf("right hand side range %d = %+v, want %+v", i, sa, want)
+				}
+			}
+
+			var bound []SolnBoundSA
+			if err := prob.GetBoundSA(&bound); err != nil || len(bound) != len(tt.bound) {
+				t.Fatalf("GetBoundSA = %v, %v, want %v", bound, err, tt.bound)
+			}
+			for j, sa := range bound {
+				want := tt.bound[j]
+				if sa.Name != want.Name || !near(sa.LbLower, want.LbLower) || !near(sa.LbUpper, want.LbUpper) ||
+					!near(sa.UbLower, want.UbLower) || !near(sa.UbUpper, want.UbUpper) {
+					t.Errorf("bound range %d = %+v, want %+v", j, sa, want)
+				}
+			}
+		})
+	}
+}
+
+//==============================================================================
+
+func TestGoSensitivityErrors(t *testing.T) {
+
+	var obj []SolnObjSA
+	var rhs []SolnRhsSA
+	var bound []SolnBoundSA
+
+	tests := []struct {
+		name     string
+		colType  string
+		solve    func(p *Problem) error
+		want     error
+	}{
+		{"not solved", "C", func(p *Problem) error { return nil }, ErrNoSolution},
+		{"after MipOpt", "I", func(p *Problem) error { return p.MipOpt() }, ErrNotForMIP},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			_, prob := newTestProb(t, NewGoBackend(), goModel(tt.colType))
+			if err := tt.solve(prob); err != nil {
+				t.Fatalf("solve failed: %v", err)
+			}
+
+			for _, err := range []error{prob.GetObjSA(&obj), prob.GetRHSSA(&rhs), prob.GetBoundSA(&bound)} {
+				if !errors.Is(err, tt.want) {
+					t.Errorf("error = %v, want %v", err, tt.want)
+				}
+			}
+		})
+	}
+}
+
+//============================ END OF FILE =====================================
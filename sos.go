@@ -0,0 +1,190 @@
+// 01   Oct. 16, 2026   Initial version, special ordered sets
+// 02   Oct. 16, 2026   The sets are copied here only, not by the backends
+
+package gpx
+
+import (
+	"github.com/pkg/errors"
+)
+
+// InputSOS defines a data structure of a special ordered set, passed as an
+// input argument to AddSOS and returned by GetSOS. In a set of type 1 at most
+// one member may be non-zero, as in an exclusive choice; in a set of type 2 at
+// most two members may be non-zero, and they must be adjacent in the order of
+// their weights, as in a piecewise linear function. The weights must be
+// distinct.
+type InputSOS struct {
+	Name     string           // Name of the set
+	Type     int              // Type of the set, 1 or 2
+	Members  []InputSOSMember // Members of the set
+}
+
+// InputSOSMember defines a data structure of a member of a special ordered set.
+type InputSOSMember struct {
+	ColIndex  int      // Column index of this member
+	Weight    float64  // Weight of this member, which orders the set
+}
+
+//==============================================================================
+// PROBLEM FUNCTIONS
+//==============================================================================
+
+// AddSOS appends the special ordered sets specified to the problem, which
+// becomes a MIP. MipOpt branches on the sets directly, which is usually much
+// faster than a reformulation with binary columns.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXaddsos, and CPXchgprobtype if the problem is an LP or QP.
+func (p *Problem) AddSOS(sList []InputSOS) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	if len(sList) < 1 {
+		return errors.Errorf("AddSOS expected more than %d sets", len(sList))
+	}
+
+	numCols := p.slv.NumCols()
+	for i, s := range sList {
+		if s.Type != 1 && s.Type != 2 {
+			return errors.Errorf("AddSOS found type %d for set %d, expected 1 or 2", s.Type, i)
+		}
+
+		if len(s.Members) < 1 {
+			return errors.Errorf("AddSOS found no members for set %d", i)
+		}
+
+		cols    := make(map[int]bool, len(s.Members))
+		weights := make(map[float64]bool, len(s.Members))
+		for _, m := range s.Members {
+			if m.ColIndex < 0 || m.ColIndex >= numCols {
+				return errors.Errorf("AddSOS found column %d in set %d, expected 0 to %d",
+					m.ColIndex, i, numCols - 1)
+			}
+			if cols[m.ColIndex] {
+				return errors.Errorf("AddSOS found column %d twice in set %d", m.ColIndex, i)
+			}
+			if weights[m.Weight] {
+				return errors.Errorf("AddSOS found weight %g twice in set %d", m.Weight, i)
+			}
+			cols[m.ColIndex]  = true
+			weights[m.Weight] = true
+		}
+	}
+
+	return p.slv.AddSOS(copySOS(sList))
+}
+
+//==============================================================================
+
+// GetSOS obtains all special ordered sets of the problem, and populates the
+// slice passed to the function with one entry per set, in the order in which
+// they were added, as expected by AddSOS.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXgetnumsos, CPXgetsos and CPXgetsosname.
+func (p *Problem) GetSOS(sList *[]InputSOS) error {
+
+	*sList = nil
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	sets := make([]InputSOS, p.slv.NumSOS())
+	if err := p.slv.SOS(sets); err != nil {
+		return errors.Wrap(err, "GetSOS failed")
+	}
+
+	names, err := p.slv.SOSNames()
+	if err != nil {
+		return errors.Wrap(err, "GetSOS failed to get set names")
+	}
+
+	for i := range sets {
+		sets[i].Name = names[i]
+	}
+	*sList = sets
+
+	return nil
+}
+
+//==============================================================================
+
+// DelSOS deletes the special ordered sets from begin to end, both included.
+// The sets after end move down to fill the gap. The columns of the sets are
+// kept.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXdelsos.
+func (p *Problem) DelSOS(begin, end int) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	if _, err := delRange("DelSOS", p.slv.NumSOS(), begin, end); err != nil {
+		return err
+	}
+
+	return p.slv.DelSOS(begin, end)
+}
+
+//==============================================================================
+
+// copySOS returns a copy of the special ordered sets, each with its own
+// Members slice.
+func copySOS(sList []InputSOS) []InputSOS {
+
+	copied := make([]InputSOS, len(sList))
+	for i, s := range sList {
+		s.Members = append([]InputSOSMember(nil), s.Members...)
+		copied[i] = s
+	}
+
+	return copied
+}
+
+//==============================================================================
+// PACKAGE-LEVEL FUNCTIONS
+//==============================================================================
+
+// AddSOS appends special ordered sets to the default problem. See
+// Problem.AddSOS.
+func AddSOS(sList []InputSOS) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.AddSOS(sList)
+}
+
+//==============================================================================
+
+// GetSOS obtains the special ordered sets of the default problem. See
+// Problem.GetSOS.
+func GetSOS(sList *[]InputSOS) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.GetSOS(sList)
+}
+
+//==============================================================================
+
+// DelSOS deletes a range of special ordered sets of the default problem. See
+// Problem.DelSOS.
+func DelSOS(begin, end int) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.DelSOS(begin, end)
+}
+
+//============================ END OF FILE =====================================
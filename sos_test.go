@@ -0,0 +1,108 @@
+package gpx
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestAddSOS adds special ordered sets to the problem of delModel, and checks
+// the sets obtained by GetSOS, or that a list which is rejected leaves the
+// problem without any.
+func TestAddSOS(t *testing.T) {
+
+	members := []InputSOSMember{{0, 1}, {2, 3}, {1, 2}}
+
+	tests := []struct {
+		name   string
+		sList  []InputSOS
+		fails  bool
+	}{
+		{"type 1", []InputSOS{{"s1", 1, members}}, false},
+		{"two sets", []InputSOS{{"s1", 2, members}, {"s2", 1, []InputSOSMember{{3, -1}, {0, 1}}}}, false},
+		{"one member", []InputSOS{{"s1", 1, []InputSOSMember{{3, 0}}}}, false},
+		{"empty", nil, true},
+		{"type 3", []InputSOS{{"s1", 3, members}}, true},
+		{"no type", []InputSOS{{"s1", 0, members}}, true},
+		{"no members", []InputSOS{{"s1", 1, nil}}, true},
+		{"column out of range", []InputSOS{{"s1", 1, []InputSOSMember{{0, 1}, {4, 2}}}}, true},
+		{"negative column", []InputSOS{{"s1", 2, []InputSOSMember{{-1, 1}}}}, true},
+		{"column twice", []InputSOS{{"s1", 1, []InputSOSMember{{1, 1}, {1, 2}}}}, true},
+		{"weight twice", []InputSOS{{"s1", 2, []InputSOSMember{{0, 1}, {1, 1}}}}, true},
+		{"second set fails", []InputSOS{{"s1", 1, members}, {"s2", 1, nil}}, true},
+	}
+
+	for _, backend := range []string{"fake", "go"} {
+		for _, tt := range tests {
+			t.Run(backend + " " + tt.name, func(t *testing.T) {
+
+				var b Backend = NewFakeBackend()
+				if backend == "go" {
+					b = NewGoBackend()
+				}
+				_, prob := newTestProb(t, b, delModel())
+
+				err := prob.AddSOS(tt.sList)
+				if (err != nil) != tt.fails {
+					t.Fatalf("AddSOS error = %v, want error %t", err, tt.fails)
+				}
+
+				var sets []InputSOS
+				if err = prob.GetSOS(&sets); err != nil {
+					t.Fatalf("GetSOS failed: %v", err)
+				}
+				if tt.fails {
+					if len(sets) > 0 {
+						t.Errorf("GetSOS = %+v, want no sets", sets)
+					}
+					return
+				}
+				if !reflect.DeepEqual(sets, tt.sList) {
+					t.Errorf("GetSOS = %+v, want %+v", sets, tt.sList)
+				}
+			})
+		}
+	}
+}
+
+//==============================================================================
+
+// TestAddSOSCopy checks that the backend keeps the sets as they were passed,
+// whether the caller changes its slices afterwards or columns are deleted from
+// the problem, and that the sets obtained are copies.
+func TestAddSOSCopy(t *testing.T) {
+
+	fake := NewFakeBackend()
+	_, prob := newTestProb(t, fake, delModel())
+
+	sList := []InputSOS{{"s1", 1, []InputSOSMember{{0, 1}, {2, 2}}}, {"s2", 2, []InputSOSMember{{1, 1}}}}
+	want  := []InputSOS{{"s1", 1, []InputSOSMember{{0, 1}, {2, 2}}}, {"s2", 2, []InputSOSMember{{1, 1}}}}
+
+	if err := prob.AddSOS(sList); err != nil {
+		t.Fatalf("AddSOS failed: %v", err)
+	}
+	sList[0].Members[0].Weight = 7
+
+	var sets []InputSOS
+	if err := prob.GetSOS(&sets); err != nil || !reflect.DeepEqual(sets, want) {
+		t.Fatalf("GetSOS = %+v, %v, want %+v", sets, err, want)
+	}
+	sets[0].Members[1].Weight = 7
+
+	if _, err := prob.DelCols(0, 1); err != nil {
+		t.Fatalf("DelCols failed: %v", err)
+	}
+
+	for _, c := range fake.Calls() {
+		if c.Op == "AddSOS" && !reflect.DeepEqual(c.Args[0], want) {
+			t.Errorf("AddSOS recorded %+v, want %+v", c.Args[0], want)
+		}
+	}
+
+	// s2 is left empty and deleted, s1 keeps column c, now the first.
+	wantAfter := []InputSOS{{"s1", 1, []InputSOSMember{{0, 2}}}}
+	if err := prob.GetSOS(&sets); err != nil || !reflect.DeepEqual(sets, wantAfter) {
+		t.Errorf("GetSOS after DelCols = %+v, %v, want %+v", sets, err, wantAfter)
+	}
+}
+
+//============================ END OF FILE =====================================
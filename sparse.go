@@ -0,0 +1,176 @@
+// 01   Oct. 16, 2026   Initial version, adding rows and columns with their coefficients
+
+package gpx
+
+import (
+	"github.com/pkg/errors"
+)
+
+// InputMatrix defines a data structure passed as an input argument to AddRows
+// and AddCols, holding the non-zero coefficients of the rows or columns being
+// added in compressed sparse form. The coefficients of item k (a row for
+// AddRows, a column for AddCols) are Ind[Beg[k]:Beg[k+1]] and
+// Val[Beg[k]:Beg[k+1]], the last item ending at len(Ind). Ind holds column
+// indices in AddRows and row indices in AddCols, referring to existing columns
+// or rows. A matrix with empty slices adds no coefficients.
+type InputMatrix struct {
+	Beg    []int      // Position in Ind and Val of the first coefficient of each item
+	Ind    []int      // Column or row index of each coefficient
+	Val    []float64  // Value of each coefficient
+}
+
+//==============================================================================
+// PROBLEM FUNCTIONS
+//==============================================================================
+
+// AddRows creates the new rows specified for this problem, together with their
+// non-zero coefficients in the existing columns, given row by row in rMat.
+// The rows are defined as in NewRows.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXaddrows, and CPXchgrngval for ranged rows.
+func (p *Problem) AddRows(rList []InputRow, rMat InputMatrix) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	if len(rList) < 1 {
+		return errors.Errorf("AddRows expected more than %d rows", len(rList))
+	}
+
+	for i := 0; i < len(rList); i++ {
+		if rList[i].Sense == "" {
+			return errors.Errorf("AddRows found no sense for row %d", i)
+		}
+	}
+
+	mat, err := checkMatrix("AddRows", "row", "column", rMat, len(rList), p.slv.NumCols())
+	if err != nil {
+		return err
+	}
+
+	return p.slv.AddRows(rList, mat)
+}
+
+//==============================================================================
+
+// AddCols creates the new columns specified for this problem, together with
+// their non-zero coefficients in the existing rows, given column by column in
+// cMat. The columns and their objective function coefficients are defined as
+// in NewCols. Adding many columns at once, e.g. in column generation, is much
+// faster than NewCols followed by ChgCoefList.
+// In case of failure, it returns an error including the error code it received from Cplex.
+// This function uses CPXaddcols, and CPXchgctype for columns which are not
+// continuous.
+func (p *Problem) AddCols(objList []InputObjCoef, cList []InputCol, cMat InputMatrix) error {
+
+	if err := p.checkProb(); err != nil {
+		return err
+	}
+
+	if len(cList) < 1 {
+		return errors.Errorf("AddCols expected more than %d columns", len(cList))
+	}
+
+	for i := 0; i < len(cList); i++ {
+		if cList[i].Type == "" {
+			return errors.Errorf("AddCols found no type for column %d", i)
+		}
+	}
+
+	// Objective function coefficients refer to the columns being created.
+	for i := 0; i < len(objList); i++ {
+		if objList[i].ColIndex < 0 || objList[i].ColIndex >= len(cList) {
+			return errors.Errorf("AddCols found objective coefficient for column %d, expected 0 to %d",
+				objList[i].ColIndex, len(cList) - 1)
+		}
+	}
+
+	mat, err := checkMatrix("AddCols", "column", "row", cMat, len(cList), p.slv.NumRows())
+	if err != nil {
+		return err
+	}
+
+	return p.slv.AddCols(objList, cList, mat)
+}
+
+//==============================================================================
+
+// checkMatrix checks the matrix passed to operation op for count new items
+// (rows or columns, named by kind), whose coefficients refer to numOther
+// existing items of the other kind. A matrix with empty slices is returned
+// with Beg set to zero for each item, so that the backends always receive one
+// entry in Beg per item.
+// In case the matrix is inconsistent, refers to items which do not exist or
+// holds the same coefficient twice, it returns an error.
+func checkMatrix(op string, kind string, other string, mat InputMatrix, count int, numOther int) (InputMatrix, error) {
+
+	if len(mat.Ind) != len(mat.Val) {
+		return mat, errors.Errorf("%s found %d indices and %d values", op, len(mat.Ind), len(mat.Val))
+	}
+
+	if len(mat.Beg) == 0 && len(mat.Ind) == 0 {
+		return InputMatrix{Beg: make([]int, count)}, nil
+	}
+
+	if len(mat.Beg) != count {
+		return mat, errors.Errorf("%s found %d entries in Beg, expected %d", op, len(mat.Beg), count)
+	}
+
+	for k := 0; k < count; k++ {
+		end := len(mat.Ind)
+		if k + 1 < count {
+			end = mat.Beg[k + 1]
+		}
+		if mat.Beg[k] < 0 || mat.Beg[k] > end || end > len(mat.Ind) {
+			return mat, errors.Errorf("%s found coefficients %d to %d for %s %d, expected 0 to %d",
+				op, mat.Beg[k], end - 1, kind, k, len(mat.Ind) - 1)
+		}
+
+		seen := make(map[int]bool, end - mat.Beg[k])
+		for _, index := range mat.Ind[mat.Beg[k]:end] {
+			if index < 0 || index >= numOther {
+				return mat, errors.Errorf("%s found %s index %d for %s %d, expected 0 to %d",
+					op, other, index, kind, k, numOther - 1)
+			}
+			if seen[index] {
+				return mat, errors.Errorf("%s found %s index %d twice for %s %d", op, other, index, kind, k)
+			}
+			seen[index] = true
+		}
+	}
+
+	return mat, nil
+}
+
+//==============================================================================
+// PACKAGE-LEVEL FUNCTIONS
+//==============================================================================
+
+// AddRows creates new rows with their coefficients in the default problem. See
+// Problem.AddRows.
+func AddRows(rList []InputRow, rMat InputMatrix) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.AddRows(rList, rMat)
+}
+
+//==============================================================================
+
+// AddCols creates new columns with their coefficients in the default problem.
+// See Problem.AddCols.
+func AddCols(objList []InputObjCoef, cList []InputCol, cMat InputMatrix) error {
+
+	prob, err := getDefaultProb()
+	if err != nil {
+		return err
+	}
+
+	return prob.AddCols(objList, cList, cMat)
+}
+
+//============================ END OF FILE =====================================
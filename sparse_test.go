@@ -0,0 +1,108 @@
+package gpx
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckMatrix(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		mat    InputMatrix
+		count  int
+		want   InputMatrix   // Matrix returned, nil Beg for an error
+	}{
+		{"empty", InputMatrix{}, 3, InputMatrix{Beg: []int{0, 0, 0}}},
+		{"one item", InputMatrix{[]int{0}, []int{2, 0}, []float64{1, 2}}, 1,
+			InputMatrix{[]int{0}, []int{2, 0}, []float64{1, 2}}},
+		{"empty items", InputMatrix{[]int{0, 0, 1}, []int{1}, []float64{5}}, 3,
+			InputMatrix{[]int{0, 0, 1}, []int{1}, []float64{5}}},
+		{"last item empty", InputMatrix{[]int{0, 2}, []int{0, 1}, []float64{1, 2}}, 2,
+			InputMatrix{[]int{0, 2}, []int{0, 1}, []float64{1, 2}}},
+		{"same index in two items", InputMatrix{[]int{0, 1}, []int{1, 1}, []float64{1, 2}}, 2,
+			InputMatrix{[]int{0, 1}, []int{1, 1}, []float64{1, 2}}},
+		{"fewer values", InputMatrix{[]int{0}, []int{0, 1}, []float64{1}}, 1, InputMatrix{}},
+		{"values without Beg", InputMatrix{nil, []int{0}, []float64{1}}, 1, InputMatrix{}},
+		{"Beg too short", InputMatrix{[]int{0}, []int{0, 1}, []float64{1, 2}}, 2, InputMatrix{}},
+		{"negative Beg", InputMatrix{[]int{-1}, []int{0}, []float64{1}}, 1, InputMatrix{}},
+		{"decreasing Beg", InputMatrix{[]int{1, 0}, []int{0, 1}, []float64{1, 2}}, 2, InputMatrix{}},
+		{"Beg past the end", InputMatrix{[]int{0, 3}, []int{0, 1}, []float64{1, 2}}, 2, InputMatrix{}},
+		{"negative index", InputMatrix{[]int{0}, []int{-1}, []float64{1}}, 1, InputMatrix{}},
+		{"index out of range", InputMatrix{[]int{0}, []int{3}, []float64{1}}, 1, InputMatrix{}},
+		{"index twice", InputMatrix{[]int{0}, []int{2, 0, 2}, []float64{1, 2, 3}}, 1, InputMatrix{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			got, err := checkMatrix("Test", "row", "column", tt.mat, tt.count, 3)
+			if (err != nil) != (tt.want.Beg == nil) {
+				t.Fatalf("checkMatrix error = %v, want error %t", err, tt.want.Beg == nil)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("checkMatrix = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+//==============================================================================
+
+// TestAddRowsCols adds a row and a column with their coefficients to the
+// problem of goModel, and checks the coefficients which result, or that a
+// matrix which is rejected leaves the problem unchanged.
+func TestAddRowsCols(t *testing.T) {
+
+	goElems := []InputElem{{0, 0, 1}, {1, 0, 1}, {0, 1, 1}, {1, 1, 3}}
+
+	tests := []struct {
+		name   string
+		add    func(p *Problem) error
+		elems  []InputElem   // nil for an error
+	}{
+		{"row", func(p *Problem) error {
+			return p.AddRows([]InputRow{{"c3", "G", 1, 0}}, InputMatrix{[]int{0}, []int{1, 0}, []float64{2, -1}})
+		}, []InputElem{{0, 0, 1}, {1, 0, 1}, {2, 0, -1}, {0, 1, 1}, {1, 1, 3}, {2, 1, 2}}},
+		{"row without coefficients", func(p *Problem) error {
+			return p.AddRows([]InputRow{{"c3", "G", 1, 0}}, InputMatrix{})
+		}, goElems},
+		{"columns", func(p *Problem) error {
+			return p.AddCols([]InputObjCoef{{1, 5}}, []InputCol{{"z", "C", 0, 1}, {"w", "I", 0, 2}},
+				InputMatrix{[]int{0, 0}, []int{1, 0}, []float64{4, 6}})
+		}, []InputElem{{0, 0, 1}, {1, 0, 1}, {0, 1, 1}, {1, 1, 3}, {0, 3, 6}, {1, 3, 4}}},
+		{"row index out of range", func(p *Problem) error {
+			return p.AddCols(nil, []InputCol{{"z", "C", 0, 1}}, InputMatrix{[]int{0}, []int{2}, []float64{1}})
+		}, nil},
+		{"column index twice", func(p *Problem) error {
+			return p.AddRows([]InputRow{{"c3", "L", 1, 0}}, InputMatrix{[]int{0}, []int{1, 1}, []float64{1, 1}})
+		}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			_, prob := newTestProb(t, NewGoBackend(), goModel("C"))
+
+			err := tt.add(prob)
+			if (err != nil) != (tt.elems == nil) {
+				t.Fatalf("error = %v, want error %t", err, tt.elems == nil)
+			}
+
+			want := tt.elems
+			if want == nil {
+				want = goElems
+			}
+
+			var elems []InputElem
+			if err = prob.GetCoefs(&elems); err != nil {
+				t.Fatalf("GetCoefs failed: %v", err)
+			}
+			if !reflect.DeepEqual(elems, want) {
+				t.Errorf("GetCoefs = %v, want %v", elems, want)
+			}
+		})
+	}
+}
+
+//============================ END OF FILE =====================================
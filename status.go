@@ -0,0 +1,263 @@
+// 01   Oct. 16, 2026   Initial version, solution status, method and type
+// 02   Oct. 16, 2026   Added IsAborted
+
+package gpx
+
+import (
+	"fmt"
+)
+
+// SolutionStatus is the status of the solution of a problem, as reported by
+// CPXgetstat after LpOpt or MipOpt. The values are the Cplex solution status
+// codes, so that codes not listed below can still be compared with the Cplex
+// documentation.
+type SolutionStatus int
+
+// Solution status codes for continuous problems (CPX_STAT_*).
+const (
+	StatNone                 SolutionStatus =   0   // No solution exists
+	StatOptimal              SolutionStatus =   1   // Optimal solution found
+	StatUnbounded            SolutionStatus =   2   // Problem is unbounded
+	StatInfeasible           SolutionStatus =   3   // Problem is infeasible
+	StatInfOrUnbd            SolutionStatus =   4   // Problem is infeasible or unbounded
+	StatOptimalInfeas        SolutionStatus =   5   // Optimal, with infeasibilities after unscaling
+	StatNumBest              SolutionStatus =   6   // Numerical difficulties, best solution found
+	StatAbortItLim           SolutionStatus =  10   // Stopped at the iteration limit
+	StatAbortTimeLim         SolutionStatus =  11   // Stopped at the time limit
+	StatAbortObjLim          SolutionStatus =  12   // Stopped at the objective limit
+	StatAbortUser            SolutionStatus =  13   // Stopped on request of the user
+	StatOptimalFaceUnbounded SolutionStatus =  20   // Optimal face is unbounded
+	StatAbortPrimObjLim      SolutionStatus =  21   // Stopped at the primal objective limit
+	StatAbortDualObjLim      SolutionStatus =  22   // Stopped at the dual objective limit
+	StatFeasible             SolutionStatus =  23   // Feasible solution found
+	StatAbortDetTimeLim      SolutionStatus =  25   // Stopped at the deterministic time limit
+)
+
+// Solution status codes for mixed integer problems (CPXMIP_*).
+const (
+	MipOptimal               SolutionStatus = 101   // Optimal integer solution found
+	MipOptimalTol            SolutionStatus = 102   // Integer solution within the gap tolerance found
+	MipInfeasible            SolutionStatus = 103   // Problem is integer infeasible
+	MipSolLim                SolutionStatus = 104   // Stopped at the solution limit
+	MipNodeLimFeas           SolutionStatus = 105   // Stopped at the node limit, solution exists
+	MipNodeLimInfeas         SolutionStatus = 106   // Stopped at the node limit, no solution exists
+	MipTimeLimFeas           SolutionStatus = 107   // Stopped at the time limit, solution exists
+	MipTimeLimInfeas         SolutionStatus = 108   // Stopped at the time limit, no solution exists
+	MipFailFeas              SolutionStatus = 109   // Stopped by an error, solution exists
+	MipFailInfeas            SolutionStatus = 110   // Stopped by an error, no solution exists
+	MipMemLimFeas            SolutionStatus = 111   // Stopped at the memory limit, solution exists
+	MipMemLimInfeas          SolutionStatus = 112   // Stopped at the memory limit, no solution exists
+	MipAbortFeas             SolutionStatus = 113   // Stopped on request of the user, solution exists
+	MipAbortInfeas           SolutionStatus = 114   // Stopped on request of the user, no solution exists
+	MipOptimalInfeas         SolutionStatus = 115   // Optimal, with infeasibilities after unscaling
+	MipFailFeasNoTree        SolutionStatus = 116   // Out of memory for the tree, solution exists
+	MipFailInfeasNoTree      SolutionStatus = 117   // Out of memory for the tree, no solution exists
+	MipUnbounded             SolutionStatus = 118   // Problem is unbounded
+	MipInfOrUnbd             SolutionStatus = 119   // Problem is infeasible or unbounded
+	MipPopulateSolLim        SolutionStatus = 128   // Populate stopped at the solution limit
+	MipOptimalPopulated      SolutionStatus = 129   // Populate enumerated all optimal solutions
+	MipOptimalPopulatedTol   SolutionStatus = 130   // Populate enumerated all solutions within the gap
+	MipDetTimeLimFeas        SolutionStatus = 131   // Stopped at the deterministic time limit, solution exists
+	MipDetTimeLimInfeas      SolutionStatus = 132   // Stopped at the deterministic time limit, no solution exists
+)
+
+// statusText holds the descriptions returned by SolutionStatus.String.
+var statusText = map[SolutionStatus]string{
+	StatNone:                 "no solution",
+	StatOptimal:              "optimal",
+	StatUnbounded:            "unbounded",
+	StatInfeasible:           "infeasible",
+	StatInfOrUnbd:            "infeasible or unbounded",
+	StatOptimalInfeas:        "optimal with unscaled infeasibilities",
+	StatNumBest:              "stopped by numerical difficulties",
+	StatAbortItLim:           "stopped at iteration limit",
+	StatAbortTimeLim:         "stopped at time limit",
+	StatAbortObjLim:          "stopped at objective limit",
+	StatAbortUser:            "aborted by user",
+	StatOptimalFaceUnbounded: "optimal face unbounded",
+	StatAbortPrimObjLim:      "stopped at primal objective limit",
+	StatAbortDualObjLim:      "stopped at dual objective limit",
+	StatFeasible:             "feasible",
+	StatAbortDetTimeLim:      "stopped at deterministic time limit",
+	MipOptimal:               "integer optimal",
+	MipOptimalTol:            "integer optimal within tolerance",
+	MipInfeasible:            "integer infeasible",
+	MipSolLim:                "stopped at solution limit",
+	MipNodeLimFeas:           "stopped at node limit, integer feasible",
+	MipNodeLimInfeas:         "stopped at node limit, no integer solution",
+	MipTimeLimFeas:           "stopped at time limit, integer feasible",
+	MipTimeLimInfeas:         "stopped at time limit, no integer solution",
+	MipFailFeas:              "stopped by error, integer feasible",
+	MipFailInfeas:            "stopped by error, no integer solution",
+	MipMemLimFeas:            "stopped at memory limit, integer feasible",
+	MipMemLimInfeas:          "stopped at memory limit, no integer solution",
+	MipAbortFeas:             "aborted by user, integer feasible",
+	MipAbortInfeas:           "aborted by user, no integer solution",
+	MipOptimalInfeas:         "integer optimal with unscaled infeasibilities",
+	MipFailFeasNoTree:        "out of memory for tree, integer feasible",
+	MipFailInfeasNoTree:      "out of memory for tree, no integer solution",
+	MipUnbounded:             "unbounded",
+	MipInfOrUnbd:             "infeasible or unbounded",
+	MipPopulateSolLim:        "populate stopped at solution limit",
+	MipOptimalPopulated:      "populated all optimal solutions",
+	MipOptimalPopulatedTol:   "populated all solutions within tolerance",
+	MipDetTimeLimFeas:        "stopped at deterministic time limit, integer feasible",
+	MipDetTimeLimInfeas:      "stopped at deterministic time limit, no integer solution",
+}
+
+// Algorithm identifies a Cplex optimizer. The values are the Cplex CPX_ALG_*
+// codes.
+type Algorithm int
+
+// Optimizers, as reported in SolnInfo.
+const (
+	AlgNone                  Algorithm = -1   // No solution exists
+	AlgAuto                  Algorithm =  0   // Automatic selection
+	AlgPrimal                Algorithm =  1   // Primal simplex
+	AlgDual                  Algorithm =  2   // Dual simplex
+	AlgNet                   Algorithm =  3   // Network simplex
+	AlgBarrier               Algorithm =  4   // Barrier
+	AlgSifting               Algorithm =  5   // Sifting
+	AlgConcurrent            Algorithm =  6   // Concurrent
+	AlgMip                   Algorithm = 12   // Mixed integer optimizer
+)
+
+// SolnType identifies the kind of solution which exists. The values are the
+// Cplex solution type codes.
+type SolnType int
+
+// Solution types, as reported in SolnInfo.
+const (
+	SolnNone                 SolnType = 0     // No solution exists (CPX_NO_SOLN)
+	SolnBasic                SolnType = 1     // Basic solution (CPX_BASIC_SOLN)
+	SolnNonbasic             SolnType = 2     // Primal and dual, but no basis (CPX_NONBASIC_SOLN)
+	SolnPrimal               SolnType = 3     // Primal values only (CPX_PRIMAL_SOLN)
+)
+
+// SolnInfo describes the solution of a problem, as reported by CPXsolninfo.
+type SolnInfo struct {
+	Method          Algorithm    // Optimizer which produced the solution
+	Type            SolnType     // Kind of solution
+	PrimalFeasible  bool         // Cplex considers the solution primal feasible
+	DualFeasible    bool         // Cplex considers the solution dual feasible
+}
+
+//==============================================================================
+
+// String returns a short description of the status.
+func (s SolutionStatus) String() string {
+
+	if text, ok := statusText[s]; ok {
+		return text
+	}
+
+	return fmt.Sprintf("status %d", int(s))
+}
+
+//==============================================================================
+
+// IsOptimal returns true if the status reports an optimal solution, including
+// a MIP solution which is optimal within the gap tolerance.
+func (s SolutionStatus) IsOptimal() bool {
+
+	switch s {
+	case StatOptimal, MipOptimal, MipOptimalTol, MipOptimalPopulated, MipOptimalPopulatedTol:
+		return true
+	}
+
+	return false
+}
+
+//==============================================================================
+
+// IsInfeasible returns true if the status reports that the problem has been
+// proven infeasible. It returns false for StatInfOrUnbd and MipInfOrUnbd.
+func (s SolutionStatus) IsInfeasible() bool {
+
+	return s == StatInfeasible || s == MipInfeasible
+}
+
+//==============================================================================
+
+// IsUnbounded returns true if the status reports that the problem has been
+// proven unbounded. It returns false for StatInfOrUnbd and MipInfOrUnbd.
+func (s SolutionStatus) IsUnbounded() bool {
+
+	return s == StatUnbounded || s == MipUnbounded
+}
+
+//==============================================================================
+
+// IsAborted returns true if the optimizer stopped on request of the user, e.g.
+// because the context passed to LpOptContext or MipOptContext was cancelled.
+// MipAbortFeas reports that an integer solution is still available.
+func (s SolutionStatus) IsAborted() bool {
+
+	return s == StatAbortUser || s == MipAbortFeas || s == MipAbortInfeas
+}
+
+//==============================================================================
+
+// HitLimit returns true if the optimizer stopped because it reached a limit
+// (iterations, time, objective, nodes, solutions or memory) before it
+// could prove optimality, infeasibility or unboundedness.
+func (s SolutionStatus) HitLimit() bool {
+
+	switch s {
+	case StatAbortItLim, StatAbortTimeLim, StatAbortObjLim, StatAbortPrimObjLim,
+		StatAbortDualObjLim, StatAbortDetTimeLim, MipSolLim, MipNodeLimFeas,
+		MipNodeLimInfeas, MipTimeLimFeas, MipTimeLimInfeas, MipMemLimFeas,
+		MipMemLimInfeas, MipPopulateSolLim, MipDetTimeLimFeas, MipDetTimeLimInfeas:
+		return true
+	}
+
+	return false
+}
+
+//==============================================================================
+
+// primalFeasible returns true if a problem with this status has a primal
+// feasible solution. It is used by backends which do not keep this flag
+// separately.
+func (s SolutionStatus) primalFeasible() bool {
+
+	switch s {
+	case StatFeasible, MipSolLim, MipNodeLimFeas, MipTimeLimFeas, MipFailFeas,
+		MipMemLimFeas, MipAbortFeas, MipFailFeasNoTree, MipPopulateSolLim,
+		MipDetTimeLimFeas:
+		return true
+	}
+
+	return s.IsOptimal()
+}
+
+//==============================================================================
+
+// String returns the name of the algorithm.
+func (a Algorithm) String() string {
+
+	switch a {
+	case AlgNone:
+		return "none"
+	case AlgAuto:
+		return "automatic"
+	case AlgPrimal:
+		return "primal simplex"
+	case AlgDual:
+		return "dual simplex"
+	case AlgNet:
+		return "network simplex"
+	case AlgBarrier:
+		return "barrier"
+	case AlgSifting:
+		return "sifting"
+	case AlgConcurrent:
+		return "concurrent"
+	case AlgMip:
+		return "mixed integer"
+	}
+
+	return fmt.Sprintf("algorithm %d", int(a))
+}
+
+//============================ END OF FILE =====================================
@@ -0,0 +1,78 @@
+package gpx
+
+import (
+	"testing"
+)
+
+func TestSolutionStatusPredicates(t *testing.T) {
+
+	tests := []struct {
+		status      SolutionStatus
+		optimal     bool
+		infeasible  bool
+		unbounded   bool
+		aborted     bool
+		limit       bool
+		feasible    bool
+	}{
+		{StatNone, false, false, false, false, false, false},
+		{StatOptimal, true, false, false, false, false, true},
+		{StatUnbounded, false, false, true, false, false, false},
+		{StatInfeasible, false, true, false, false, false, false},
+		{StatInfOrUnbd, false, false, false, false, false, false},
+		{StatAbortItLim, false, false, false, false, true, false},
+		{StatAbortUser, false, false, false, true, false, false},
+		{StatFeasible, false, false, false, false, false, true},
+		{StatAbortDetTimeLim, false, false, false, false, true, false},
+		{MipOptimal, true, false, false, false, false, true},
+		{MipOptimalTol, true, false, false, false, false, true},
+		{MipInfeasible, false, true, false, false, false, false},
+		{MipNodeLimFeas, false, false, false, false, true, true},
+		{MipNodeLimInfeas, false, false, false, false, true, false},
+		{MipFailFeas, false, false, false, false, false, true},
+		{MipFailInfeas, false, false, false, false, false, false},
+		{MipAbortFeas, false, false, false, true, false, true},
+		{MipAbortInfeas, false, false, false, true, false, false},
+		{MipUnbounded, false, false, true, false, false, false},
+		{MipInfOrUnbd, false, false, false, false, false, false},
+		{MipPopulateSolLim, false, false, false, false, true, true},
+		{MipOptimalPopulated, true, false, false, false, false, true},
+		{MipOptimalPopulatedTol, true, false, false, false, false, true},
+		{SolutionStatus(999), false, false, false, false, false, false},
+	}
+
+	for _, tt := range tests {
+		got := []bool{tt.status.IsOptimal(), tt.status.IsInfeasible(), tt.status.IsUnbounded(),
+			tt.status.IsAborted(), tt.status.HitLimit(), tt.status.primalFeasible()}
+		want := []bool{tt.optimal, tt.infeasible, tt.unbounded, tt.aborted, tt.limit, tt.feasible}
+		names := []string{"IsOptimal", "IsInfeasible", "IsUnbounded", "IsAborted", "HitLimit", "primalFeasible"}
+
+		for k := range got {
+			if got[k] != want[k] {
+				t.Errorf("%s.%s() = %t, want %t", tt.status, names[k], got[k], want[k])
+			}
+		}
+	}
+}
+
+//==============================================================================
+
+func TestSolutionStatusString(t *testing.T) {
+
+	tests := []struct {
+		status  SolutionStatus
+		want    string
+	}{
+		{StatOptimal, "optimal"},
+		{StatInfOrUnbd, "infeasible or unbounded"},
+		{SolutionStatus(999), "status 999"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.status.String(); got != tt.want {
+			t.Errorf("SolutionStatus(%d).String() = %q, want %q", int(tt.status), got, tt.want)
+		}
+	}
+}
+
+//============================ END OF FILE =====================================